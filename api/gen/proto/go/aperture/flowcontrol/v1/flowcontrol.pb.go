@@ -9,6 +9,7 @@ package flowcontrolv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -21,6 +22,53 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Status of the flow.
+type FlowEndRequest_Status int32
+
+const (
+	FlowEndRequest_STATUS_OK    FlowEndRequest_Status = 0
+	FlowEndRequest_STATUS_ERROR FlowEndRequest_Status = 1
+)
+
+// Enum value maps for FlowEndRequest_Status.
+var (
+	FlowEndRequest_Status_name = map[int32]string{
+		0: "STATUS_OK",
+		1: "STATUS_ERROR",
+	}
+	FlowEndRequest_Status_value = map[string]int32{
+		"STATUS_OK":    0,
+		"STATUS_ERROR": 1,
+	}
+)
+
+func (x FlowEndRequest_Status) Enum() *FlowEndRequest_Status {
+	p := new(FlowEndRequest_Status)
+	*p = x
+	return p
+}
+
+func (x FlowEndRequest_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlowEndRequest_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[0].Descriptor()
+}
+
+func (FlowEndRequest_Status) Type() protoreflect.EnumType {
+	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[0]
+}
+
+func (x FlowEndRequest_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlowEndRequest_Status.Descriptor instead.
+func (FlowEndRequest_Status) EnumDescriptor() ([]byte, []int) {
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{1, 0}
+}
+
 // Error information.
 type CheckResponse_Error int32
 
@@ -64,11 +112,11 @@ func (x CheckResponse_Error) String() string {
 }
 
 func (CheckResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[0].Descriptor()
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[1].Descriptor()
 }
 
 func (CheckResponse_Error) Type() protoreflect.EnumType {
-	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[0]
+	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[1]
 }
 
 func (x CheckResponse_Error) Number() protoreflect.EnumNumber {
@@ -77,7 +125,7 @@ func (x CheckResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CheckResponse_Error.Descriptor instead.
 func (CheckResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{1, 0}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{3, 0}
 }
 
 // RejectReason contains fields that give further information about rejection.
@@ -87,6 +135,7 @@ const (
 	CheckResponse_REJECT_REASON_NONE                CheckResponse_RejectReason = 0
 	CheckResponse_REJECT_REASON_RATE_LIMITED        CheckResponse_RejectReason = 1
 	CheckResponse_REJECT_REASON_CONCURRENCY_LIMITED CheckResponse_RejectReason = 2
+	CheckResponse_REJECT_REASON_QUEUE_FULL          CheckResponse_RejectReason = 3
 )
 
 // Enum value maps for CheckResponse_RejectReason.
@@ -95,11 +144,13 @@ var (
 		0: "REJECT_REASON_NONE",
 		1: "REJECT_REASON_RATE_LIMITED",
 		2: "REJECT_REASON_CONCURRENCY_LIMITED",
+		3: "REJECT_REASON_QUEUE_FULL",
 	}
 	CheckResponse_RejectReason_value = map[string]int32{
 		"REJECT_REASON_NONE":                0,
 		"REJECT_REASON_RATE_LIMITED":        1,
 		"REJECT_REASON_CONCURRENCY_LIMITED": 2,
+		"REJECT_REASON_QUEUE_FULL":          3,
 	}
 )
 
@@ -114,11 +165,11 @@ func (x CheckResponse_RejectReason) String() string {
 }
 
 func (CheckResponse_RejectReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[1].Descriptor()
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[2].Descriptor()
 }
 
 func (CheckResponse_RejectReason) Type() protoreflect.EnumType {
-	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[1]
+	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[2]
 }
 
 func (x CheckResponse_RejectReason) Number() protoreflect.EnumNumber {
@@ -127,7 +178,7 @@ func (x CheckResponse_RejectReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CheckResponse_RejectReason.Descriptor instead.
 func (CheckResponse_RejectReason) EnumDescriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{1, 1}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{3, 1}
 }
 
 // DecisionType contains fields that represent decision made by Check call.
@@ -161,11 +212,11 @@ func (x CheckResponse_DecisionType) String() string {
 }
 
 func (CheckResponse_DecisionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[2].Descriptor()
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[3].Descriptor()
 }
 
 func (CheckResponse_DecisionType) Type() protoreflect.EnumType {
-	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[2]
+	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[3]
 }
 
 func (x CheckResponse_DecisionType) Number() protoreflect.EnumNumber {
@@ -174,7 +225,7 @@ func (x CheckResponse_DecisionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CheckResponse_DecisionType.Descriptor instead.
 func (CheckResponse_DecisionType) EnumDescriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{1, 2}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{3, 2}
 }
 
 // Type contains fields that represent type of ControlPointInfo.
@@ -214,11 +265,11 @@ func (x ControlPointInfo_Type) String() string {
 }
 
 func (ControlPointInfo_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[3].Descriptor()
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[4].Descriptor()
 }
 
 func (ControlPointInfo_Type) Type() protoreflect.EnumType {
-	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[3]
+	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[4]
 }
 
 func (x ControlPointInfo_Type) Number() protoreflect.EnumNumber {
@@ -227,19 +278,20 @@ func (x ControlPointInfo_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ControlPointInfo_Type.Descriptor instead.
 func (ControlPointInfo_Type) EnumDescriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{2, 0}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{5, 0}
 }
 
 // Error information.
 type ClassifierInfo_Error int32
 
 const (
-	ClassifierInfo_ERROR_NONE                ClassifierInfo_Error = 0
-	ClassifierInfo_ERROR_EVAL_FAILED         ClassifierInfo_Error = 1
-	ClassifierInfo_ERROR_EMPTY_RESULTSET     ClassifierInfo_Error = 2
-	ClassifierInfo_ERROR_AMBIGUOUS_RESULTSET ClassifierInfo_Error = 3
-	ClassifierInfo_ERROR_MULTI_EXPRESSION    ClassifierInfo_Error = 4
-	ClassifierInfo_ERROR_EXPRESSION_NOT_MAP  ClassifierInfo_Error = 5
+	ClassifierInfo_ERROR_NONE                    ClassifierInfo_Error = 0
+	ClassifierInfo_ERROR_EVAL_FAILED             ClassifierInfo_Error = 1
+	ClassifierInfo_ERROR_EMPTY_RESULTSET         ClassifierInfo_Error = 2
+	ClassifierInfo_ERROR_AMBIGUOUS_RESULTSET     ClassifierInfo_Error = 3
+	ClassifierInfo_ERROR_MULTI_EXPRESSION        ClassifierInfo_Error = 4
+	ClassifierInfo_ERROR_EXPRESSION_NOT_MAP      ClassifierInfo_Error = 5
+	ClassifierInfo_ERROR_JWT_VERIFICATION_FAILED ClassifierInfo_Error = 6
 )
 
 // Enum value maps for ClassifierInfo_Error.
@@ -251,14 +303,16 @@ var (
 		3: "ERROR_AMBIGUOUS_RESULTSET",
 		4: "ERROR_MULTI_EXPRESSION",
 		5: "ERROR_EXPRESSION_NOT_MAP",
+		6: "ERROR_JWT_VERIFICATION_FAILED",
 	}
 	ClassifierInfo_Error_value = map[string]int32{
-		"ERROR_NONE":                0,
-		"ERROR_EVAL_FAILED":         1,
-		"ERROR_EMPTY_RESULTSET":     2,
-		"ERROR_AMBIGUOUS_RESULTSET": 3,
-		"ERROR_MULTI_EXPRESSION":    4,
-		"ERROR_EXPRESSION_NOT_MAP":  5,
+		"ERROR_NONE":                    0,
+		"ERROR_EVAL_FAILED":             1,
+		"ERROR_EMPTY_RESULTSET":         2,
+		"ERROR_AMBIGUOUS_RESULTSET":     3,
+		"ERROR_MULTI_EXPRESSION":        4,
+		"ERROR_EXPRESSION_NOT_MAP":      5,
+		"ERROR_JWT_VERIFICATION_FAILED": 6,
 	}
 )
 
@@ -273,11 +327,11 @@ func (x ClassifierInfo_Error) String() string {
 }
 
 func (ClassifierInfo_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[4].Descriptor()
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[5].Descriptor()
 }
 
 func (ClassifierInfo_Error) Type() protoreflect.EnumType {
-	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[4]
+	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[5]
 }
 
 func (x ClassifierInfo_Error) Number() protoreflect.EnumNumber {
@@ -286,7 +340,7 @@ func (x ClassifierInfo_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ClassifierInfo_Error.Descriptor instead.
 func (ClassifierInfo_Error) EnumDescriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{3, 0}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{6, 0}
 }
 
 type LimiterDecision_LimiterReason int32
@@ -294,6 +348,7 @@ type LimiterDecision_LimiterReason int32
 const (
 	LimiterDecision_LIMITER_REASON_UNSPECIFIED   LimiterDecision_LimiterReason = 0
 	LimiterDecision_LIMITER_REASON_KEY_NOT_FOUND LimiterDecision_LimiterReason = 1
+	LimiterDecision_LIMITER_REASON_QUEUE_FULL    LimiterDecision_LimiterReason = 2
 )
 
 // Enum value maps for LimiterDecision_LimiterReason.
@@ -301,10 +356,12 @@ var (
 	LimiterDecision_LimiterReason_name = map[int32]string{
 		0: "LIMITER_REASON_UNSPECIFIED",
 		1: "LIMITER_REASON_KEY_NOT_FOUND",
+		2: "LIMITER_REASON_QUEUE_FULL",
 	}
 	LimiterDecision_LimiterReason_value = map[string]int32{
 		"LIMITER_REASON_UNSPECIFIED":   0,
 		"LIMITER_REASON_KEY_NOT_FOUND": 1,
+		"LIMITER_REASON_QUEUE_FULL":    2,
 	}
 )
 
@@ -319,11 +376,11 @@ func (x LimiterDecision_LimiterReason) String() string {
 }
 
 func (LimiterDecision_LimiterReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[5].Descriptor()
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[6].Descriptor()
 }
 
 func (LimiterDecision_LimiterReason) Type() protoreflect.EnumType {
-	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[5]
+	return &file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes[6]
 }
 
 func (x LimiterDecision_LimiterReason) Number() protoreflect.EnumNumber {
@@ -332,7 +389,7 @@ func (x LimiterDecision_LimiterReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LimiterDecision_LimiterReason.Descriptor instead.
 func (LimiterDecision_LimiterReason) EnumDescriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{4, 0}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{7, 0}
 }
 
 // CheckRequest contains fields required to perform Check call.
@@ -391,6 +448,122 @@ func (x *CheckRequest) GetLabels() map[string]string {
 	return nil
 }
 
+// FlowEndRequest contains fields required to perform FlowEnd call.
+type FlowEndRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// check_response is the response returned by Check call for this flow.
+	CheckResponse *CheckResponse `protobuf:"bytes,1,opt,name=check_response,json=checkResponse,proto3" json:"check_response,omitempty"`
+	// status of the flow.
+	Status FlowEndRequest_Status `protobuf:"varint,2,opt,name=status,proto3,enum=aperture.flowcontrol.v1.FlowEndRequest_Status" json:"status,omitempty"`
+	// duration of the workload, i.e. time spent processing the flow after it was admitted.
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// attributes of the flow, used by flux meters that are configured with an attribute_key
+	// other than workload_duration_ms.
+	Attributes map[string]float64 `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *FlowEndRequest) Reset() {
+	*x = FlowEndRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowEndRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowEndRequest) ProtoMessage() {}
+
+func (x *FlowEndRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowEndRequest.ProtoReflect.Descriptor instead.
+func (*FlowEndRequest) Descriptor() ([]byte, []int) {
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FlowEndRequest) GetCheckResponse() *CheckResponse {
+	if x != nil {
+		return x.CheckResponse
+	}
+	return nil
+}
+
+func (x *FlowEndRequest) GetStatus() FlowEndRequest_Status {
+	if x != nil {
+		return x.Status
+	}
+	return FlowEndRequest_STATUS_OK
+}
+
+func (x *FlowEndRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *FlowEndRequest) GetAttributes() map[string]float64 {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// FlowEndResponse is the response to FlowEnd call.
+type FlowEndResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FlowEndResponse) Reset() {
+	*x = FlowEndResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowEndResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowEndResponse) ProtoMessage() {}
+
+func (x *FlowEndResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowEndResponse.ProtoReflect.Descriptor instead.
+func (*FlowEndResponse) Descriptor() ([]byte, []int) {
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{2}
+}
+
 // CheckResponse contains fields that represent decision made by Check call.
 type CheckResponse struct {
 	state         protoimpl.MessageState
@@ -421,12 +594,22 @@ type CheckResponse struct {
 	FluxMeterInfos []*FluxMeterInfo `protobuf:"bytes,11,rep,name=flux_meter_infos,json=fluxMeterInfos,proto3" json:"flux_meter_infos,omitempty"`
 	// limiter_decisions contains information about decision made by each limiter.
 	LimiterDecisions []*LimiterDecision `protobuf:"bytes,12,rep,name=limiter_decisions,json=limiterDecisions,proto3" json:"limiter_decisions,omitempty"`
+	// denied_response is the response to send to the client of a rejected flow, as configured by the limiter that rejected it.
+	DeniedResponse *DeniedResponse `protobuf:"bytes,13,opt,name=denied_response,json=deniedResponse,proto3" json:"denied_response,omitempty"`
+	// limiter_flow_labels are the flow labels added by the limiters, e.g. remaining quota.
+	LimiterFlowLabels map[string]string `protobuf:"bytes,14,rep,name=limiter_flow_labels,json=limiterFlowLabels,proto3" json:"limiter_flow_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// response_headers are the headers to add to the response sent to the client, e.g. remaining quota.
+	ResponseHeaders map[string]string `protobuf:"bytes,15,rep,name=response_headers,json=responseHeaders,proto3" json:"response_headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// flow_id identifies the flow on the agent that made the decision. It is set when the agent keeps state
+	// of the flow until it ends: tokens issued by the concurrency limiters, of which the agent returns the unused
+	// ones at most once, or request flow labels used by response rules of classifiers.
+	FlowId string `protobuf:"bytes,16,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
 }
 
 func (x *CheckResponse) Reset() {
 	*x = CheckResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[1]
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -439,7 +622,7 @@ func (x *CheckResponse) String() string {
 func (*CheckResponse) ProtoMessage() {}
 
 func (x *CheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[1]
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +635,7 @@ func (x *CheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
 func (*CheckResponse) Descriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{1}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CheckResponse) GetStart() *timestamppb.Timestamp {
@@ -539,6 +722,99 @@ func (x *CheckResponse) GetLimiterDecisions() []*LimiterDecision {
 	return nil
 }
 
+func (x *CheckResponse) GetDeniedResponse() *DeniedResponse {
+	if x != nil {
+		return x.DeniedResponse
+	}
+	return nil
+}
+
+func (x *CheckResponse) GetLimiterFlowLabels() map[string]string {
+	if x != nil {
+		return x.LimiterFlowLabels
+	}
+	return nil
+}
+
+func (x *CheckResponse) GetResponseHeaders() map[string]string {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+func (x *CheckResponse) GetFlowId() string {
+	if x != nil {
+		return x.FlowId
+	}
+	return ""
+}
+
+// DeniedResponse describes the response to send to the client of a rejected flow.
+type DeniedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// HTTP status code, 0 if the limiter does not configure one.
+	HttpStatusCode uint32            `protobuf:"varint,1,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	Headers        map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body           string            `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *DeniedResponse) Reset() {
+	*x = DeniedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeniedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeniedResponse) ProtoMessage() {}
+
+func (x *DeniedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeniedResponse.ProtoReflect.Descriptor instead.
+func (*DeniedResponse) Descriptor() ([]byte, []int) {
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeniedResponse) GetHttpStatusCode() uint32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *DeniedResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *DeniedResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
 type ControlPointInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -551,7 +827,7 @@ type ControlPointInfo struct {
 func (x *ControlPointInfo) Reset() {
 	*x = ControlPointInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[2]
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -564,7 +840,7 @@ func (x *ControlPointInfo) String() string {
 func (*ControlPointInfo) ProtoMessage() {}
 
 func (x *ControlPointInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[2]
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +853,7 @@ func (x *ControlPointInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControlPointInfo.ProtoReflect.Descriptor instead.
 func (*ControlPointInfo) Descriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{2}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ControlPointInfo) GetType() ControlPointInfo_Type {
@@ -610,7 +886,7 @@ type ClassifierInfo struct {
 func (x *ClassifierInfo) Reset() {
 	*x = ClassifierInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[3]
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -623,7 +899,7 @@ func (x *ClassifierInfo) String() string {
 func (*ClassifierInfo) ProtoMessage() {}
 
 func (x *ClassifierInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[3]
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +912,7 @@ func (x *ClassifierInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClassifierInfo.ProtoReflect.Descriptor instead.
 func (*ClassifierInfo) Descriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{3}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ClassifierInfo) GetPolicyName() string {
@@ -688,13 +964,16 @@ type LimiterDecision struct {
 	// Types that are assignable to Details:
 	//	*LimiterDecision_RateLimiterInfo_
 	//	*LimiterDecision_ConcurrencyLimiterInfo_
+	//	*LimiterDecision_QuotaLimiterInfo_
 	Details isLimiterDecision_Details `protobuf_oneof:"details"`
+	// Set instead of dropped when the limiter is in shadow mode and would have dropped the flow.
+	WouldHaveDropped bool `protobuf:"varint,8,opt,name=would_have_dropped,json=wouldHaveDropped,proto3" json:"would_have_dropped,omitempty"`
 }
 
 func (x *LimiterDecision) Reset() {
 	*x = LimiterDecision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[4]
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -707,7 +986,7 @@ func (x *LimiterDecision) String() string {
 func (*LimiterDecision) ProtoMessage() {}
 
 func (x *LimiterDecision) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[4]
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +999,7 @@ func (x *LimiterDecision) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LimiterDecision.ProtoReflect.Descriptor instead.
 func (*LimiterDecision) Descriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{4}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LimiterDecision) GetPolicyName() string {
@@ -779,6 +1058,20 @@ func (x *LimiterDecision) GetConcurrencyLimiterInfo() *LimiterDecision_Concurren
 	return nil
 }
 
+func (x *LimiterDecision) GetQuotaLimiterInfo() *LimiterDecision_QuotaLimiterInfo {
+	if x, ok := x.GetDetails().(*LimiterDecision_QuotaLimiterInfo_); ok {
+		return x.QuotaLimiterInfo
+	}
+	return nil
+}
+
+func (x *LimiterDecision) GetWouldHaveDropped() bool {
+	if x != nil {
+		return x.WouldHaveDropped
+	}
+	return false
+}
+
 type isLimiterDecision_Details interface {
 	isLimiterDecision_Details()
 }
@@ -791,10 +1084,16 @@ type LimiterDecision_ConcurrencyLimiterInfo_ struct {
 	ConcurrencyLimiterInfo *LimiterDecision_ConcurrencyLimiterInfo `protobuf:"bytes,7,opt,name=concurrency_limiter_info,json=concurrencyLimiterInfo,proto3,oneof"`
 }
 
+type LimiterDecision_QuotaLimiterInfo_ struct {
+	QuotaLimiterInfo *LimiterDecision_QuotaLimiterInfo `protobuf:"bytes,9,opt,name=quota_limiter_info,json=quotaLimiterInfo,proto3,oneof"`
+}
+
 func (*LimiterDecision_RateLimiterInfo_) isLimiterDecision_Details() {}
 
 func (*LimiterDecision_ConcurrencyLimiterInfo_) isLimiterDecision_Details() {}
 
+func (*LimiterDecision_QuotaLimiterInfo_) isLimiterDecision_Details() {}
+
 // FluxMeterInfo describes detail for each FluxMeterInfo.
 type FluxMeterInfo struct {
 	state         protoimpl.MessageState
@@ -807,7 +1106,7 @@ type FluxMeterInfo struct {
 func (x *FluxMeterInfo) Reset() {
 	*x = FluxMeterInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[5]
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -820,7 +1119,7 @@ func (x *FluxMeterInfo) String() string {
 func (*FluxMeterInfo) ProtoMessage() {}
 
 func (x *FluxMeterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[5]
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,7 +1132,7 @@ func (x *FluxMeterInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FluxMeterInfo.ProtoReflect.Descriptor instead.
 func (*FluxMeterInfo) Descriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{5}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *FluxMeterInfo) GetFluxMeterName() string {
@@ -851,12 +1150,16 @@ type LimiterDecision_RateLimiterInfo struct {
 	Remaining int64  `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
 	Current   int64  `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
 	Label     string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// number of tokens taken by the flow
+	Tokens int64 `protobuf:"varint,4,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	// values of the label keys the label is made of
+	LabelValues map[string]string `protobuf:"bytes,5,rep,name=label_values,json=labelValues,proto3" json:"label_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *LimiterDecision_RateLimiterInfo) Reset() {
 	*x = LimiterDecision_RateLimiterInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[8]
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -869,7 +1172,7 @@ func (x *LimiterDecision_RateLimiterInfo) String() string {
 func (*LimiterDecision_RateLimiterInfo) ProtoMessage() {}
 
 func (x *LimiterDecision_RateLimiterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[8]
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1185,7 @@ func (x *LimiterDecision_RateLimiterInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LimiterDecision_RateLimiterInfo.ProtoReflect.Descriptor instead.
 func (*LimiterDecision_RateLimiterInfo) Descriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{4, 0}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *LimiterDecision_RateLimiterInfo) GetRemaining() int64 {
@@ -906,18 +1209,35 @@ func (x *LimiterDecision_RateLimiterInfo) GetLabel() string {
 	return ""
 }
 
+func (x *LimiterDecision_RateLimiterInfo) GetTokens() int64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+func (x *LimiterDecision_RateLimiterInfo) GetLabelValues() map[string]string {
+	if x != nil {
+		return x.LabelValues
+	}
+	return nil
+}
+
 type LimiterDecision_ConcurrencyLimiterInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	WorkloadIndex string `protobuf:"bytes,1,opt,name=workload_index,json=workloadIndex,proto3" json:"workload_index,omitempty"`
+	// Tokens taken from the scheduler for the flow. Unused tokens are returned on flow end,
+	// based on the tokens recorded by the agent for flow_id and not on this value.
+	Tokens uint64 `protobuf:"varint,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
 func (x *LimiterDecision_ConcurrencyLimiterInfo) Reset() {
 	*x = LimiterDecision_ConcurrencyLimiterInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[9]
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -930,7 +1250,7 @@ func (x *LimiterDecision_ConcurrencyLimiterInfo) String() string {
 func (*LimiterDecision_ConcurrencyLimiterInfo) ProtoMessage() {}
 
 func (x *LimiterDecision_ConcurrencyLimiterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[9]
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,7 +1263,7 @@ func (x *LimiterDecision_ConcurrencyLimiterInfo) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use LimiterDecision_ConcurrencyLimiterInfo.ProtoReflect.Descriptor instead.
 func (*LimiterDecision_ConcurrencyLimiterInfo) Descriptor() ([]byte, []int) {
-	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{4, 1}
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{7, 1}
 }
 
 func (x *LimiterDecision_ConcurrencyLimiterInfo) GetWorkloadIndex() string {
@@ -953,6 +1273,85 @@ func (x *LimiterDecision_ConcurrencyLimiterInfo) GetWorkloadIndex() string {
 	return ""
 }
 
+func (x *LimiterDecision_ConcurrencyLimiterInfo) GetTokens() uint64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+type LimiterDecision_QuotaLimiterInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remaining int64  `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Current   int64  `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	Label     string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// End of the current quota window.
+	WindowEnd *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+}
+
+func (x *LimiterDecision_QuotaLimiterInfo) Reset() {
+	*x = LimiterDecision_QuotaLimiterInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LimiterDecision_QuotaLimiterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimiterDecision_QuotaLimiterInfo) ProtoMessage() {}
+
+func (x *LimiterDecision_QuotaLimiterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimiterDecision_QuotaLimiterInfo.ProtoReflect.Descriptor instead.
+func (*LimiterDecision_QuotaLimiterInfo) Descriptor() ([]byte, []int) {
+	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *LimiterDecision_QuotaLimiterInfo) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *LimiterDecision_QuotaLimiterInfo) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *LimiterDecision_QuotaLimiterInfo) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *LimiterDecision_QuotaLimiterInfo) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
 var File_aperture_flowcontrol_v1_flowcontrol_proto protoreflect.FileDescriptor
 
 var file_aperture_flowcontrol_v1_flowcontrol_proto_rawDesc = []byte{
@@ -960,7 +1359,9 @@ var file_aperture_flowcontrol_v1_flowcontrol_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f,
 	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x61, 0x70, 0x65,
 	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x01, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
@@ -973,199 +1374,314 @@ var file_aperture_flowcontrol_v1_flowcontrol_proto_rawDesc = []byte{
 	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xad, 0x0a, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x42, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f,
-	0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x6f, 0x77,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x73, 0x0a, 0x15, 0x74, 0x65, 0x6c,
-	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa1, 0x03, 0x0a, 0x0e, 0x46, 0x6c, 0x6f, 0x77, 0x45,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0e, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f,
+	0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
 	0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x46, 0x6c, 0x6f, 0x77, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x74, 0x65, 0x6c, 0x65, 0x6d,
-	0x65, 0x74, 0x72, 0x79, 0x46, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x58,
-	0x0a, 0x0d, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65,
-	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x33, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63,
+	0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x29, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x22, 0x11, 0x0a, 0x0f, 0x46, 0x6c,
+	0x6f, 0x77, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x0e,
+	0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12,
+	0x42, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c,
+	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x57, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x6c, 0x6f, 0x77,
+	0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x73, 0x0a, 0x15, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x6c,
+	0x6f, 0x77, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3f, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63,
 	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x10, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x46, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x13, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x46, 0x6c, 0x6f, 0x77, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x58, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61,
 	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x66, 0x6c, 0x75, 0x78, 0x5f, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x75, 0x78, 0x4d,
-	0x65, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x66, 0x6c, 0x75, 0x78, 0x4d, 0x65,
-	0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x55, 0x0a, 0x11, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66,
-	0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
-	0x46, 0x0a, 0x18, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x46, 0x6c, 0x6f, 0x77,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb5, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
-	0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49,
-	0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
-	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f,
-	0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x5f, 0x54, 0x4f, 0x5f,
-	0x4d, 0x41, 0x50, 0x5f, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x5f, 0x54, 0x4f,
-	0x5f, 0x52, 0x45, 0x47, 0x4f, 0x5f, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x59, 0x10, 0x05, 0x22,
-	0x6d, 0x0a, 0x0c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
-	0x16, 0x0a, 0x12, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
-	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x4a, 0x45, 0x43,
-	0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49,
-	0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x4a, 0x45, 0x43,
-	0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x43, 0x55, 0x52, 0x52,
-	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0x46,
-	0x0a, 0x0c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
-	0x0a, 0x16, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45,
-	0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45,
-	0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x22, 0xbf, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x65, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4d, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x45, 0x41, 0x54,
-	0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e,
-	0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x45, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x03, 0x22, 0x84, 0x03, 0x0a, 0x0e, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a,
-	0x10, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa2, 0x01, 0x0a, 0x05, 0x45,
+	0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x58, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x10, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66,
+	0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x50, 0x0a,
+	0x10, 0x66, 0x6c, 0x75, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0e, 0x66, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12,
+	0x55, 0x0a, 0x11, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0e, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x13, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x6f,
+	0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x66, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3b, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f,
+	0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x1a, 0x46, 0x0a, 0x18, 0x54, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x46, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x44, 0x0a, 0x16, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x6f, 0x77, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb5, 0x01, 0x0a, 0x05, 0x45,
 	0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4e, 0x4f,
-	0x4e, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x45, 0x56,
-	0x41, 0x4c, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c,
-	0x54, 0x53, 0x45, 0x54, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
-	0x41, 0x4d, 0x42, 0x49, 0x47, 0x55, 0x4f, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
-	0x53, 0x45, 0x54, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d,
-	0x55, 0x4c, 0x54, 0x49, 0x5f, 0x45, 0x58, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10,
-	0x04, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x52, 0x45,
-	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x41, 0x50, 0x10, 0x05, 0x22,
-	0xcb, 0x05, 0x0a, 0x0f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18,
-	0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x44, 0x49,
+	0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x46, 0x46,
+	0x49, 0x43, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1f,
+	0x0a, 0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54, 0x5f,
+	0x54, 0x4f, 0x5f, 0x4d, 0x41, 0x50, 0x5f, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x10, 0x03, 0x12,
+	0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x54,
+	0x5f, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x47, 0x4f, 0x5f, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x12,
+	0x0a, 0x0e, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x59,
+	0x10, 0x05, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x41, 0x54,
+	0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
+	0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x03,
+	0x22, 0x46, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x22, 0xda, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6e,
+	0x69, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbf, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
 	0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x66, 0x0a, 0x11, 0x72, 0x61, 0x74, 0x65,
-	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66,
-	0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
-	0x0f, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x7b, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c,
-	0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x5f, 0x0a,
-	0x0f, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x1a, 0x3f,
-	0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
-	0x51, 0x0a, 0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53,
-	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53,
-	0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
-	0x10, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x37, 0x0a,
-	0x0d, 0x46, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26,
-	0x0a, 0x0f, 0x66, 0x6c, 0x75, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x6e, 0x0a, 0x12, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x05,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x45, 0x41, 0x54, 0x55,
+	0x52, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x47,
+	0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45,
+	0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x03, 0x22, 0xa7, 0x03, 0x0a, 0x0e, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66,
+	0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x45, 0x56, 0x41,
+	0x4c, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
+	0x53, 0x45, 0x54, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x41,
+	0x4d, 0x42, 0x49, 0x47, 0x55, 0x4f, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x53,
+	0x45, 0x54, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d, 0x55,
+	0x4c, 0x54, 0x49, 0x5f, 0x45, 0x58, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x04,
+	0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x52, 0x45, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x41, 0x50, 0x10, 0x05, 0x12, 0x21,
+	0x0a, 0x1d, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4a, 0x57, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x49,
+	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x06, 0x22, 0x80, 0x0a, 0x0a, 0x0f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x66, 0x0a, 0x11, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
 	0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61,
-	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x83, 0x02, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
-	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x46, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x75, 0x78, 0x6e, 0x69, 0x6e, 0x6a, 0x61,
-	0x2f, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65,
-	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f,
-	0x76, 0x31, 0x3b, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x41, 0x46, 0x58, 0xaa, 0x02, 0x17, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
-	0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x17, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x46, 0x6c, 0x6f, 0x77,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x41, 0x70, 0x65,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x46, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x19, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x3a, 0x46, 0x6c, 0x6f,
-	0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48,
+	0x00, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x7b, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x69, 0x0a, 0x12, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x6f,
+	0x75, 0x6c, 0x64, 0x5f, 0x68, 0x61, 0x76, 0x65, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x77, 0x6f, 0x75, 0x6c, 0x64, 0x48, 0x61, 0x76,
+	0x65, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x1a, 0xa5, 0x02, 0x0a, 0x0f, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x12, 0x6c, 0x0a, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x1a, 0x3e, 0x0a, 0x10, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x57, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a, 0x9b, 0x01, 0x0a, 0x10, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x22, 0x70, 0x0a, 0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49,
+	0x4d, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45,
+	0x55, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x02, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x22, 0x37, 0x0a, 0x0d, 0x46, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x6c, 0x75, 0x78, 0x5f, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x66, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0xce, 0x01,
+	0x0a, 0x12, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x25, 0x2e,
+	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e,
+	0x0a, 0x07, 0x46, 0x6c, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x66, 0x6c,
+	0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f,
+	0x77, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x83,
+	0x02, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x66, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x10,
+	0x46, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66,
+	0x6c, 0x75, 0x78, 0x6e, 0x69, 0x6e, 0x6a, 0x61, 0x2f, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x2f, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x66, 0x6c, 0x6f, 0x77,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6c, 0x6f, 0x77, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x46, 0x58, 0xaa, 0x02,
+	0x17, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x41, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x5c, 0x46, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x23, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x46, 0x6c,
+	0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x41, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x3a, 0x3a, 0x46, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1180,51 +1696,75 @@ func file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescGZIP() []byte {
 	return file_aperture_flowcontrol_v1_flowcontrol_proto_rawDescData
 }
 
-var file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_aperture_flowcontrol_v1_flowcontrol_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_aperture_flowcontrol_v1_flowcontrol_proto_goTypes = []interface{}{
-	(CheckResponse_Error)(0),                       // 0: aperture.flowcontrol.v1.CheckResponse.Error
-	(CheckResponse_RejectReason)(0),                // 1: aperture.flowcontrol.v1.CheckResponse.RejectReason
-	(CheckResponse_DecisionType)(0),                // 2: aperture.flowcontrol.v1.CheckResponse.DecisionType
-	(ControlPointInfo_Type)(0),                     // 3: aperture.flowcontrol.v1.ControlPointInfo.Type
-	(ClassifierInfo_Error)(0),                      // 4: aperture.flowcontrol.v1.ClassifierInfo.Error
-	(LimiterDecision_LimiterReason)(0),             // 5: aperture.flowcontrol.v1.LimiterDecision.LimiterReason
-	(*CheckRequest)(nil),                           // 6: aperture.flowcontrol.v1.CheckRequest
-	(*CheckResponse)(nil),                          // 7: aperture.flowcontrol.v1.CheckResponse
-	(*ControlPointInfo)(nil),                       // 8: aperture.flowcontrol.v1.ControlPointInfo
-	(*ClassifierInfo)(nil),                         // 9: aperture.flowcontrol.v1.ClassifierInfo
-	(*LimiterDecision)(nil),                        // 10: aperture.flowcontrol.v1.LimiterDecision
-	(*FluxMeterInfo)(nil),                          // 11: aperture.flowcontrol.v1.FluxMeterInfo
-	nil,                                            // 12: aperture.flowcontrol.v1.CheckRequest.LabelsEntry
-	nil,                                            // 13: aperture.flowcontrol.v1.CheckResponse.TelemetryFlowLabelsEntry
-	(*LimiterDecision_RateLimiterInfo)(nil),        // 14: aperture.flowcontrol.v1.LimiterDecision.RateLimiterInfo
-	(*LimiterDecision_ConcurrencyLimiterInfo)(nil), // 15: aperture.flowcontrol.v1.LimiterDecision.ConcurrencyLimiterInfo
-	(*timestamppb.Timestamp)(nil),                  // 16: google.protobuf.Timestamp
+	(FlowEndRequest_Status)(0),              // 0: aperture.flowcontrol.v1.FlowEndRequest.Status
+	(CheckResponse_Error)(0),                // 1: aperture.flowcontrol.v1.CheckResponse.Error
+	(CheckResponse_RejectReason)(0),         // 2: aperture.flowcontrol.v1.CheckResponse.RejectReason
+	(CheckResponse_DecisionType)(0),         // 3: aperture.flowcontrol.v1.CheckResponse.DecisionType
+	(ControlPointInfo_Type)(0),              // 4: aperture.flowcontrol.v1.ControlPointInfo.Type
+	(ClassifierInfo_Error)(0),               // 5: aperture.flowcontrol.v1.ClassifierInfo.Error
+	(LimiterDecision_LimiterReason)(0),      // 6: aperture.flowcontrol.v1.LimiterDecision.LimiterReason
+	(*CheckRequest)(nil),                    // 7: aperture.flowcontrol.v1.CheckRequest
+	(*FlowEndRequest)(nil),                  // 8: aperture.flowcontrol.v1.FlowEndRequest
+	(*FlowEndResponse)(nil),                 // 9: aperture.flowcontrol.v1.FlowEndResponse
+	(*CheckResponse)(nil),                   // 10: aperture.flowcontrol.v1.CheckResponse
+	(*DeniedResponse)(nil),                  // 11: aperture.flowcontrol.v1.DeniedResponse
+	(*ControlPointInfo)(nil),                // 12: aperture.flowcontrol.v1.ControlPointInfo
+	(*ClassifierInfo)(nil),                  // 13: aperture.flowcontrol.v1.ClassifierInfo
+	(*LimiterDecision)(nil),                 // 14: aperture.flowcontrol.v1.LimiterDecision
+	(*FluxMeterInfo)(nil),                   // 15: aperture.flowcontrol.v1.FluxMeterInfo
+	nil,                                     // 16: aperture.flowcontrol.v1.CheckRequest.LabelsEntry
+	nil,                                     // 17: aperture.flowcontrol.v1.FlowEndRequest.AttributesEntry
+	nil,                                     // 18: aperture.flowcontrol.v1.CheckResponse.TelemetryFlowLabelsEntry
+	nil,                                     // 19: aperture.flowcontrol.v1.CheckResponse.LimiterFlowLabelsEntry
+	nil,                                     // 20: aperture.flowcontrol.v1.CheckResponse.ResponseHeadersEntry
+	nil,                                     // 21: aperture.flowcontrol.v1.DeniedResponse.HeadersEntry
+	(*LimiterDecision_RateLimiterInfo)(nil), // 22: aperture.flowcontrol.v1.LimiterDecision.RateLimiterInfo
+	(*LimiterDecision_ConcurrencyLimiterInfo)(nil), // 23: aperture.flowcontrol.v1.LimiterDecision.ConcurrencyLimiterInfo
+	(*LimiterDecision_QuotaLimiterInfo)(nil),       // 24: aperture.flowcontrol.v1.LimiterDecision.QuotaLimiterInfo
+	nil,                                            // 25: aperture.flowcontrol.v1.LimiterDecision.RateLimiterInfo.LabelValuesEntry
+	(*durationpb.Duration)(nil),                    // 26: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                  // 27: google.protobuf.Timestamp
 }
 var file_aperture_flowcontrol_v1_flowcontrol_proto_depIdxs = []int32{
-	12, // 0: aperture.flowcontrol.v1.CheckRequest.labels:type_name -> aperture.flowcontrol.v1.CheckRequest.LabelsEntry
-	16, // 1: aperture.flowcontrol.v1.CheckResponse.start:type_name -> google.protobuf.Timestamp
-	16, // 2: aperture.flowcontrol.v1.CheckResponse.end:type_name -> google.protobuf.Timestamp
-	0,  // 3: aperture.flowcontrol.v1.CheckResponse.error:type_name -> aperture.flowcontrol.v1.CheckResponse.Error
-	8,  // 4: aperture.flowcontrol.v1.CheckResponse.control_point_info:type_name -> aperture.flowcontrol.v1.ControlPointInfo
-	13, // 5: aperture.flowcontrol.v1.CheckResponse.telemetry_flow_labels:type_name -> aperture.flowcontrol.v1.CheckResponse.TelemetryFlowLabelsEntry
-	2,  // 6: aperture.flowcontrol.v1.CheckResponse.decision_type:type_name -> aperture.flowcontrol.v1.CheckResponse.DecisionType
-	1,  // 7: aperture.flowcontrol.v1.CheckResponse.reject_reason:type_name -> aperture.flowcontrol.v1.CheckResponse.RejectReason
-	9,  // 8: aperture.flowcontrol.v1.CheckResponse.classifier_infos:type_name -> aperture.flowcontrol.v1.ClassifierInfo
-	11, // 9: aperture.flowcontrol.v1.CheckResponse.flux_meter_infos:type_name -> aperture.flowcontrol.v1.FluxMeterInfo
-	10, // 10: aperture.flowcontrol.v1.CheckResponse.limiter_decisions:type_name -> aperture.flowcontrol.v1.LimiterDecision
-	3,  // 11: aperture.flowcontrol.v1.ControlPointInfo.type:type_name -> aperture.flowcontrol.v1.ControlPointInfo.Type
-	4,  // 12: aperture.flowcontrol.v1.ClassifierInfo.error:type_name -> aperture.flowcontrol.v1.ClassifierInfo.Error
-	5,  // 13: aperture.flowcontrol.v1.LimiterDecision.reason:type_name -> aperture.flowcontrol.v1.LimiterDecision.LimiterReason
-	14, // 14: aperture.flowcontrol.v1.LimiterDecision.rate_limiter_info:type_name -> aperture.flowcontrol.v1.LimiterDecision.RateLimiterInfo
-	15, // 15: aperture.flowcontrol.v1.LimiterDecision.concurrency_limiter_info:type_name -> aperture.flowcontrol.v1.LimiterDecision.ConcurrencyLimiterInfo
-	6,  // 16: aperture.flowcontrol.v1.FlowControlService.Check:input_type -> aperture.flowcontrol.v1.CheckRequest
-	7,  // 17: aperture.flowcontrol.v1.FlowControlService.Check:output_type -> aperture.flowcontrol.v1.CheckResponse
-	17, // [17:18] is the sub-list for method output_type
-	16, // [16:17] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	16, // 0: aperture.flowcontrol.v1.CheckRequest.labels:type_name -> aperture.flowcontrol.v1.CheckRequest.LabelsEntry
+	10, // 1: aperture.flowcontrol.v1.FlowEndRequest.check_response:type_name -> aperture.flowcontrol.v1.CheckResponse
+	0,  // 2: aperture.flowcontrol.v1.FlowEndRequest.status:type_name -> aperture.flowcontrol.v1.FlowEndRequest.Status
+	26, // 3: aperture.flowcontrol.v1.FlowEndRequest.duration:type_name -> google.protobuf.Duration
+	17, // 4: aperture.flowcontrol.v1.FlowEndRequest.attributes:type_name -> aperture.flowcontrol.v1.FlowEndRequest.AttributesEntry
+	27, // 5: aperture.flowcontrol.v1.CheckResponse.start:type_name -> google.protobuf.Timestamp
+	27, // 6: aperture.flowcontrol.v1.CheckResponse.end:type_name -> google.protobuf.Timestamp
+	1,  // 7: aperture.flowcontrol.v1.CheckResponse.error:type_name -> aperture.flowcontrol.v1.CheckResponse.Error
+	12, // 8: aperture.flowcontrol.v1.CheckResponse.control_point_info:type_name -> aperture.flowcontrol.v1.ControlPointInfo
+	18, // 9: aperture.flowcontrol.v1.CheckResponse.telemetry_flow_labels:type_name -> aperture.flowcontrol.v1.CheckResponse.TelemetryFlowLabelsEntry
+	3,  // 10: aperture.flowcontrol.v1.CheckResponse.decision_type:type_name -> aperture.flowcontrol.v1.CheckResponse.DecisionType
+	2,  // 11: aperture.flowcontrol.v1.CheckResponse.reject_reason:type_name -> aperture.flowcontrol.v1.CheckResponse.RejectReason
+	13, // 12: aperture.flowcontrol.v1.CheckResponse.classifier_infos:type_name -> aperture.flowcontrol.v1.ClassifierInfo
+	15, // 13: aperture.flowcontrol.v1.CheckResponse.flux_meter_infos:type_name -> aperture.flowcontrol.v1.FluxMeterInfo
+	14, // 14: aperture.flowcontrol.v1.CheckResponse.limiter_decisions:type_name -> aperture.flowcontrol.v1.LimiterDecision
+	11, // 15: aperture.flowcontrol.v1.CheckResponse.denied_response:type_name -> aperture.flowcontrol.v1.DeniedResponse
+	19, // 16: aperture.flowcontrol.v1.CheckResponse.limiter_flow_labels:type_name -> aperture.flowcontrol.v1.CheckResponse.LimiterFlowLabelsEntry
+	20, // 17: aperture.flowcontrol.v1.CheckResponse.response_headers:type_name -> aperture.flowcontrol.v1.CheckResponse.ResponseHeadersEntry
+	21, // 18: aperture.flowcontrol.v1.DeniedResponse.headers:type_name -> aperture.flowcontrol.v1.DeniedResponse.HeadersEntry
+	4,  // 19: aperture.flowcontrol.v1.ControlPointInfo.type:type_name -> aperture.flowcontrol.v1.ControlPointInfo.Type
+	5,  // 20: aperture.flowcontrol.v1.ClassifierInfo.error:type_name -> aperture.flowcontrol.v1.ClassifierInfo.Error
+	6,  // 21: aperture.flowcontrol.v1.LimiterDecision.reason:type_name -> aperture.flowcontrol.v1.LimiterDecision.LimiterReason
+	22, // 22: aperture.flowcontrol.v1.LimiterDecision.rate_limiter_info:type_name -> aperture.flowcontrol.v1.LimiterDecision.RateLimiterInfo
+	23, // 23: aperture.flowcontrol.v1.LimiterDecision.concurrency_limiter_info:type_name -> aperture.flowcontrol.v1.LimiterDecision.ConcurrencyLimiterInfo
+	24, // 24: aperture.flowcontrol.v1.LimiterDecision.quota_limiter_info:type_name -> aperture.flowcontrol.v1.LimiterDecision.QuotaLimiterInfo
+	25, // 25: aperture.flowcontrol.v1.LimiterDecision.RateLimiterInfo.label_values:type_name -> aperture.flowcontrol.v1.LimiterDecision.RateLimiterInfo.LabelValuesEntry
+	27, // 26: aperture.flowcontrol.v1.LimiterDecision.QuotaLimiterInfo.window_end:type_name -> google.protobuf.Timestamp
+	7,  // 27: aperture.flowcontrol.v1.FlowControlService.Check:input_type -> aperture.flowcontrol.v1.CheckRequest
+	8,  // 28: aperture.flowcontrol.v1.FlowControlService.FlowEnd:input_type -> aperture.flowcontrol.v1.FlowEndRequest
+	10, // 29: aperture.flowcontrol.v1.FlowControlService.Check:output_type -> aperture.flowcontrol.v1.CheckResponse
+	9,  // 30: aperture.flowcontrol.v1.FlowControlService.FlowEnd:output_type -> aperture.flowcontrol.v1.FlowEndResponse
+	29, // [29:31] is the sub-list for method output_type
+	27, // [27:29] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_aperture_flowcontrol_v1_flowcontrol_proto_init() }
@@ -1246,7 +1786,7 @@ func file_aperture_flowcontrol_v1_flowcontrol_proto_init() {
 			}
 		}
 		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckResponse); i {
+			switch v := v.(*FlowEndRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1258,7 +1798,7 @@ func file_aperture_flowcontrol_v1_flowcontrol_proto_init() {
 			}
 		}
 		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControlPointInfo); i {
+			switch v := v.(*FlowEndResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1270,7 +1810,7 @@ func file_aperture_flowcontrol_v1_flowcontrol_proto_init() {
 			}
 		}
 		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClassifierInfo); i {
+			switch v := v.(*CheckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1282,7 +1822,7 @@ func file_aperture_flowcontrol_v1_flowcontrol_proto_init() {
 			}
 		}
 		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LimiterDecision); i {
+			switch v := v.(*DeniedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1294,7 +1834,31 @@ func file_aperture_flowcontrol_v1_flowcontrol_proto_init() {
 			}
 		}
 		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FluxMeterInfo); i {
+			switch v := v.(*ControlPointInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClassifierInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LimiterDecision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1306,6 +1870,18 @@ func file_aperture_flowcontrol_v1_flowcontrol_proto_init() {
 			}
 		}
 		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FluxMeterInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LimiterDecision_RateLimiterInfo); i {
 			case 0:
 				return &v.state
@@ -1317,7 +1893,7 @@ func file_aperture_flowcontrol_v1_flowcontrol_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LimiterDecision_ConcurrencyLimiterInfo); i {
 			case 0:
 				return &v.state
@@ -1329,18 +1905,31 @@ func file_aperture_flowcontrol_v1_flowcontrol_proto_init() {
 				return nil
 			}
 		}
+		file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LimiterDecision_QuotaLimiterInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_aperture_flowcontrol_v1_flowcontrol_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*LimiterDecision_RateLimiterInfo_)(nil),
 		(*LimiterDecision_ConcurrencyLimiterInfo_)(nil),
+		(*LimiterDecision_QuotaLimiterInfo_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_aperture_flowcontrol_v1_flowcontrol_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   10,
+			NumEnums:      7,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
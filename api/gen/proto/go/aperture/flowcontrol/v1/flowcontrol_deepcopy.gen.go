@@ -26,6 +26,48 @@ func (in *CheckRequest) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using FlowEndRequest within kubernetes types, where deepcopy-gen is used.
+func (in *FlowEndRequest) DeepCopyInto(out *FlowEndRequest) {
+	p := proto.Clone(in).(*FlowEndRequest)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowEndRequest. Required by controller-gen.
+func (in *FlowEndRequest) DeepCopy() *FlowEndRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowEndRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new FlowEndRequest. Required by controller-gen.
+func (in *FlowEndRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using FlowEndResponse within kubernetes types, where deepcopy-gen is used.
+func (in *FlowEndResponse) DeepCopyInto(out *FlowEndResponse) {
+	p := proto.Clone(in).(*FlowEndResponse)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowEndResponse. Required by controller-gen.
+func (in *FlowEndResponse) DeepCopy() *FlowEndResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowEndResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new FlowEndResponse. Required by controller-gen.
+func (in *FlowEndResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using CheckResponse within kubernetes types, where deepcopy-gen is used.
 func (in *CheckResponse) DeepCopyInto(out *CheckResponse) {
 	p := proto.Clone(in).(*CheckResponse)
@@ -47,6 +89,27 @@ func (in *CheckResponse) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using DeniedResponse within kubernetes types, where deepcopy-gen is used.
+func (in *DeniedResponse) DeepCopyInto(out *DeniedResponse) {
+	p := proto.Clone(in).(*DeniedResponse)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeniedResponse. Required by controller-gen.
+func (in *DeniedResponse) DeepCopy() *DeniedResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(DeniedResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DeniedResponse. Required by controller-gen.
+func (in *DeniedResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using ControlPointInfo within kubernetes types, where deepcopy-gen is used.
 func (in *ControlPointInfo) DeepCopyInto(out *ControlPointInfo) {
 	p := proto.Clone(in).(*ControlPointInfo)
@@ -152,6 +215,27 @@ func (in *LimiterDecision_ConcurrencyLimiterInfo) DeepCopyInterface() interface{
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using LimiterDecision_QuotaLimiterInfo within kubernetes types, where deepcopy-gen is used.
+func (in *LimiterDecision_QuotaLimiterInfo) DeepCopyInto(out *LimiterDecision_QuotaLimiterInfo) {
+	p := proto.Clone(in).(*LimiterDecision_QuotaLimiterInfo)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimiterDecision_QuotaLimiterInfo. Required by controller-gen.
+func (in *LimiterDecision_QuotaLimiterInfo) DeepCopy() *LimiterDecision_QuotaLimiterInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(LimiterDecision_QuotaLimiterInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new LimiterDecision_QuotaLimiterInfo. Required by controller-gen.
+func (in *LimiterDecision_QuotaLimiterInfo) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using FluxMeterInfo within kubernetes types, where deepcopy-gen is used.
 func (in *FluxMeterInfo) DeepCopyInto(out *FluxMeterInfo) {
 	p := proto.Clone(in).(*FluxMeterInfo)
@@ -21,6 +21,12 @@ type FlowControlServiceClient interface {
 	// Check wraps the given arbitrary resource and matches the given labels
 	// against Flow Control Limiters to makes a decision whether to allow/deny.
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	// FlowEnd reports the end of a flow that was previously admitted by Check.
+	// It updates workload latency and flux meters immediately, so that clients
+	// that do not export telemetry can still take part in auto tokens and flux meters.
+	// Flows reported via FlowEnd should not also be reported via telemetry,
+	// otherwise they will be accounted twice.
+	FlowEnd(ctx context.Context, in *FlowEndRequest, opts ...grpc.CallOption) (*FlowEndResponse, error)
 }
 
 type flowControlServiceClient struct {
@@ -40,6 +46,15 @@ func (c *flowControlServiceClient) Check(ctx context.Context, in *CheckRequest,
 	return out, nil
 }
 
+func (c *flowControlServiceClient) FlowEnd(ctx context.Context, in *FlowEndRequest, opts ...grpc.CallOption) (*FlowEndResponse, error) {
+	out := new(FlowEndResponse)
+	err := c.cc.Invoke(ctx, "/aperture.flowcontrol.v1.FlowControlService/FlowEnd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FlowControlServiceServer is the server API for FlowControlService service.
 // All implementations should embed UnimplementedFlowControlServiceServer
 // for forward compatibility
@@ -47,6 +62,12 @@ type FlowControlServiceServer interface {
 	// Check wraps the given arbitrary resource and matches the given labels
 	// against Flow Control Limiters to makes a decision whether to allow/deny.
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
+	// FlowEnd reports the end of a flow that was previously admitted by Check.
+	// It updates workload latency and flux meters immediately, so that clients
+	// that do not export telemetry can still take part in auto tokens and flux meters.
+	// Flows reported via FlowEnd should not also be reported via telemetry,
+	// otherwise they will be accounted twice.
+	FlowEnd(context.Context, *FlowEndRequest) (*FlowEndResponse, error)
 }
 
 // UnimplementedFlowControlServiceServer should be embedded to have forward compatible implementations.
@@ -56,6 +77,9 @@ type UnimplementedFlowControlServiceServer struct {
 func (UnimplementedFlowControlServiceServer) Check(context.Context, *CheckRequest) (*CheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
+func (UnimplementedFlowControlServiceServer) FlowEnd(context.Context, *FlowEndRequest) (*FlowEndResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlowEnd not implemented")
+}
 
 // UnsafeFlowControlServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to FlowControlServiceServer will
@@ -86,6 +110,24 @@ func _FlowControlService_Check_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FlowControlService_FlowEnd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlowEndRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowControlServiceServer).FlowEnd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aperture.flowcontrol.v1.FlowControlService/FlowEnd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowControlServiceServer).FlowEnd(ctx, req.(*FlowEndRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FlowControlService_ServiceDesc is the grpc.ServiceDesc for FlowControlService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -97,6 +139,10 @@ var FlowControlService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Check",
 			Handler:    _FlowControlService_Check_Handler,
 		},
+		{
+			MethodName: "FlowEnd",
+			Handler:    _FlowControlService_FlowEnd_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aperture/flowcontrol/v1/flowcontrol.proto",
@@ -67,6 +67,54 @@ func (x *LoadShedDecision) GetLoadShedFactor() float64 {
 	return 0
 }
 
+type ConcurrencyBudgetDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tokens per second shared by the agent group, negative if unknown.
+	ConcurrencyBudget float64 `protobuf:"fixed64,1,opt,name=concurrency_budget,json=concurrencyBudget,proto3" json:"concurrency_budget,omitempty"`
+}
+
+func (x *ConcurrencyBudgetDecision) Reset() {
+	*x = ConcurrencyBudgetDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConcurrencyBudgetDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyBudgetDecision) ProtoMessage() {}
+
+func (x *ConcurrencyBudgetDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConcurrencyBudgetDecision.ProtoReflect.Descriptor instead.
+func (*ConcurrencyBudgetDecision) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_decisions_v1_decisions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConcurrencyBudgetDecision) GetConcurrencyBudget() float64 {
+	if x != nil {
+		return x.ConcurrencyBudget
+	}
+	return 0
+}
+
 type TokensDecision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -79,7 +127,7 @@ type TokensDecision struct {
 func (x *TokensDecision) Reset() {
 	*x = TokensDecision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[1]
+		mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -92,7 +140,7 @@ func (x *TokensDecision) String() string {
 func (*TokensDecision) ProtoMessage() {}
 
 func (x *TokensDecision) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[1]
+	mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -105,7 +153,7 @@ func (x *TokensDecision) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokensDecision.ProtoReflect.Descriptor instead.
 func (*TokensDecision) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_decisions_v1_decisions_proto_rawDescGZIP(), []int{1}
+	return file_aperture_policy_decisions_v1_decisions_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TokensDecision) GetTokensByWorkloadIndex() map[string]uint64 {
@@ -120,13 +168,15 @@ type RateLimiterDecision struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit float64 `protobuf:"fixed64,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Limit          float64 `protobuf:"fixed64,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	FillRate       float64 `protobuf:"fixed64,2,opt,name=fill_rate,json=fillRate,proto3" json:"fill_rate,omitempty"`
+	BucketCapacity float64 `protobuf:"fixed64,3,opt,name=bucket_capacity,json=bucketCapacity,proto3" json:"bucket_capacity,omitempty"`
 }
 
 func (x *RateLimiterDecision) Reset() {
 	*x = RateLimiterDecision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[2]
+		mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -139,7 +189,7 @@ func (x *RateLimiterDecision) String() string {
 func (*RateLimiterDecision) ProtoMessage() {}
 
 func (x *RateLimiterDecision) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[2]
+	mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +202,7 @@ func (x *RateLimiterDecision) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateLimiterDecision.ProtoReflect.Descriptor instead.
 func (*RateLimiterDecision) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_decisions_v1_decisions_proto_rawDescGZIP(), []int{2}
+	return file_aperture_policy_decisions_v1_decisions_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RateLimiterDecision) GetLimit() float64 {
@@ -162,6 +212,67 @@ func (x *RateLimiterDecision) GetLimit() float64 {
 	return 0
 }
 
+func (x *RateLimiterDecision) GetFillRate() float64 {
+	if x != nil {
+		return x.FillRate
+	}
+	return 0
+}
+
+func (x *RateLimiterDecision) GetBucketCapacity() float64 {
+	if x != nil {
+		return x.BucketCapacity
+	}
+	return 0
+}
+
+type QuotaLimiterDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit float64 `protobuf:"fixed64,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *QuotaLimiterDecision) Reset() {
+	*x = QuotaLimiterDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaLimiterDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaLimiterDecision) ProtoMessage() {}
+
+func (x *QuotaLimiterDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_decisions_v1_decisions_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaLimiterDecision.ProtoReflect.Descriptor instead.
+func (*QuotaLimiterDecision) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_decisions_v1_decisions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QuotaLimiterDecision) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 var File_aperture_policy_decisions_v1_decisions_proto protoreflect.FileDescriptor
 
 var file_aperture_policy_decisions_v1_decisions_proto_rawDesc = []byte{
@@ -173,22 +284,34 @@ var file_aperture_policy_decisions_v1_decisions_proto_rawDesc = []byte{
 	0x4c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65, 0x64, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
 	0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x66, 0x61,
 	0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6c, 0x6f, 0x61, 0x64,
-	0x53, 0x68, 0x65, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xdd, 0x01, 0x0a, 0x0e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x80, 0x01,
-	0x0a, 0x18, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x47, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x68, 0x65, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x4a, 0x0a, 0x19, 0x43, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x80, 0x01, 0x0a, 0x18, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x64,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x1a, 0x48, 0x0a, 0x1a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b,
-	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2b, 0x0a, 0x13, 0x52, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x79, 0x57,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x48, 0x0a, 0x1a,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x71, 0x0a, 0x13, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x2c, 0x0a, 0x14, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
 	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
 	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x9e, 0x02, 0x0a, 0x20, 0x63, 0x6f, 0x6d, 0x2e,
 	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
@@ -223,15 +346,17 @@ func file_aperture_policy_decisions_v1_decisions_proto_rawDescGZIP() []byte {
 	return file_aperture_policy_decisions_v1_decisions_proto_rawDescData
 }
 
-var file_aperture_policy_decisions_v1_decisions_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_aperture_policy_decisions_v1_decisions_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_aperture_policy_decisions_v1_decisions_proto_goTypes = []interface{}{
-	(*LoadShedDecision)(nil),    // 0: aperture.policy.decisions.v1.LoadShedDecision
-	(*TokensDecision)(nil),      // 1: aperture.policy.decisions.v1.TokensDecision
-	(*RateLimiterDecision)(nil), // 2: aperture.policy.decisions.v1.RateLimiterDecision
-	nil,                         // 3: aperture.policy.decisions.v1.TokensDecision.TokensByWorkloadIndexEntry
+	(*LoadShedDecision)(nil),          // 0: aperture.policy.decisions.v1.LoadShedDecision
+	(*ConcurrencyBudgetDecision)(nil), // 1: aperture.policy.decisions.v1.ConcurrencyBudgetDecision
+	(*TokensDecision)(nil),            // 2: aperture.policy.decisions.v1.TokensDecision
+	(*RateLimiterDecision)(nil),       // 3: aperture.policy.decisions.v1.RateLimiterDecision
+	(*QuotaLimiterDecision)(nil),      // 4: aperture.policy.decisions.v1.QuotaLimiterDecision
+	nil,                               // 5: aperture.policy.decisions.v1.TokensDecision.TokensByWorkloadIndexEntry
 }
 var file_aperture_policy_decisions_v1_decisions_proto_depIdxs = []int32{
-	3, // 0: aperture.policy.decisions.v1.TokensDecision.tokens_by_workload_index:type_name -> aperture.policy.decisions.v1.TokensDecision.TokensByWorkloadIndexEntry
+	5, // 0: aperture.policy.decisions.v1.TokensDecision.tokens_by_workload_index:type_name -> aperture.policy.decisions.v1.TokensDecision.TokensByWorkloadIndexEntry
 	1, // [1:1] is the sub-list for method output_type
 	1, // [1:1] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
@@ -258,7 +383,7 @@ func file_aperture_policy_decisions_v1_decisions_proto_init() {
 			}
 		}
 		file_aperture_policy_decisions_v1_decisions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensDecision); i {
+			switch v := v.(*ConcurrencyBudgetDecision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -270,6 +395,18 @@ func file_aperture_policy_decisions_v1_decisions_proto_init() {
 			}
 		}
 		file_aperture_policy_decisions_v1_decisions_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_decisions_v1_decisions_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RateLimiterDecision); i {
 			case 0:
 				return &v.state
@@ -281,6 +418,18 @@ func file_aperture_policy_decisions_v1_decisions_proto_init() {
 				return nil
 			}
 		}
+		file_aperture_policy_decisions_v1_decisions_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaLimiterDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -288,7 +437,7 @@ func file_aperture_policy_decisions_v1_decisions_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_aperture_policy_decisions_v1_decisions_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
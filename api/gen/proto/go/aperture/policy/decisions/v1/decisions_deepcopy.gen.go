@@ -26,6 +26,27 @@ func (in *LoadShedDecision) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using ConcurrencyBudgetDecision within kubernetes types, where deepcopy-gen is used.
+func (in *ConcurrencyBudgetDecision) DeepCopyInto(out *ConcurrencyBudgetDecision) {
+	p := proto.Clone(in).(*ConcurrencyBudgetDecision)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyBudgetDecision. Required by controller-gen.
+func (in *ConcurrencyBudgetDecision) DeepCopy() *ConcurrencyBudgetDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(ConcurrencyBudgetDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyBudgetDecision. Required by controller-gen.
+func (in *ConcurrencyBudgetDecision) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using TokensDecision within kubernetes types, where deepcopy-gen is used.
 func (in *TokensDecision) DeepCopyInto(out *TokensDecision) {
 	p := proto.Clone(in).(*TokensDecision)
@@ -67,3 +88,24 @@ func (in *RateLimiterDecision) DeepCopy() *RateLimiterDecision {
 func (in *RateLimiterDecision) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
+
+// DeepCopyInto supports using QuotaLimiterDecision within kubernetes types, where deepcopy-gen is used.
+func (in *QuotaLimiterDecision) DeepCopyInto(out *QuotaLimiterDecision) {
+	p := proto.Clone(in).(*QuotaLimiterDecision)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimiterDecision. Required by controller-gen.
+func (in *QuotaLimiterDecision) DeepCopy() *QuotaLimiterDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaLimiterDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimiterDecision. Required by controller-gen.
+func (in *QuotaLimiterDecision) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
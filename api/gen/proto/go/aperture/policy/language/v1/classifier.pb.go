@@ -11,6 +11,7 @@ import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -51,7 +52,25 @@ type Classifier struct {
 	// A map of {key, value} pairs mapping from
 	// [flow label](/concepts/flow-control/flow-label.md) keys to rules that define
 	// how to extract and propagate flow labels with that key.
-	Rules map[string]*Rule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"required,gt=0,dive,keys,required,endkeys,required"` // @gotags: validate:"required,gt=0,dive,keys,required,endkeys,required"
+	//
+	// Either _rules_ or _response\_rules_ must be non-empty.
+	Rules map[string]*Rule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"required_without=ResponseRules,omitempty,gt=0,dive,keys,required,endkeys,required"` // @gotags: validate:"required_without=ResponseRules,omitempty,gt=0,dive,keys,required,endkeys,required"
+	// A map of {key, value} pairs mapping from flow label keys to rules that
+	// define how to extract flow labels with that key from the response.
+	//
+	// Response rules are evaluated when the access logs of a flow are processed,
+	// after the response is known. Labels created by these rules are available
+	// in telemetry and can be used in [FluxMeter](#v1-flux-meter) selectors,
+	// but not in selectors of other components, which are evaluated before the
+	// response.
+	//
+	// Example, metering latency split by cache hits and misses:
+	// ```yaml
+	// response_rules:
+	//   cache:
+	//     from: response.http.headers.x-cache
+	// ```
+	ResponseRules map[string]*ResponseRule `protobuf:"bytes,3,rep,name=response_rules,json=responseRules,proto3" json:"response_rules,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"required_without=Rules,omitempty,gt=0,dive,keys,required,endkeys,required"` // @gotags: validate:"required_without=Rules,omitempty,gt=0,dive,keys,required,endkeys,required"
 }
 
 func (x *Classifier) Reset() {
@@ -100,6 +119,71 @@ func (x *Classifier) GetRules() map[string]*Rule {
 	return nil
 }
 
+func (x *Classifier) GetResponseRules() map[string]*ResponseRule {
+	if x != nil {
+		return x.ResponseRules
+	}
+	return nil
+}
+
+// ResponseRule describes a flow classification rule that reads an attribute of the response
+//
+// :::note
+// Response headers are available to Envoy based integrations only when listed
+// in the access log configuration of the Envoy filter.
+// :::
+type ResponseRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Attribute path of the response attribute to use as flow label value.
+	//
+	// Should be either:
+	// * "response.http.status_code", or
+	// * "response.http.headers.<name>", with lowercase header name.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" validate:"required"` // @gotags: validate:"required"
+}
+
+func (x *ResponseRule) Reset() {
+	*x = ResponseRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseRule) ProtoMessage() {}
+
+func (x *ResponseRule) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseRule.ProtoReflect.Descriptor instead.
+func (*ResponseRule) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResponseRule) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
 // Rule describes a single Flow Classification Rule
 //
 // Flow classification rule extracts a value from request metadata.
@@ -143,6 +227,7 @@ type Rule struct {
 	// Types that are assignable to Source:
 	//	*Rule_Extractor
 	//	*Rule_Rego_
+	//	*Rule_Cel
 	Source isRule_Source `protobuf_oneof:"source"`
 	// Decides if the created flow label should be available as an attribute in OLAP telemetry and
 	// propagated in [baggage](/concepts/flow-control/flow-label.md#baggage))
@@ -157,12 +242,29 @@ type Rule struct {
 	// sensitive labels.
 	// :::
 	Telemetry bool `protobuf:"varint,3,opt,name=telemetry,proto3" json:"telemetry,omitempty" default:"true"` // @gotags: default:"true"
+	// Maximum number of distinct values of the flow label per agent, 0 means no limit.
+	//
+	// Values first seen after the limit is reached are replaced by `__overflow__`,
+	// both in telemetry and in policies. This protects telemetry and limiters keyed by
+	// the flow label from rules accidentally producing high-cardinality values (eg. `<ip>:<port>`).
+	MaxCardinality uint32 `protobuf:"varint,5,opt,name=max_cardinality,json=maxCardinality,proto3" json:"max_cardinality,omitempty"`
+	// Transformation of the flow label value in telemetry.
+	//
+	// * `none` – the value is used as is,
+	// * `hash` – the value is replaced by its keyed hash (truncated HMAC-SHA256
+	//   with a secret generated for the installation), so that it can't be
+	//   recovered by hashing guessed values without the secret,
+	// * `redact` – the value is replaced by `redacted`.
+	//
+	// Policies, including the ones of downstream services receiving the flow
+	// label in baggage, still use the original value.
+	TelemetryTransform string `protobuf:"bytes,6,opt,name=telemetry_transform,json=telemetryTransform,proto3" json:"telemetry_transform,omitempty" default:"none" validate:"oneof=none hash redact"` // @gotags: default:"none" validate:"oneof=none hash redact"
 }
 
 func (x *Rule) Reset() {
 	*x = Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[1]
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -175,7 +277,7 @@ func (x *Rule) String() string {
 func (*Rule) ProtoMessage() {}
 
 func (x *Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[1]
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +290,7 @@ func (x *Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rule.ProtoReflect.Descriptor instead.
 func (*Rule) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{1}
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{2}
 }
 
 func (m *Rule) GetSource() isRule_Source {
@@ -212,6 +314,13 @@ func (x *Rule) GetRego() *Rule_Rego {
 	return nil
 }
 
+func (x *Rule) GetCel() string {
+	if x, ok := x.GetSource().(*Rule_Cel); ok {
+		return x.Cel
+	}
+	return ""
+}
+
 func (x *Rule) GetTelemetry() bool {
 	if x != nil {
 		return x.Telemetry
@@ -219,6 +328,20 @@ func (x *Rule) GetTelemetry() bool {
 	return false
 }
 
+func (x *Rule) GetMaxCardinality() uint32 {
+	if x != nil {
+		return x.MaxCardinality
+	}
+	return 0
+}
+
+func (x *Rule) GetTelemetryTransform() string {
+	if x != nil {
+		return x.TelemetryTransform
+	}
+	return ""
+}
+
 type isRule_Source interface {
 	isRule_Source()
 }
@@ -233,10 +356,25 @@ type Rule_Rego_ struct {
 	Rego *Rule_Rego `protobuf:"bytes,2,opt,name=rego,proto3,oneof"`
 }
 
+type Rule_Cel struct {
+	// [CEL](https://github.com/google/cel-spec) expression evaluating to the value of the flow label.
+	//
+	// The expression is evaluated against the same input as rego rules, with
+	// its top-level fields (eg. `attributes`, `parsed_path`, `parsed_query`,
+	// `parsed_body`) available as variables. Non-string results are converted
+	// to strings. Lighter than rego for simple logic, eg.:
+	// ```
+	// "x-user" in attributes.request.http.headers ? attributes.request.http.headers["x-user"] : attributes.request.http.headers["x-client"]
+	// ```
+	Cel string `protobuf:"bytes,4,opt,name=cel,proto3,oneof"`
+}
+
 func (*Rule_Extractor) isRule_Source() {}
 
 func (*Rule_Rego_) isRule_Source() {}
 
+func (*Rule_Cel) isRule_Source() {}
+
 // Defines a high-level way to specify how to extract a flow label value given http request metadata, without a need to write rego code
 //
 // There are multiple variants of extractor, specify exactly one.
@@ -251,13 +389,16 @@ type Extractor struct {
 	//	*Extractor_Address
 	//	*Extractor_Jwt
 	//	*Extractor_PathTemplates
+	//	*Extractor_Cookie
+	//	*Extractor_Query
+	//	*Extractor_Regex
 	Variant isExtractor_Variant `protobuf_oneof:"variant"`
 }
 
 func (x *Extractor) Reset() {
 	*x = Extractor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[2]
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -270,7 +411,7 @@ func (x *Extractor) String() string {
 func (*Extractor) ProtoMessage() {}
 
 func (x *Extractor) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[2]
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +424,7 @@ func (x *Extractor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Extractor.ProtoReflect.Descriptor instead.
 func (*Extractor) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{2}
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{3}
 }
 
 func (m *Extractor) GetVariant() isExtractor_Variant {
@@ -328,6 +469,27 @@ func (x *Extractor) GetPathTemplates() *PathTemplateMatcher {
 	return nil
 }
 
+func (x *Extractor) GetCookie() *CookieExtractor {
+	if x, ok := x.GetVariant().(*Extractor_Cookie); ok {
+		return x.Cookie
+	}
+	return nil
+}
+
+func (x *Extractor) GetQuery() *QueryExtractor {
+	if x, ok := x.GetVariant().(*Extractor_Query); ok {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *Extractor) GetRegex() *RegexExtractor {
+	if x, ok := x.GetVariant().(*Extractor_Regex); ok {
+		return x.Regex
+	}
+	return nil
+}
+
 type isExtractor_Variant interface {
 	isExtractor_Variant()
 }
@@ -373,6 +535,21 @@ type Extractor_PathTemplates struct {
 	PathTemplates *PathTemplateMatcher `protobuf:"bytes,5,opt,name=path_templates,json=pathTemplates,proto3,oneof"`
 }
 
+type Extractor_Cookie struct {
+	// Read the value of a cookie.
+	Cookie *CookieExtractor `protobuf:"bytes,6,opt,name=cookie,proto3,oneof"`
+}
+
+type Extractor_Query struct {
+	// Read the value of a query parameter.
+	Query *QueryExtractor `protobuf:"bytes,7,opt,name=query,proto3,oneof"`
+}
+
+type Extractor_Regex struct {
+	// Match an attribute against a regular expression and read a capture group.
+	Regex *RegexExtractor `protobuf:"bytes,8,opt,name=regex,proto3,oneof"`
+}
+
 func (*Extractor_From) isExtractor_Variant() {}
 
 func (*Extractor_Json) isExtractor_Variant() {}
@@ -383,6 +560,12 @@ func (*Extractor_Jwt) isExtractor_Variant() {}
 
 func (*Extractor_PathTemplates) isExtractor_Variant() {}
 
+func (*Extractor_Cookie) isExtractor_Variant() {}
+
+func (*Extractor_Query) isExtractor_Variant() {}
+
+func (*Extractor_Regex) isExtractor_Variant() {}
+
 // Deserialize a json, and extract one of the fields
 //
 // Example:
@@ -407,7 +590,7 @@ type JSONExtractor struct {
 func (x *JSONExtractor) Reset() {
 	*x = JSONExtractor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[3]
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -420,7 +603,7 @@ func (x *JSONExtractor) String() string {
 func (*JSONExtractor) ProtoMessage() {}
 
 func (x *JSONExtractor) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[3]
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,7 +616,7 @@ func (x *JSONExtractor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JSONExtractor.ProtoReflect.Descriptor instead.
 func (*JSONExtractor) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{3}
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *JSONExtractor) GetFrom() string {
@@ -475,7 +658,7 @@ type AddressExtractor struct {
 func (x *AddressExtractor) Reset() {
 	*x = AddressExtractor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[4]
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -488,7 +671,7 @@ func (x *AddressExtractor) String() string {
 func (*AddressExtractor) ProtoMessage() {}
 
 func (x *AddressExtractor) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[4]
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,7 +684,7 @@ func (x *AddressExtractor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressExtractor.ProtoReflect.Descriptor instead.
 func (*AddressExtractor) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{4}
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AddressExtractor) GetFrom() string {
@@ -511,12 +694,200 @@ func (x *AddressExtractor) GetFrom() string {
 	return ""
 }
 
+// Read the value of a cookie from the "cookie" request header
+//
+// If the cookie is set multiple times, the first value is used.
+//
+// Example:
+// ```yaml
+// name: session
+// ```
+type CookieExtractor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the cookie.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" validate:"required"` //@gotags: validate:"required"
+}
+
+func (x *CookieExtractor) Reset() {
+	*x = CookieExtractor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CookieExtractor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookieExtractor) ProtoMessage() {}
+
+func (x *CookieExtractor) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookieExtractor.ProtoReflect.Descriptor instead.
+func (*CookieExtractor) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CookieExtractor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Read the value of a query parameter from the query string of "request.http.path"
+//
+// The value is URL-decoded. If the parameter is set multiple times, the first value is used.
+//
+// Example:
+// ```yaml
+// name: user_id
+// ```
+type QueryExtractor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the query parameter.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" validate:"required"` //@gotags: validate:"required"
+}
+
+func (x *QueryExtractor) Reset() {
+	*x = QueryExtractor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryExtractor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExtractor) ProtoMessage() {}
+
+func (x *QueryExtractor) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryExtractor.ProtoReflect.Descriptor instead.
+func (*QueryExtractor) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryExtractor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Match an attribute against a regular expression and read a capture group
+//
+// No label is produced if the attribute doesn't match. The first match is used.
+//
+// Example:
+// ```yaml
+// from: request.http.headers.user-agent
+// regex: "^([a-zA-Z]+)/"
+// capture_group: 1
+// ```
+type RegexExtractor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Attribute path pointing to some string - eg. "request.http.headers.user-agent".
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" validate:"required"` //@gotags: validate:"required"
+	// Regular expression in [RE2 syntax](https://github.com/google/re2/wiki/Syntax).
+	Regex string `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty" validate:"required"` //@gotags: validate:"required"
+	// Index of the capture group to read, 0 reads the whole match.
+	CaptureGroup int32 `protobuf:"varint,3,opt,name=capture_group,json=captureGroup,proto3" json:"capture_group,omitempty" validate:"gte=0"` //@gotags: validate:"gte=0"
+}
+
+func (x *RegexExtractor) Reset() {
+	*x = RegexExtractor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegexExtractor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegexExtractor) ProtoMessage() {}
+
+func (x *RegexExtractor) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegexExtractor.ProtoReflect.Descriptor instead.
+func (*RegexExtractor) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RegexExtractor) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RegexExtractor) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *RegexExtractor) GetCaptureGroup() int32 {
+	if x != nil {
+		return x.CaptureGroup
+	}
+	return 0
+}
+
 // Parse the attribute as JWT and read the payload
 //
 // Specify a field to be extracted from payload using "json_pointer".
 //
-// Note: The signature is not verified against the secret (we're assuming there's some
-// other parts of the system that handles such verification).
+// Note: The signature is not verified unless "verification" is set (otherwise we're
+// assuming there's some other parts of the system that handles such verification).
 //
 // Example:
 // ```yaml
@@ -535,12 +906,21 @@ type JWTExtractor struct {
 	// Note: Uses [json pointer](https://datatracker.ietf.org/doc/html/rfc6901) syntax,
 	// eg. `/foo/bar`. If the pointer points into an object, it'd be stringified.
 	JsonPointer string `protobuf:"bytes,2,opt,name=json_pointer,json=jsonPointer,proto3" json:"json_pointer,omitempty"`
+	// Verification of the JWT signature against a JWKS document.
+	//
+	// If set, no label is produced for tokens that fail verification, and the
+	// failure is reported with `ERROR_JWT_VERIFICATION_FAILED` in the classifier
+	// info of the flow. If not set, the payload is read without verification.
+	//
+	// The JWKS document is loaded in background when the classifier is loaded,
+	// flows classified before it's available fail verification.
+	Verification *JWTExtractor_Verification `protobuf:"bytes,3,opt,name=verification,proto3" json:"verification,omitempty"`
 }
 
 func (x *JWTExtractor) Reset() {
 	*x = JWTExtractor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[5]
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -553,7 +933,7 @@ func (x *JWTExtractor) String() string {
 func (*JWTExtractor) ProtoMessage() {}
 
 func (x *JWTExtractor) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[5]
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,7 +946,7 @@ func (x *JWTExtractor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JWTExtractor.ProtoReflect.Descriptor instead.
 func (*JWTExtractor) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{5}
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *JWTExtractor) GetFrom() string {
@@ -583,6 +963,13 @@ func (x *JWTExtractor) GetJsonPointer() string {
 	return ""
 }
 
+func (x *JWTExtractor) GetVerification() *JWTExtractor_Verification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
 // Matches HTTP Path to given path templates
 //
 // HTTP path will be matched against given path templates.
@@ -620,7 +1007,7 @@ type PathTemplateMatcher struct {
 func (x *PathTemplateMatcher) Reset() {
 	*x = PathTemplateMatcher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[6]
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -633,7 +1020,7 @@ func (x *PathTemplateMatcher) String() string {
 func (*PathTemplateMatcher) ProtoMessage() {}
 
 func (x *PathTemplateMatcher) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[6]
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +1033,7 @@ func (x *PathTemplateMatcher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PathTemplateMatcher.ProtoReflect.Descriptor instead.
 func (*PathTemplateMatcher) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{6}
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PathTemplateMatcher) GetTemplateValues() map[string]string {
@@ -677,7 +1064,7 @@ type Rule_Rego struct {
 func (x *Rule_Rego) Reset() {
 	*x = Rule_Rego{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[8]
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -690,7 +1077,7 @@ func (x *Rule_Rego) String() string {
 func (*Rule_Rego) ProtoMessage() {}
 
 func (x *Rule_Rego) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[8]
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +1090,7 @@ func (x *Rule_Rego) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rule_Rego.ProtoReflect.Descriptor instead.
 func (*Rule_Rego) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{1, 0}
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *Rule_Rego) GetSource() string {
@@ -720,6 +1107,95 @@ func (x *Rule_Rego) GetQuery() string {
 	return ""
 }
 
+type JWTExtractor_Verification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL of the JWKS document, eg. `https://example.com/.well-known/jwks.json`.
+	//
+	// One of _jwks\_url_ and _jwks\_file_ must be set.
+	JwksUrl string `protobuf:"bytes,1,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url,omitempty" validate:"required_without=JwksFile"` // @gotags: validate:"required_without=JwksFile"
+	// Path of a local file with the JWKS document.
+	JwksFile string `protobuf:"bytes,2,opt,name=jwks_file,json=jwksFile,proto3" json:"jwks_file,omitempty" validate:"required_without=JwksUrl"` // @gotags: validate:"required_without=JwksUrl"
+	// How often the JWKS document is reloaded.
+	JwksRefreshInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=jwks_refresh_interval,json=jwksRefreshInterval,proto3" json:"jwks_refresh_interval,omitempty" default:"300s"` // @gotags: default:"300s"
+	// Expected issuer of the token (`iss` claim), not checked if empty.
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Expected audience of the token (`aud` claim).
+	//
+	// Must be set if tokens carry the `aud` claim, as such tokens fail
+	// verification when no audience is expected.
+	Audience string `protobuf:"bytes,5,opt,name=audience,proto3" json:"audience,omitempty"`
+}
+
+func (x *JWTExtractor_Verification) Reset() {
+	*x = JWTExtractor_Verification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JWTExtractor_Verification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWTExtractor_Verification) ProtoMessage() {}
+
+func (x *JWTExtractor_Verification) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_classifier_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWTExtractor_Verification.ProtoReflect.Descriptor instead.
+func (*JWTExtractor_Verification) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_classifier_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *JWTExtractor_Verification) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+func (x *JWTExtractor_Verification) GetJwksFile() string {
+	if x != nil {
+		return x.JwksFile
+	}
+	return ""
+}
+
+func (x *JWTExtractor_Verification) GetJwksRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.JwksRefreshInterval
+	}
+	return nil
+}
+
+func (x *JWTExtractor_Verification) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *JWTExtractor_Verification) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
 var File_aperture_policy_language_v1_classifier_proto protoreflect.FileDescriptor
 
 var file_aperture_policy_language_v1_classifier_proto_rawDesc = []byte{
@@ -730,126 +1206,227 @@ var file_aperture_policy_language_v1_classifier_proto_rawDesc = []byte{
 	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x2a, 0x61, 0x70, 0x65,
 	0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6c,
 	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
 	0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x02, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x05, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x73,
 	0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x64, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
 	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
 	0x75, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x73, 0x65, 0x6c, 0x65, 0x63,
 	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42,
 	0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61,
 	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x94, 0x01, 0x0a,
+	0x65, 0x64, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0xb4, 0x01, 0x0a,
 	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61,
 	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
 	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73,
 	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x4a, 0x92, 0x41, 0x47, 0x82, 0x03, 0x44, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x33, 0x1a, 0x31, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x2c, 0x67, 0x74, 0x3d, 0x30, 0x2c, 0x64, 0x69, 0x76, 0x65, 0x2c, 0x6b, 0x65,
+	0x42, 0x6a, 0x92, 0x41, 0x67, 0x82, 0x03, 0x64, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x53, 0x1a, 0x51, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x3d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2c, 0x67, 0x74, 0x3d, 0x30, 0x2c, 0x64, 0x69, 0x76, 0x65, 0x2c, 0x6b, 0x65,
 	0x79, 0x73, 0x2c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x65, 0x6e, 0x64, 0x6b,
 	0x65, 0x79, 0x73, 0x2c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xd3, 0x02, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61,
+	0x6c, 0x65, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x61,
 	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
-	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x12, 0x3c, 0x0a, 0x04, 0x72, 0x65, 0x67, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
-	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x72, 0x65, 0x67, 0x6f, 0x12,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x62, 0x92, 0x41, 0x5f, 0x82, 0x03, 0x5c,
+	0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x4b, 0x1a, 0x49, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x6f, 0x75, 0x74, 0x3d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2c, 0x67, 0x74, 0x3d, 0x30, 0x2c, 0x64, 0x69, 0x76, 0x65, 0x2c, 0x6b, 0x65,
+	0x79, 0x73, 0x2c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x65, 0x6e, 0x64, 0x6b,
+	0x65, 0x79, 0x73, 0x2c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x0d, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x0a, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6b, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x3f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67,
+	0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x8b, 0x04, 0x0a,
+	0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a,
+	0x04, 0x72, 0x65, 0x67, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x52,
+	0x65, 0x67, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x72, 0x65, 0x67, 0x6f, 0x12, 0x12, 0x0a, 0x03, 0x63,
+	0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x63, 0x65, 0x6c, 0x12,
 	0x3f, 0x0a, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x08, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f,
 	0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71,
 	0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79,
-	0x1a, 0x7a, 0x0a, 0x04, 0x52, 0x65, 0x67, 0x6f, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b,
-	0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f,
-	0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x42, 0x08, 0x0a, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xd3, 0x02, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x04, 0x6a, 0x73,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x79, 0x0a, 0x13, 0x74, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x48, 0x92, 0x41, 0x45, 0x82, 0x03, 0x16, 0x0a, 0x0c,
+	0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x06, 0x1a, 0x04,
+	0x6e, 0x6f, 0x6e, 0x65, 0x82, 0x03, 0x29, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x1a, 0x16, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d,
+	0x6e, 0x6f, 0x6e, 0x65, 0x20, 0x68, 0x61, 0x73, 0x68, 0x20, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74,
+	0x52, 0x12, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x1a, 0x7a, 0x0a, 0x04, 0x52, 0x65, 0x67, 0x6f, 0x12, 0x39, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41,
+	0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d,
+	0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xa5, 0x04, 0x0a, 0x09, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x40,
+	0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x49, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x03, 0x6a,
+	0x77, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
 	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
-	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4a, 0x57, 0x54, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x48,
-	0x00, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
-	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74,
-	0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
-	0x48, 0x00, 0x52, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x0d,
-	0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a,
-	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e,
-	0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x49,
-	0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x57, 0x54, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x70, 0x61,
+	0x74, 0x68, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x43, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x43, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x22, 0x60, 0x0a, 0x0d, 0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x22, 0x49, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d,
+	0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22,
+	0x48, 0x0a, 0x0f, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x35, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76,
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x68, 0x0a, 0x0c, 0x4a, 0x57, 0x54,
-	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a,
-	0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a,
-	0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x12, 0x21, 0x0a, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x22, 0xeb, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x90, 0x01, 0x0a, 0x0f,
-	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x21, 0x92, 0x41, 0x1e,
+	0x72, 0x65, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x0e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03,
+	0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67,
+	0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x37, 0x0a, 0x05,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e,
 	0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x0e,
-	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x41,
-	0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x42, 0x98, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x55, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x75, 0x78, 0x6e, 0x69, 0x6e, 0x6a, 0x61, 0x2f, 0x61,
-	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
-	0x65, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x41, 0x50, 0x4c, 0xaa, 0x02, 0x1b, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x1b, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x27, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x5c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x41, 0x70,
-	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x3a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x3a,
-	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x05,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1e, 0x92, 0x41,
+	0x1b, 0x82, 0x03, 0x18, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x07, 0x1a, 0x05, 0x67, 0x74, 0x65, 0x3d, 0x30, 0x52, 0x0c, 0x63, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x95, 0x04, 0x0a, 0x0c, 0x4a,
+	0x57, 0x54, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03,
+	0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x57, 0x54, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0xce, 0x02, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x82, 0x03, 0x2c, 0x0a, 0x0d, 0x78, 0x2d,
+	0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x1a, 0x19, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x3d,
+	0x4a, 0x77, 0x6b, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x6a, 0x77, 0x6b, 0x73, 0x55, 0x72,
+	0x6c, 0x12, 0x4e, 0x0a, 0x09, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0x92, 0x41, 0x2e, 0x82, 0x03, 0x2b, 0x0a, 0x0d, 0x78, 0x2d,
+	0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x1a, 0x18, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x3d,
+	0x4a, 0x77, 0x6b, 0x73, 0x55, 0x72, 0x6c, 0x52, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x6b, 0x0a, 0x15, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1c, 0x92, 0x41, 0x19,
+	0x82, 0x03, 0x16, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x06, 0x1a, 0x04, 0x33, 0x30, 0x30, 0x73, 0x52, 0x13, 0x6a, 0x77, 0x6b, 0x73, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x90, 0x01, 0x0a, 0x0f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82,
+	0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x0e, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x41, 0x0a,
+	0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x42, 0x98, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x55, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x75, 0x78, 0x6e, 0x69, 0x6e, 0x6a, 0x61, 0x2f, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x41, 0x50, 0x4c, 0xaa, 0x02, 0x1b, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x1b, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x27, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x5c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x41, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x3a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x3a, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -864,36 +1441,50 @@ func file_aperture_policy_language_v1_classifier_proto_rawDescGZIP() []byte {
 	return file_aperture_policy_language_v1_classifier_proto_rawDescData
 }
 
-var file_aperture_policy_language_v1_classifier_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_aperture_policy_language_v1_classifier_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_aperture_policy_language_v1_classifier_proto_goTypes = []interface{}{
-	(*Classifier)(nil),          // 0: aperture.policy.language.v1.Classifier
-	(*Rule)(nil),                // 1: aperture.policy.language.v1.Rule
-	(*Extractor)(nil),           // 2: aperture.policy.language.v1.Extractor
-	(*JSONExtractor)(nil),       // 3: aperture.policy.language.v1.JSONExtractor
-	(*AddressExtractor)(nil),    // 4: aperture.policy.language.v1.AddressExtractor
-	(*JWTExtractor)(nil),        // 5: aperture.policy.language.v1.JWTExtractor
-	(*PathTemplateMatcher)(nil), // 6: aperture.policy.language.v1.PathTemplateMatcher
-	nil,                         // 7: aperture.policy.language.v1.Classifier.RulesEntry
-	(*Rule_Rego)(nil),           // 8: aperture.policy.language.v1.Rule.Rego
-	nil,                         // 9: aperture.policy.language.v1.PathTemplateMatcher.TemplateValuesEntry
-	(*v1.Selector)(nil),         // 10: aperture.common.selector.v1.Selector
+	(*Classifier)(nil),                // 0: aperture.policy.language.v1.Classifier
+	(*ResponseRule)(nil),              // 1: aperture.policy.language.v1.ResponseRule
+	(*Rule)(nil),                      // 2: aperture.policy.language.v1.Rule
+	(*Extractor)(nil),                 // 3: aperture.policy.language.v1.Extractor
+	(*JSONExtractor)(nil),             // 4: aperture.policy.language.v1.JSONExtractor
+	(*AddressExtractor)(nil),          // 5: aperture.policy.language.v1.AddressExtractor
+	(*CookieExtractor)(nil),           // 6: aperture.policy.language.v1.CookieExtractor
+	(*QueryExtractor)(nil),            // 7: aperture.policy.language.v1.QueryExtractor
+	(*RegexExtractor)(nil),            // 8: aperture.policy.language.v1.RegexExtractor
+	(*JWTExtractor)(nil),              // 9: aperture.policy.language.v1.JWTExtractor
+	(*PathTemplateMatcher)(nil),       // 10: aperture.policy.language.v1.PathTemplateMatcher
+	nil,                               // 11: aperture.policy.language.v1.Classifier.RulesEntry
+	nil,                               // 12: aperture.policy.language.v1.Classifier.ResponseRulesEntry
+	(*Rule_Rego)(nil),                 // 13: aperture.policy.language.v1.Rule.Rego
+	(*JWTExtractor_Verification)(nil), // 14: aperture.policy.language.v1.JWTExtractor.Verification
+	nil,                               // 15: aperture.policy.language.v1.PathTemplateMatcher.TemplateValuesEntry
+	(*v1.Selector)(nil),               // 16: aperture.common.selector.v1.Selector
+	(*durationpb.Duration)(nil),       // 17: google.protobuf.Duration
 }
 var file_aperture_policy_language_v1_classifier_proto_depIdxs = []int32{
-	10, // 0: aperture.policy.language.v1.Classifier.selector:type_name -> aperture.common.selector.v1.Selector
-	7,  // 1: aperture.policy.language.v1.Classifier.rules:type_name -> aperture.policy.language.v1.Classifier.RulesEntry
-	2,  // 2: aperture.policy.language.v1.Rule.extractor:type_name -> aperture.policy.language.v1.Extractor
-	8,  // 3: aperture.policy.language.v1.Rule.rego:type_name -> aperture.policy.language.v1.Rule.Rego
-	3,  // 4: aperture.policy.language.v1.Extractor.json:type_name -> aperture.policy.language.v1.JSONExtractor
-	4,  // 5: aperture.policy.language.v1.Extractor.address:type_name -> aperture.policy.language.v1.AddressExtractor
-	5,  // 6: aperture.policy.language.v1.Extractor.jwt:type_name -> aperture.policy.language.v1.JWTExtractor
-	6,  // 7: aperture.policy.language.v1.Extractor.path_templates:type_name -> aperture.policy.language.v1.PathTemplateMatcher
-	9,  // 8: aperture.policy.language.v1.PathTemplateMatcher.template_values:type_name -> aperture.policy.language.v1.PathTemplateMatcher.TemplateValuesEntry
-	1,  // 9: aperture.policy.language.v1.Classifier.RulesEntry.value:type_name -> aperture.policy.language.v1.Rule
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	16, // 0: aperture.policy.language.v1.Classifier.selector:type_name -> aperture.common.selector.v1.Selector
+	11, // 1: aperture.policy.language.v1.Classifier.rules:type_name -> aperture.policy.language.v1.Classifier.RulesEntry
+	12, // 2: aperture.policy.language.v1.Classifier.response_rules:type_name -> aperture.policy.language.v1.Classifier.ResponseRulesEntry
+	3,  // 3: aperture.policy.language.v1.Rule.extractor:type_name -> aperture.policy.language.v1.Extractor
+	13, // 4: aperture.policy.language.v1.Rule.rego:type_name -> aperture.policy.language.v1.Rule.Rego
+	4,  // 5: aperture.policy.language.v1.Extractor.json:type_name -> aperture.policy.language.v1.JSONExtractor
+	5,  // 6: aperture.policy.language.v1.Extractor.address:type_name -> aperture.policy.language.v1.AddressExtractor
+	9,  // 7: aperture.policy.language.v1.Extractor.jwt:type_name -> aperture.policy.language.v1.JWTExtractor
+	10, // 8: aperture.policy.language.v1.Extractor.path_templates:type_name -> aperture.policy.language.v1.PathTemplateMatcher
+	6,  // 9: aperture.policy.language.v1.Extractor.cookie:type_name -> aperture.policy.language.v1.CookieExtractor
+	7,  // 10: aperture.policy.language.v1.Extractor.query:type_name -> aperture.policy.language.v1.QueryExtractor
+	8,  // 11: aperture.policy.language.v1.Extractor.regex:type_name -> aperture.policy.language.v1.RegexExtractor
+	14, // 12: aperture.policy.language.v1.JWTExtractor.verification:type_name -> aperture.policy.language.v1.JWTExtractor.Verification
+	15, // 13: aperture.policy.language.v1.PathTemplateMatcher.template_values:type_name -> aperture.policy.language.v1.PathTemplateMatcher.TemplateValuesEntry
+	2,  // 14: aperture.policy.language.v1.Classifier.RulesEntry.value:type_name -> aperture.policy.language.v1.Rule
+	1,  // 15: aperture.policy.language.v1.Classifier.ResponseRulesEntry.value:type_name -> aperture.policy.language.v1.ResponseRule
+	17, // 16: aperture.policy.language.v1.JWTExtractor.Verification.jwks_refresh_interval:type_name -> google.protobuf.Duration
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_aperture_policy_language_v1_classifier_proto_init() }
@@ -915,7 +1506,7 @@ func file_aperture_policy_language_v1_classifier_proto_init() {
 			}
 		}
 		file_aperture_policy_language_v1_classifier_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rule); i {
+			switch v := v.(*ResponseRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -927,7 +1518,7 @@ func file_aperture_policy_language_v1_classifier_proto_init() {
 			}
 		}
 		file_aperture_policy_language_v1_classifier_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Extractor); i {
+			switch v := v.(*Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -939,7 +1530,7 @@ func file_aperture_policy_language_v1_classifier_proto_init() {
 			}
 		}
 		file_aperture_policy_language_v1_classifier_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JSONExtractor); i {
+			switch v := v.(*Extractor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -951,7 +1542,7 @@ func file_aperture_policy_language_v1_classifier_proto_init() {
 			}
 		}
 		file_aperture_policy_language_v1_classifier_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressExtractor); i {
+			switch v := v.(*JSONExtractor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -963,7 +1554,7 @@ func file_aperture_policy_language_v1_classifier_proto_init() {
 			}
 		}
 		file_aperture_policy_language_v1_classifier_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JWTExtractor); i {
+			switch v := v.(*AddressExtractor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -975,7 +1566,19 @@ func file_aperture_policy_language_v1_classifier_proto_init() {
 			}
 		}
 		file_aperture_policy_language_v1_classifier_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PathTemplateMatcher); i {
+			switch v := v.(*CookieExtractor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_classifier_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryExtractor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -987,6 +1590,42 @@ func file_aperture_policy_language_v1_classifier_proto_init() {
 			}
 		}
 		file_aperture_policy_language_v1_classifier_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegexExtractor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_classifier_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JWTExtractor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_classifier_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathTemplateMatcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_classifier_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rule_Rego); i {
 			case 0:
 				return &v.state
@@ -998,17 +1637,33 @@ func file_aperture_policy_language_v1_classifier_proto_init() {
 				return nil
 			}
 		}
+		file_aperture_policy_language_v1_classifier_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JWTExtractor_Verification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_aperture_policy_language_v1_classifier_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_aperture_policy_language_v1_classifier_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*Rule_Extractor)(nil),
 		(*Rule_Rego_)(nil),
+		(*Rule_Cel)(nil),
 	}
-	file_aperture_policy_language_v1_classifier_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_aperture_policy_language_v1_classifier_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*Extractor_From)(nil),
 		(*Extractor_Json)(nil),
 		(*Extractor_Address)(nil),
 		(*Extractor_Jwt)(nil),
 		(*Extractor_PathTemplates)(nil),
+		(*Extractor_Cookie)(nil),
+		(*Extractor_Query)(nil),
+		(*Extractor_Regex)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1016,7 +1671,7 @@ func file_aperture_policy_language_v1_classifier_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_aperture_policy_language_v1_classifier_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
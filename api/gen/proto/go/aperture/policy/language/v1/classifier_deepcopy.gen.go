@@ -26,6 +26,27 @@ func (in *Classifier) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using ResponseRule within kubernetes types, where deepcopy-gen is used.
+func (in *ResponseRule) DeepCopyInto(out *ResponseRule) {
+	p := proto.Clone(in).(*ResponseRule)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseRule. Required by controller-gen.
+func (in *ResponseRule) DeepCopy() *ResponseRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ResponseRule. Required by controller-gen.
+func (in *ResponseRule) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using Rule within kubernetes types, where deepcopy-gen is used.
 func (in *Rule) DeepCopyInto(out *Rule) {
 	p := proto.Clone(in).(*Rule)
@@ -131,6 +152,69 @@ func (in *AddressExtractor) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using CookieExtractor within kubernetes types, where deepcopy-gen is used.
+func (in *CookieExtractor) DeepCopyInto(out *CookieExtractor) {
+	p := proto.Clone(in).(*CookieExtractor)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CookieExtractor. Required by controller-gen.
+func (in *CookieExtractor) DeepCopy() *CookieExtractor {
+	if in == nil {
+		return nil
+	}
+	out := new(CookieExtractor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new CookieExtractor. Required by controller-gen.
+func (in *CookieExtractor) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using QueryExtractor within kubernetes types, where deepcopy-gen is used.
+func (in *QueryExtractor) DeepCopyInto(out *QueryExtractor) {
+	p := proto.Clone(in).(*QueryExtractor)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryExtractor. Required by controller-gen.
+func (in *QueryExtractor) DeepCopy() *QueryExtractor {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryExtractor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new QueryExtractor. Required by controller-gen.
+func (in *QueryExtractor) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using RegexExtractor within kubernetes types, where deepcopy-gen is used.
+func (in *RegexExtractor) DeepCopyInto(out *RegexExtractor) {
+	p := proto.Clone(in).(*RegexExtractor)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexExtractor. Required by controller-gen.
+func (in *RegexExtractor) DeepCopy() *RegexExtractor {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexExtractor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new RegexExtractor. Required by controller-gen.
+func (in *RegexExtractor) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using JWTExtractor within kubernetes types, where deepcopy-gen is used.
 func (in *JWTExtractor) DeepCopyInto(out *JWTExtractor) {
 	p := proto.Clone(in).(*JWTExtractor)
@@ -152,6 +236,27 @@ func (in *JWTExtractor) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using JWTExtractor_Verification within kubernetes types, where deepcopy-gen is used.
+func (in *JWTExtractor_Verification) DeepCopyInto(out *JWTExtractor_Verification) {
+	p := proto.Clone(in).(*JWTExtractor_Verification)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTExtractor_Verification. Required by controller-gen.
+func (in *JWTExtractor_Verification) DeepCopy() *JWTExtractor_Verification {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTExtractor_Verification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new JWTExtractor_Verification. Required by controller-gen.
+func (in *JWTExtractor_Verification) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using PathTemplateMatcher within kubernetes types, where deepcopy-gen is used.
 func (in *PathTemplateMatcher) DeepCopyInto(out *PathTemplateMatcher) {
 	p := proto.Clone(in).(*PathTemplateMatcher)
@@ -26,6 +26,53 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Calendar window of the quota.
+type QuotaLimiter_Window int32
+
+const (
+	QuotaLimiter_WINDOW_DAY   QuotaLimiter_Window = 0
+	QuotaLimiter_WINDOW_MONTH QuotaLimiter_Window = 1
+)
+
+// Enum value maps for QuotaLimiter_Window.
+var (
+	QuotaLimiter_Window_name = map[int32]string{
+		0: "WINDOW_DAY",
+		1: "WINDOW_MONTH",
+	}
+	QuotaLimiter_Window_value = map[string]int32{
+		"WINDOW_DAY":   0,
+		"WINDOW_MONTH": 1,
+	}
+)
+
+func (x QuotaLimiter_Window) Enum() *QuotaLimiter_Window {
+	p := new(QuotaLimiter_Window)
+	*p = x
+	return p
+}
+
+func (x QuotaLimiter_Window) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuotaLimiter_Window) Descriptor() protoreflect.EnumDescriptor {
+	return file_aperture_policy_language_v1_policy_proto_enumTypes[0].Descriptor()
+}
+
+func (QuotaLimiter_Window) Type() protoreflect.EnumType {
+	return &file_aperture_policy_language_v1_policy_proto_enumTypes[0]
+}
+
+func (x QuotaLimiter_Window) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuotaLimiter_Window.Descriptor instead.
+func (QuotaLimiter_Window) EnumDescriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{17, 0}
+}
+
 type AllPoliciesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -120,6 +167,108 @@ func (x *AllPolicies) GetAllPolicies() map[string]*Policy {
 	return nil
 }
 
+type UpsertPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolicyName string  `protobuf:"bytes,1,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	Policy     *Policy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *UpsertPolicyRequest) Reset() {
+	*x = UpsertPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPolicyRequest) ProtoMessage() {}
+
+func (x *UpsertPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpsertPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpsertPolicyRequest) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *UpsertPolicyRequest) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type DeletePolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolicyName string `protobuf:"bytes,1,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+}
+
+func (x *DeletePolicyRequest) Reset() {
+	*x = DeletePolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePolicyRequest) ProtoMessage() {}
+
+func (x *DeletePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeletePolicyRequest) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
 // Policy expresses reliability automation workflow that automatically protects services
 //
 // :::info
@@ -136,12 +285,15 @@ type Policy struct {
 	Circuit *Circuit `protobuf:"bytes,1,opt,name=circuit,proto3" json:"circuit,omitempty"`
 	// Resources (FluxMeters, Classifiers etc.) to setup.
 	Resources *Resources `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	// Response sent to clients of flows rejected by the limiters of this policy.
+	// Limiters can override it with their own _rejection\_response_.
+	RejectionResponse *RejectionResponse `protobuf:"bytes,3,opt,name=rejection_response,json=rejectionResponse,proto3" json:"rejection_response,omitempty"`
 }
 
 func (x *Policy) Reset() {
 	*x = Policy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[2]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -154,7 +306,7 @@ func (x *Policy) String() string {
 func (*Policy) ProtoMessage() {}
 
 func (x *Policy) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[2]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +319,7 @@ func (x *Policy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Policy.ProtoReflect.Descriptor instead.
 func (*Policy) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{2}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Policy) GetCircuit() *Circuit {
@@ -184,6 +336,95 @@ func (x *Policy) GetResources() *Resources {
 	return nil
 }
 
+func (x *Policy) GetRejectionResponse() *RejectionResponse {
+	if x != nil {
+		return x.RejectionResponse
+	}
+	return nil
+}
+
+// Response sent to the client when a limiter rejects a flow
+//
+// :::info
+// Used by the Envoy ext_authz integration as the denied HTTP response.
+// :::
+//
+// Without a rejection response, rate limited flows are rejected with 429 (Too Many Requests)
+// and concurrency limited flows with 503 (Service Unavailable), without a `Retry-After` header.
+//
+// A `Retry-After` header is added to the response, unless set in _headers_.
+// It's derived from the _limit\_reset\_interval_ of the
+// [RateLimiter](#v1-rate-limiter) (in token bucket mode, from the time it takes
+// to refill the tokens the flow is missing), the _max\_timeout_ of the
+// [Scheduler](#v1-scheduler) of the [ConcurrencyLimiter](#v1-concurrency-limiter)
+// and the end of the window of the [QuotaLimiter](#v1-quota-limiter).
+type RejectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// HTTP status code of the response.
+	// Defaults to 429 (Too Many Requests) for rate limiters and 503 (Service Unavailable) for concurrency limiters.
+	HttpStatusCode uint32 `protobuf:"varint,1,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty" validate:"omitempty,gte=400,lte=599"` // @gotags: validate:"omitempty,gte=400,lte=599"
+	// Headers of the response.
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Body of the response.
+	Body string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *RejectionResponse) Reset() {
+	*x = RejectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectionResponse) ProtoMessage() {}
+
+func (x *RejectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectionResponse.ProtoReflect.Descriptor instead.
+func (*RejectionResponse) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RejectionResponse) GetHttpStatusCode() uint32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *RejectionResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RejectionResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
 // Circuit is defined as a dataflow graph of inter-connected components
 //
 // :::info
@@ -224,7 +465,7 @@ type Circuit struct {
 func (x *Circuit) Reset() {
 	*x = Circuit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[3]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -237,7 +478,7 @@ func (x *Circuit) String() string {
 func (*Circuit) ProtoMessage() {}
 
 func (x *Circuit) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[3]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +491,7 @@ func (x *Circuit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Circuit.ProtoReflect.Descriptor instead.
 func (*Circuit) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{3}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Circuit) GetEvaluationInterval() *durationpb.Duration {
@@ -292,7 +533,7 @@ type Resources struct {
 func (x *Resources) Reset() {
 	*x = Resources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[4]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -305,7 +546,7 @@ func (x *Resources) String() string {
 func (*Resources) ProtoMessage() {}
 
 func (x *Resources) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[4]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -318,7 +559,7 @@ func (x *Resources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resources.ProtoReflect.Descriptor instead.
 func (*Resources) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{4}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Resources) GetFluxMeters() map[string]*FluxMeter {
@@ -390,13 +631,24 @@ type Component struct {
 	//	*Component_Extrapolator
 	//	*Component_Max
 	//	*Component_Min
+	//	*Component_PidController
+	//	*Component_QuotaLimiter
+	//	*Component_HoltWinters
+	//	*Component_Integrator
+	//	*Component_Differentiator
+	//	*Component_Delta
+	//	*Component_Schedule
+	//	*Component_Variable
+	//	*Component_Holder
+	//	*Component_FirstValid
+	//	*Component_Inverter
 	Component isComponent_Component `protobuf_oneof:"component"`
 }
 
 func (x *Component) Reset() {
 	*x = Component{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[5]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -409,7 +661,7 @@ func (x *Component) String() string {
 func (*Component) ProtoMessage() {}
 
 func (x *Component) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[5]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +674,7 @@ func (x *Component) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Component.ProtoReflect.Descriptor instead.
 func (*Component) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{5}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{8}
 }
 
 func (m *Component) GetComponent() isComponent_Component {
@@ -523,6 +775,83 @@ func (x *Component) GetMin() *Min {
 	return nil
 }
 
+func (x *Component) GetPidController() *PIDController {
+	if x, ok := x.GetComponent().(*Component_PidController); ok {
+		return x.PidController
+	}
+	return nil
+}
+
+func (x *Component) GetQuotaLimiter() *QuotaLimiter {
+	if x, ok := x.GetComponent().(*Component_QuotaLimiter); ok {
+		return x.QuotaLimiter
+	}
+	return nil
+}
+
+func (x *Component) GetHoltWinters() *HoltWinters {
+	if x, ok := x.GetComponent().(*Component_HoltWinters); ok {
+		return x.HoltWinters
+	}
+	return nil
+}
+
+func (x *Component) GetIntegrator() *Integrator {
+	if x, ok := x.GetComponent().(*Component_Integrator); ok {
+		return x.Integrator
+	}
+	return nil
+}
+
+func (x *Component) GetDifferentiator() *Differentiator {
+	if x, ok := x.GetComponent().(*Component_Differentiator); ok {
+		return x.Differentiator
+	}
+	return nil
+}
+
+func (x *Component) GetDelta() *Delta {
+	if x, ok := x.GetComponent().(*Component_Delta); ok {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *Component) GetSchedule() *Schedule {
+	if x, ok := x.GetComponent().(*Component_Schedule); ok {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Component) GetVariable() *Variable {
+	if x, ok := x.GetComponent().(*Component_Variable); ok {
+		return x.Variable
+	}
+	return nil
+}
+
+func (x *Component) GetHolder() *Holder {
+	if x, ok := x.GetComponent().(*Component_Holder); ok {
+		return x.Holder
+	}
+	return nil
+}
+
+func (x *Component) GetFirstValid() *FirstValid {
+	if x, ok := x.GetComponent().(*Component_FirstValid); ok {
+		return x.FirstValid
+	}
+	return nil
+}
+
+func (x *Component) GetInverter() *Inverter {
+	if x, ok := x.GetComponent().(*Component_Inverter); ok {
+		return x.Inverter
+	}
+	return nil
+}
+
 type isComponent_Component interface {
 	isComponent_Component()
 }
@@ -593,6 +922,61 @@ type Component_Min struct {
 	Min *Min `protobuf:"bytes,13,opt,name=min,proto3,oneof"`
 }
 
+type Component_PidController struct {
+	// PID controller computes the output as a weighted sum of the error between the setpoint and the signal, its integral and its derivative.
+	PidController *PIDController `protobuf:"bytes,14,opt,name=pid_controller,json=pidController,proto3,oneof"`
+}
+
+type Component_QuotaLimiter struct {
+	// Quota Limiter enforces long-horizon (daily or monthly) budgets of flows per label value.
+	QuotaLimiter *QuotaLimiter `protobuf:"bytes,15,opt,name=quota_limiter,json=quotaLimiter,proto3,oneof"`
+}
+
+type Component_HoltWinters struct {
+	// Holt-Winters filter tracks the level, trend and optionally seasonality of a signal and forecasts it.
+	HoltWinters *HoltWinters `protobuf:"bytes,16,opt,name=holt_winters,json=holtWinters,proto3,oneof"`
+}
+
+type Component_Integrator struct {
+	// Accumulates the input signal over time.
+	Integrator *Integrator `protobuf:"bytes,17,opt,name=integrator,proto3,oneof"`
+}
+
+type Component_Differentiator struct {
+	// Emits the rate of change of the input signal over a window.
+	Differentiator *Differentiator `protobuf:"bytes,18,opt,name=differentiator,proto3,oneof"`
+}
+
+type Component_Delta struct {
+	// Emits the difference between the current and the previous reading of the input signal.
+	Delta *Delta `protobuf:"bytes,19,opt,name=delta,proto3,oneof"`
+}
+
+type Component_Schedule struct {
+	// Emits values on a cron schedule, with optional linear ramps between them.
+	Schedule *Schedule `protobuf:"bytes,20,opt,name=schedule,proto3,oneof"`
+}
+
+type Component_Variable struct {
+	// Emits a value read from the dynamic config of the policy.
+	Variable *Variable `protobuf:"bytes,21,opt,name=variable,proto3,oneof"`
+}
+
+type Component_Holder struct {
+	// Samples the input signal and holds the value for a duration.
+	Holder *Holder `protobuf:"bytes,22,opt,name=holder,proto3,oneof"`
+}
+
+type Component_FirstValid struct {
+	// Emits the first valid signal of the input signals.
+	FirstValid *FirstValid `protobuf:"bytes,23,opt,name=first_valid,json=firstValid,proto3,oneof"`
+}
+
+type Component_Inverter struct {
+	// Logical negation of the input signal.
+	Inverter *Inverter `protobuf:"bytes,24,opt,name=inverter,proto3,oneof"`
+}
+
 func (*Component_GradientController) isComponent_Component() {}
 
 func (*Component_Ema) isComponent_Component() {}
@@ -619,6 +1003,28 @@ func (*Component_Max) isComponent_Component() {}
 
 func (*Component_Min) isComponent_Component() {}
 
+func (*Component_PidController) isComponent_Component() {}
+
+func (*Component_QuotaLimiter) isComponent_Component() {}
+
+func (*Component_HoltWinters) isComponent_Component() {}
+
+func (*Component_Integrator) isComponent_Component() {}
+
+func (*Component_Differentiator) isComponent_Component() {}
+
+func (*Component_Delta) isComponent_Component() {}
+
+func (*Component_Schedule) isComponent_Component() {}
+
+func (*Component_Variable) isComponent_Component() {}
+
+func (*Component_Holder) isComponent_Component() {}
+
+func (*Component_FirstValid) isComponent_Component() {}
+
+func (*Component_Inverter) isComponent_Component() {}
+
 // Components are interconnected with each other via Ports
 type Port struct {
 	state         protoimpl.MessageState
@@ -632,7 +1038,7 @@ type Port struct {
 func (x *Port) Reset() {
 	*x = Port{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[6]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +1051,7 @@ func (x *Port) String() string {
 func (*Port) ProtoMessage() {}
 
 func (x *Port) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[6]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +1064,7 @@ func (x *Port) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Port.ProtoReflect.Descriptor instead.
 func (*Port) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{6}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Port) GetSignalName() string {
@@ -748,7 +1154,7 @@ type GradientController struct {
 func (x *GradientController) Reset() {
 	*x = GradientController{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[7]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -761,7 +1167,7 @@ func (x *GradientController) String() string {
 func (*GradientController) ProtoMessage() {}
 
 func (x *GradientController) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[7]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,7 +1180,7 @@ func (x *GradientController) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GradientController.ProtoReflect.Descriptor instead.
 func (*GradientController) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{7}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GradientController) GetInPorts() *GradientController_Ins {
@@ -812,6 +1218,136 @@ func (x *GradientController) GetMaxGradient() float64 {
 	return 0
 }
 
+// PID controller is a type of controller which computes the output based on the
+// error between the setpoint and the signal, the integral of the error and the
+// derivative of the signal
+//
+// The output of PID controller is computed as follows:
+//
+// $$
+// \text{error} = \text{setpoint} - \text{signal}
+// $$
+//
+// $$
+// \text{output} = \text{kp} \cdot \text{error} + \text{ki} \int \text{error} \, dt - \text{kd} \frac{d\,\text{signal}}{dt} + \text{optimize}
+// $$
+//
+// The derivative term is computed on the signal instead of the error, so that
+// setpoint changes do not cause a spike in the output. The derivative can be
+// smoothed with a low-pass filter, see `derivative_filter_time_constant`.
+//
+// The output can be _optionally_ clamped to desired range using `max` and
+// `min` input. When the output is clamped, the integral term is adjusted so
+// that it does not keep accumulating beyond the range (anti-windup).
+//
+// :::note
+// Use negative gains if the signal and the output are _positively_ correlated
+// (eg. queue depth and concurrency limit).
+// :::
+type PIDController struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input ports of the PID Controller.
+	InPorts *PIDController_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty"`
+	// Output ports of the PID Controller.
+	OutPorts *PIDController_Outs `protobuf:"bytes,2,opt,name=out_ports,json=outPorts,proto3" json:"out_ports,omitempty"`
+	// Proportional gain, applied to the error.
+	Kp float64 `protobuf:"fixed64,3,opt,name=kp,proto3" json:"kp,omitempty"`
+	// Integral gain, applied to the integral of the error over time in seconds.
+	Ki float64 `protobuf:"fixed64,4,opt,name=ki,proto3" json:"ki,omitempty"`
+	// Derivative gain, applied to the rate of change of the signal per second.
+	Kd float64 `protobuf:"fixed64,5,opt,name=kd,proto3" json:"kd,omitempty"`
+	// Time constant of the low-pass filter applied to the derivative term.
+	//
+	// Larger values smooth out noise in the signal at the expense of a slower derivative response. Zero disables filtering.
+	DerivativeFilterTimeConstant *durationpb.Duration `protobuf:"bytes,6,opt,name=derivative_filter_time_constant,json=derivativeFilterTimeConstant,proto3" json:"derivative_filter_time_constant,omitempty" default:"0s"` // @gotags: default:"0s"
+	// Initial value of the output, before the integral term accumulates.
+	Bias float64 `protobuf:"fixed64,7,opt,name=bias,proto3" json:"bias,omitempty"`
+}
+
+func (x *PIDController) Reset() {
+	*x = PIDController{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PIDController) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PIDController) ProtoMessage() {}
+
+func (x *PIDController) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PIDController.ProtoReflect.Descriptor instead.
+func (*PIDController) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PIDController) GetInPorts() *PIDController_Ins {
+	if x != nil {
+		return x.InPorts
+	}
+	return nil
+}
+
+func (x *PIDController) GetOutPorts() *PIDController_Outs {
+	if x != nil {
+		return x.OutPorts
+	}
+	return nil
+}
+
+func (x *PIDController) GetKp() float64 {
+	if x != nil {
+		return x.Kp
+	}
+	return 0
+}
+
+func (x *PIDController) GetKi() float64 {
+	if x != nil {
+		return x.Ki
+	}
+	return 0
+}
+
+func (x *PIDController) GetKd() float64 {
+	if x != nil {
+		return x.Kd
+	}
+	return 0
+}
+
+func (x *PIDController) GetDerivativeFilterTimeConstant() *durationpb.Duration {
+	if x != nil {
+		return x.DerivativeFilterTimeConstant
+	}
+	return nil
+}
+
+func (x *PIDController) GetBias() float64 {
+	if x != nil {
+		return x.Bias
+	}
+	return 0
+}
+
 // Exponential Moving Average (EMA) is a type of moving average that applies exponenially more weight to recent signal readings
 //
 // At any time EMA component operates in one of the following states:
@@ -862,7 +1398,7 @@ type EMA struct {
 func (x *EMA) Reset() {
 	*x = EMA{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[8]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -875,7 +1411,7 @@ func (x *EMA) String() string {
 func (*EMA) ProtoMessage() {}
 
 func (x *EMA) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[8]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +1424,7 @@ func (x *EMA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EMA.ProtoReflect.Descriptor instead.
 func (*EMA) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{8}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EMA) GetInPorts() *EMA_Ins {
@@ -933,6 +1469,144 @@ func (x *EMA) GetCorrectionFactorOnMaxEnvelopeViolation() float64 {
 	return 0
 }
 
+// Holt-Winters filter (triple exponential smoothing) tracks the level, trend and optionally seasonality of a signal
+//
+// At any time the component operates in one of the following states:
+// 1. Warm up state: The first warm_up_window samples are used to compute the initial level, the initial trend is zero.
+//    If an invalid reading is received during the warm_up_window, the state gets reset back to beginning of Warm up state.
+// 2. Normal state: The level $L$, trend $T$ and seasonal component $S$ of a series $Y$ are updated every tick using the following formulas.
+//
+// $$
+// \begin{aligned}
+// L_t &= \alpha (Y_t - S_{t-m}) + (1 - \alpha)(L_{t-1} + T_{t-1}) \\
+// T_t &= \beta (L_t - L_{t-1}) + (1 - \beta) T_{t-1} \\
+// S_t &= \gamma (Y_t - L_t) + (1 - \gamma) S_{t-m}
+// \end{aligned}
+// $$
+//
+// Where $m$ is the number of ticks in season\_length. Seasonal components start at zero and are not used if season\_length is zero.
+// The coefficients are computed from the windows like in [EMA](#v1-e-m-a), eg.:
+//
+// $$
+// \alpha = \frac{2}{N + 1} \quad\text{where } N = \frac{\text{level\_window}}{\text{evaluation\_period}}
+// $$
+//
+// $\gamma$ is computed from the number of seasons in seasonal\_window.
+//
+// Invalid readings are skipped and the last good outputs are emitted. If invalid readings persist for level\_window, the state gets reset back to Warm up state.
+type HoltWinters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input ports for the Holt-Winters component.
+	InPorts *HoltWinters_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty"`
+	// Output ports for the Holt-Winters component.
+	OutPorts *HoltWinters_Outs `protobuf:"bytes,2,opt,name=out_ports,json=outPorts,proto3" json:"out_ports,omitempty"`
+	// Duration of the level smoothing window.
+	LevelWindow *durationpb.Duration `protobuf:"bytes,3,opt,name=level_window,json=levelWindow,proto3" json:"level_window,omitempty" default:"60s"` // @gotags: default:"60s"
+	// Duration of the trend smoothing window.
+	TrendWindow *durationpb.Duration `protobuf:"bytes,4,opt,name=trend_window,json=trendWindow,proto3" json:"trend_window,omitempty" default:"300s"` // @gotags: default:"300s"
+	// Length of a season, eg. `86400s` for daily-cyclic signals, zero disables seasonality.
+	SeasonLength *durationpb.Duration `protobuf:"bytes,5,opt,name=season_length,json=seasonLength,proto3" json:"season_length,omitempty" default:"0s"` // @gotags: default:"0s"
+	// Duration of the seasonal smoothing window, should span multiple seasons.
+	SeasonalWindow *durationpb.Duration `protobuf:"bytes,6,opt,name=seasonal_window,json=seasonalWindow,proto3" json:"seasonal_window,omitempty" default:"604800s"` // @gotags: default:"604800s"
+	// Duration of the warming up window.
+	//
+	// The initial level is the average of signal readings received during the warm up window.
+	WarmUpWindow *durationpb.Duration `protobuf:"bytes,7,opt,name=warm_up_window,json=warmUpWindow,proto3" json:"warm_up_window,omitempty" default:"0s"` // @gotags: default:"0s"
+	// How far ahead the forecast output looks.
+	ForecastHorizon *durationpb.Duration `protobuf:"bytes,8,opt,name=forecast_horizon,json=forecastHorizon,proto3" json:"forecast_horizon,omitempty" default:"0s"` // @gotags: default:"0s"
+}
+
+func (x *HoltWinters) Reset() {
+	*x = HoltWinters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HoltWinters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoltWinters) ProtoMessage() {}
+
+func (x *HoltWinters) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoltWinters.ProtoReflect.Descriptor instead.
+func (*HoltWinters) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HoltWinters) GetInPorts() *HoltWinters_Ins {
+	if x != nil {
+		return x.InPorts
+	}
+	return nil
+}
+
+func (x *HoltWinters) GetOutPorts() *HoltWinters_Outs {
+	if x != nil {
+		return x.OutPorts
+	}
+	return nil
+}
+
+func (x *HoltWinters) GetLevelWindow() *durationpb.Duration {
+	if x != nil {
+		return x.LevelWindow
+	}
+	return nil
+}
+
+func (x *HoltWinters) GetTrendWindow() *durationpb.Duration {
+	if x != nil {
+		return x.TrendWindow
+	}
+	return nil
+}
+
+func (x *HoltWinters) GetSeasonLength() *durationpb.Duration {
+	if x != nil {
+		return x.SeasonLength
+	}
+	return nil
+}
+
+func (x *HoltWinters) GetSeasonalWindow() *durationpb.Duration {
+	if x != nil {
+		return x.SeasonalWindow
+	}
+	return nil
+}
+
+func (x *HoltWinters) GetWarmUpWindow() *durationpb.Duration {
+	if x != nil {
+		return x.WarmUpWindow
+	}
+	return nil
+}
+
+func (x *HoltWinters) GetForecastHorizon() *durationpb.Duration {
+	if x != nil {
+		return x.ForecastHorizon
+	}
+	return nil
+}
+
 // Type of combinator that computes the arithmetic operation on the operand signals
 type ArithmeticCombinator struct {
 	state         protoimpl.MessageState
@@ -953,7 +1627,7 @@ type ArithmeticCombinator struct {
 func (x *ArithmeticCombinator) Reset() {
 	*x = ArithmeticCombinator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[9]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -966,7 +1640,7 @@ func (x *ArithmeticCombinator) String() string {
 func (*ArithmeticCombinator) ProtoMessage() {}
 
 func (x *ArithmeticCombinator) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[9]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,7 +1653,7 @@ func (x *ArithmeticCombinator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArithmeticCombinator.ProtoReflect.Descriptor instead.
 func (*ArithmeticCombinator) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{9}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ArithmeticCombinator) GetInPorts() *ArithmeticCombinator_Ins {
@@ -1034,7 +1708,7 @@ type Decider struct {
 func (x *Decider) Reset() {
 	*x = Decider{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[10]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1047,7 +1721,7 @@ func (x *Decider) String() string {
 func (*Decider) ProtoMessage() {}
 
 func (x *Decider) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[10]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,7 +1734,7 @@ func (x *Decider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Decider.ProtoReflect.Descriptor instead.
 func (*Decider) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{10}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Decider) GetInPorts() *Decider_Ins {
@@ -1116,7 +1790,7 @@ type Switcher struct {
 func (x *Switcher) Reset() {
 	*x = Switcher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[11]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1129,7 +1803,7 @@ func (x *Switcher) String() string {
 func (*Switcher) ProtoMessage() {}
 
 func (x *Switcher) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[11]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,7 +1816,7 @@ func (x *Switcher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Switcher.ProtoReflect.Descriptor instead.
 func (*Switcher) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{11}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Switcher) GetInPorts() *Switcher_Ins {
@@ -1159,56 +1833,73 @@ func (x *Switcher) GetOutPorts() *Switcher_Outs {
 	return nil
 }
 
-// Limits the traffic on a control point to specified rate
+// Limits the number of flows per label value within a calendar window (day or month)
 //
-// :::info
-// See also [Rate Limiter overview](/concepts/flow-control/rate-limiter.md).
-// :::
+// Unlike [RateLimiter](#v1-rate-limiter), which keeps volatile counters for
+// short intervals, counters of quota limiter are persisted in etcd, so that
+// they survive agent restarts. This makes it suitable for billing-relevant
+// limits, e.g. API calls per customer per month.
 //
-// Ratelimiting is done separately on per-label-value basis. Use _label\_key_
-// to select which label should be used as key.
-type RateLimiter struct {
+// Windows are aligned to the calendar in the configured _time\_zone_, e.g. a
+// monthly quota resets at midnight of the first day of each month.
+//
+// Remaining quota is exposed to the flow as a flow label and to the client as
+// a response header.
+type QuotaLimiter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InPorts *RateLimiter_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty" validate:"required"` // @gotags: validate:"required"
-	// Which control point to apply this ratelimiter to.
+	// Input ports for the QuotaLimiter component.
+	InPorts *QuotaLimiter_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty" validate:"required"` // @gotags: validate:"required"
+	// Which control point to apply this quota limiter to.
 	Selector *v1.Selector `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty" validate:"required"` // @gotags: validate:"required"
-	// Time after which the limit for a given label value will be reset.
-	LimitResetInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=limit_reset_interval,json=limitResetInterval,proto3" json:"limit_reset_interval,omitempty" default:"60s"` // @gotags: default:"60s"
-	// Specifies which label the ratelimiter should be keyed by.
+	// Specifies which label the quota limiter should be keyed by, e.g. `customer_id`.
 	//
-	// Rate limiting is done independently for each value of the
-	// [label](/concepts/flow-control/flow-label.md) with given key.
-	// Eg., to give each user a separate limit, assuming you have a _user_ flow
-	// label set up, set `label_key: "user"`.
-	LabelKey string `protobuf:"bytes,4,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty" validate:"required"` // @gotags: validate:"required"
-	// Configuration of lazy-syncing behaviour of ratelimiter
-	LazySync *RateLimiter_LazySync `protobuf:"bytes,5,opt,name=lazy_sync,json=lazySync,proto3" json:"lazy_sync,omitempty"`
-	// Configuration key for DynamicConfig
-	DynamicConfigKey string `protobuf:"bytes,6,opt,name=dynamic_config_key,json=dynamicConfigKey,proto3" json:"dynamic_config_key,omitempty"`
-	// Initial configuration
-	InitConfig *RateLimiter_DynamicConfig `protobuf:"bytes,7,opt,name=init_config,json=initConfig,proto3" json:"init_config,omitempty"`
+	// Flows without the label are not limited.
+	LabelKey string `protobuf:"bytes,3,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty" validate:"required"` // @gotags: validate:"required"
+	// Calendar window after which the quota resets.
+	Window QuotaLimiter_Window `protobuf:"varint,4,opt,name=window,proto3,enum=aperture.policy.language.v1.QuotaLimiter_Window" json:"window,omitempty"`
+	// IANA time zone in which the calendar windows are aligned, e.g. `America/New_York`.
+	TimeZone string `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty" default:"UTC"` // @gotags: default:"UTC"
+	// How often the counters are persisted in etcd and synced with other agents.
+	//
+	// Flows admitted by other agents within this interval are not yet accounted
+	// for, so the quota can be exceeded by the traffic of a single interval.
+	SyncInterval *durationpb.Duration `protobuf:"bytes,6,opt,name=sync_interval,json=syncInterval,proto3" json:"sync_interval,omitempty" default:"1s"` // @gotags: default:"1s"
+	// Flow label under which the remaining quota is exposed to the flow.
+	// Empty value disables the flow label.
+	RemainingFlowLabelKey string `protobuf:"bytes,7,opt,name=remaining_flow_label_key,json=remainingFlowLabelKey,proto3" json:"remaining_flow_label_key,omitempty" default:"quota_remaining"` // @gotags: default:"quota_remaining"
+	// Response header under which the remaining quota is returned to the client.
+	// Empty value disables the header.
+	RemainingResponseHeader string `protobuf:"bytes,8,opt,name=remaining_response_header,json=remainingResponseHeader,proto3" json:"remaining_response_header,omitempty" default:"X-Quota-Remaining"` // @gotags: default:"X-Quota-Remaining"
+	// Shadow mode runs the full decision logic of the quota limiter, but never drops flows.
+	//
+	// Flows that would have been dropped are marked with `would_have_dropped` in
+	// the limiter decision. Quota is consumed by flows in shadow mode as well.
+	ShadowMode bool `protobuf:"varint,9,opt,name=shadow_mode,json=shadowMode,proto3" json:"shadow_mode,omitempty" default:"false"` // @gotags: default:"false"
+	// Response sent to clients of flows rejected by this quota limiter.
+	// Defaults to the _rejection\_response_ of the policy.
+	RejectionResponse *RejectionResponse `protobuf:"bytes,10,opt,name=rejection_response,json=rejectionResponse,proto3" json:"rejection_response,omitempty"`
 }
 
-func (x *RateLimiter) Reset() {
-	*x = RateLimiter{}
+func (x *QuotaLimiter) Reset() {
+	*x = QuotaLimiter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[12]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RateLimiter) String() string {
+func (x *QuotaLimiter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimiter) ProtoMessage() {}
+func (*QuotaLimiter) ProtoMessage() {}
 
-func (x *RateLimiter) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[12]
+func (x *QuotaLimiter) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,49 +1910,216 @@ func (x *RateLimiter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateLimiter.ProtoReflect.Descriptor instead.
-func (*RateLimiter) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QuotaLimiter.ProtoReflect.Descriptor instead.
+func (*QuotaLimiter) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RateLimiter) GetInPorts() *RateLimiter_Ins {
+func (x *QuotaLimiter) GetInPorts() *QuotaLimiter_Ins {
 	if x != nil {
 		return x.InPorts
 	}
 	return nil
 }
 
-func (x *RateLimiter) GetSelector() *v1.Selector {
+func (x *QuotaLimiter) GetSelector() *v1.Selector {
 	if x != nil {
 		return x.Selector
 	}
 	return nil
 }
 
-func (x *RateLimiter) GetLimitResetInterval() *durationpb.Duration {
-	if x != nil {
-		return x.LimitResetInterval
-	}
-	return nil
-}
-
-func (x *RateLimiter) GetLabelKey() string {
+func (x *QuotaLimiter) GetLabelKey() string {
 	if x != nil {
 		return x.LabelKey
 	}
 	return ""
 }
 
-func (x *RateLimiter) GetLazySync() *RateLimiter_LazySync {
+func (x *QuotaLimiter) GetWindow() QuotaLimiter_Window {
 	if x != nil {
-		return x.LazySync
+		return x.Window
 	}
-	return nil
+	return QuotaLimiter_WINDOW_DAY
 }
 
-func (x *RateLimiter) GetDynamicConfigKey() string {
+func (x *QuotaLimiter) GetTimeZone() string {
 	if x != nil {
-		return x.DynamicConfigKey
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *QuotaLimiter) GetSyncInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SyncInterval
+	}
+	return nil
+}
+
+func (x *QuotaLimiter) GetRemainingFlowLabelKey() string {
+	if x != nil {
+		return x.RemainingFlowLabelKey
+	}
+	return ""
+}
+
+func (x *QuotaLimiter) GetRemainingResponseHeader() string {
+	if x != nil {
+		return x.RemainingResponseHeader
+	}
+	return ""
+}
+
+func (x *QuotaLimiter) GetShadowMode() bool {
+	if x != nil {
+		return x.ShadowMode
+	}
+	return false
+}
+
+func (x *QuotaLimiter) GetRejectionResponse() *RejectionResponse {
+	if x != nil {
+		return x.RejectionResponse
+	}
+	return nil
+}
+
+// Limits the traffic on a control point to specified rate
+//
+// :::info
+// See also [Rate Limiter overview](/concepts/flow-control/rate-limiter.md).
+// :::
+//
+// Ratelimiting is done separately on per-label-value basis. Use _label\_key_
+// to select which label should be used as key.
+type RateLimiter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InPorts *RateLimiter_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty" validate:"required"` // @gotags: validate:"required"
+	// Which control point to apply this ratelimiter to.
+	Selector *v1.Selector `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty" validate:"required"` // @gotags: validate:"required"
+	// Time after which the limit for a given label value will be reset.
+	//
+	// In token bucket mode, this is the time after which the bucket of an idle
+	// label value is dropped and the interval lazy sync is spread over. It
+	// should not be shorter than the time it takes to fill up a bucket.
+	LimitResetInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=limit_reset_interval,json=limitResetInterval,proto3" json:"limit_reset_interval,omitempty" default:"60s"` // @gotags: default:"60s"
+	// Specifies which label the ratelimiter should be keyed by.
+	//
+	// Rate limiting is done independently for each value of the
+	// [label](/concepts/flow-control/flow-label.md) with given key.
+	// Eg., to give each user a separate limit, assuming you have a _user_ flow
+	// label set up, set `label_key: "user"`.
+	//
+	// Either _label\_key_ or _label\_keys_ must be set.
+	LabelKey string `protobuf:"bytes,4,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty" validate:"required_without=LabelKeys"` // @gotags: validate:"required_without=LabelKeys"
+	// Configuration of lazy-syncing behaviour of ratelimiter
+	LazySync *RateLimiter_LazySync `protobuf:"bytes,5,opt,name=lazy_sync,json=lazySync,proto3" json:"lazy_sync,omitempty"`
+	// Configuration key for DynamicConfig
+	DynamicConfigKey string `protobuf:"bytes,6,opt,name=dynamic_config_key,json=dynamicConfigKey,proto3" json:"dynamic_config_key,omitempty"`
+	// Initial configuration
+	InitConfig *RateLimiter_DynamicConfig `protobuf:"bytes,7,opt,name=init_config,json=initConfig,proto3" json:"init_config,omitempty"`
+	// Shadow mode runs the full decision logic of the rate limiter, but never drops flows.
+	//
+	// Flows that would have been dropped are marked with `would_have_dropped` in
+	// the limiter decision and are reported separately in telemetry. Useful for
+	// safely rolling out a policy against real traffic before enforcing it.
+	ShadowMode bool `protobuf:"varint,8,opt,name=shadow_mode,json=shadowMode,proto3" json:"shadow_mode,omitempty" default:"false"` // @gotags: default:"false"
+	// Keeps the token buckets in the memory of each agent instead of the
+	// distributed cache, so the limits apply per agent instead of per agent
+	// group. Supported only in token bucket mode.
+	Local bool `protobuf:"varint,9,opt,name=local,proto3" json:"local,omitempty" default:"false"` // @gotags: default:"false"
+	// Response sent to clients of flows rejected by this rate limiter.
+	// Defaults to the _rejection\_response_ of the policy.
+	RejectionResponse *RejectionResponse `protobuf:"bytes,10,opt,name=rejection_response,json=rejectionResponse,proto3" json:"rejection_response,omitempty"`
+	// Flow label key that carries the cost of the flow, e.g. the batch size of a request extracted by a classifier.
+	//
+	// The cost is deducted from the limit instead of 1 and refunded in full if the flow is rejected later.
+	// Flows without the label or with a non-positive or non-numeric value cost 1.
+	// Fractional costs are rounded up.
+	TokensLabelKey string `protobuf:"bytes,11,opt,name=tokens_label_key,json=tokensLabelKey,proto3" json:"tokens_label_key,omitempty"`
+	// Specifies multiple labels the ratelimiter should be keyed by.
+	//
+	// Rate limiting is done independently for each combination of values of the
+	// labels with given keys. Eg., to give each endpoint of each tenant a
+	// separate limit, set `label_keys: ["tenant", "endpoint"]`. Flows missing any
+	// of the labels are not limited.
+	LabelKeys []string `protobuf:"bytes,12,rep,name=label_keys,json=labelKeys,proto3" json:"label_keys,omitempty" validate:"required_without=LabelKey,dive,required"` // @gotags: validate:"required_without=LabelKey,dive,required"
+}
+
+func (x *RateLimiter) Reset() {
+	*x = RateLimiter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimiter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimiter) ProtoMessage() {}
+
+func (x *RateLimiter) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimiter.ProtoReflect.Descriptor instead.
+func (*RateLimiter) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RateLimiter) GetInPorts() *RateLimiter_Ins {
+	if x != nil {
+		return x.InPorts
+	}
+	return nil
+}
+
+func (x *RateLimiter) GetSelector() *v1.Selector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *RateLimiter) GetLimitResetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.LimitResetInterval
+	}
+	return nil
+}
+
+func (x *RateLimiter) GetLabelKey() string {
+	if x != nil {
+		return x.LabelKey
+	}
+	return ""
+}
+
+func (x *RateLimiter) GetLazySync() *RateLimiter_LazySync {
+	if x != nil {
+		return x.LazySync
+	}
+	return nil
+}
+
+func (x *RateLimiter) GetDynamicConfigKey() string {
+	if x != nil {
+		return x.DynamicConfigKey
 	}
 	return ""
 }
@@ -1273,6 +2131,41 @@ func (x *RateLimiter) GetInitConfig() *RateLimiter_DynamicConfig {
 	return nil
 }
 
+func (x *RateLimiter) GetShadowMode() bool {
+	if x != nil {
+		return x.ShadowMode
+	}
+	return false
+}
+
+func (x *RateLimiter) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+func (x *RateLimiter) GetRejectionResponse() *RejectionResponse {
+	if x != nil {
+		return x.RejectionResponse
+	}
+	return nil
+}
+
+func (x *RateLimiter) GetTokensLabelKey() string {
+	if x != nil {
+		return x.TokensLabelKey
+	}
+	return ""
+}
+
+func (x *RateLimiter) GetLabelKeys() []string {
+	if x != nil {
+		return x.LabelKeys
+	}
+	return nil
+}
+
 // Concurrency Limiter is an actuator component that regulates flows in order to provide active service protection
 //
 // :::info
@@ -1296,13 +2189,24 @@ type ConcurrencyLimiter struct {
 	Scheduler *Scheduler `protobuf:"bytes,1,opt,name=scheduler,proto3" json:"scheduler,omitempty" validate:"required"` // @gotags: validate:"required"
 	// Types that are assignable to ActuationStrategy:
 	//	*ConcurrencyLimiter_LoadShedActuator
+	//	*ConcurrencyLimiter_ConcurrencyBudgetActuator
 	ActuationStrategy isConcurrencyLimiter_ActuationStrategy `protobuf_oneof:"actuation_strategy"`
+	// Shadow mode runs the decision logic of the concurrency limiter, but never drops or delays flows.
+	//
+	// Flows are never queued in shadow mode, a flow is accepted if the scheduler has tokens available
+	// right away. Flows that would have been dropped are marked with `would_have_dropped` in
+	// the limiter decision and are reported separately in telemetry. Useful for
+	// safely rolling out a policy against real traffic before enforcing it.
+	ShadowMode bool `protobuf:"varint,3,opt,name=shadow_mode,json=shadowMode,proto3" json:"shadow_mode,omitempty" default:"false"` // @gotags: default:"false"
+	// Response sent to clients of flows rejected by this concurrency limiter.
+	// Defaults to the _rejection\_response_ of the policy.
+	RejectionResponse *RejectionResponse `protobuf:"bytes,4,opt,name=rejection_response,json=rejectionResponse,proto3" json:"rejection_response,omitempty"`
 }
 
 func (x *ConcurrencyLimiter) Reset() {
 	*x = ConcurrencyLimiter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[13]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1315,7 +2219,7 @@ func (x *ConcurrencyLimiter) String() string {
 func (*ConcurrencyLimiter) ProtoMessage() {}
 
 func (x *ConcurrencyLimiter) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[13]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +2232,7 @@ func (x *ConcurrencyLimiter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConcurrencyLimiter.ProtoReflect.Descriptor instead.
 func (*ConcurrencyLimiter) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{13}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ConcurrencyLimiter) GetScheduler() *Scheduler {
@@ -1352,6 +2256,27 @@ func (x *ConcurrencyLimiter) GetLoadShedActuator() *LoadShedActuator {
 	return nil
 }
 
+func (x *ConcurrencyLimiter) GetConcurrencyBudgetActuator() *ConcurrencyBudgetActuator {
+	if x, ok := x.GetActuationStrategy().(*ConcurrencyLimiter_ConcurrencyBudgetActuator); ok {
+		return x.ConcurrencyBudgetActuator
+	}
+	return nil
+}
+
+func (x *ConcurrencyLimiter) GetShadowMode() bool {
+	if x != nil {
+		return x.ShadowMode
+	}
+	return false
+}
+
+func (x *ConcurrencyLimiter) GetRejectionResponse() *RejectionResponse {
+	if x != nil {
+		return x.RejectionResponse
+	}
+	return nil
+}
+
 type isConcurrencyLimiter_ActuationStrategy interface {
 	isConcurrencyLimiter_ActuationStrategy()
 }
@@ -1363,8 +2288,17 @@ type ConcurrencyLimiter_LoadShedActuator struct {
 	LoadShedActuator *LoadShedActuator `protobuf:"bytes,2,opt,name=load_shed_actuator,json=loadShedActuator,proto3,oneof"`
 }
 
+type ConcurrencyLimiter_ConcurrencyBudgetActuator struct {
+	// Actuator sharing a global concurrency budget across the agent group.
+	//
+	// Actuation strategy defines the input signal that will drive the scheduler.
+	ConcurrencyBudgetActuator *ConcurrencyBudgetActuator `protobuf:"bytes,5,opt,name=concurrency_budget_actuator,json=concurrencyBudgetActuator,proto3,oneof"`
+}
+
 func (*ConcurrencyLimiter_LoadShedActuator) isConcurrencyLimiter_ActuationStrategy() {}
 
+func (*ConcurrencyLimiter_ConcurrencyBudgetActuator) isConcurrencyLimiter_ActuationStrategy() {}
+
 // Weighted Fair Queuing-based workload scheduler
 //
 // :::note
@@ -1435,12 +2369,15 @@ type Scheduler struct {
 	// tweaking this timeout, make sure to adjust the GRPC timeout accordingly.
 	// :::
 	MaxTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty" default:"0.45s"` // @gotags: default:"0.45s"
+	// Controlled delay (CoDel) queueing discipline.
+	// If not set, requests within a flow are served in FIFO order and dropped only on timeout.
+	Codel *Scheduler_CoDel `protobuf:"bytes,8,opt,name=codel,proto3" json:"codel,omitempty"`
 }
 
 func (x *Scheduler) Reset() {
 	*x = Scheduler{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[14]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1453,7 +2390,7 @@ func (x *Scheduler) String() string {
 func (*Scheduler) ProtoMessage() {}
 
 func (x *Scheduler) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[14]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,7 +2403,7 @@ func (x *Scheduler) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Scheduler.ProtoReflect.Descriptor instead.
 func (*Scheduler) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{14}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Scheduler) GetOutPorts() *Scheduler_Outs {
@@ -1518,6 +2455,13 @@ func (x *Scheduler) GetMaxTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *Scheduler) GetCodel() *Scheduler_CoDel {
+	if x != nil {
+		return x.Codel
+	}
+	return nil
+}
+
 // Takes the load shed factor input signal and publishes it to the schedulers in the data-plane
 type LoadShedActuator struct {
 	state         protoimpl.MessageState
@@ -1531,7 +2475,7 @@ type LoadShedActuator struct {
 func (x *LoadShedActuator) Reset() {
 	*x = LoadShedActuator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[15]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1544,7 +2488,7 @@ func (x *LoadShedActuator) String() string {
 func (*LoadShedActuator) ProtoMessage() {}
 
 func (x *LoadShedActuator) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[15]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1557,7 +2501,7 @@ func (x *LoadShedActuator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadShedActuator.ProtoReflect.Descriptor instead.
 func (*LoadShedActuator) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{15}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *LoadShedActuator) GetInPorts() *LoadShedActuator_Ins {
@@ -1567,6 +2511,89 @@ func (x *LoadShedActuator) GetInPorts() *LoadShedActuator_Ins {
 	return nil
 }
 
+// Takes the concurrency budget input signal and shares it between the schedulers of the agent group
+//
+// Instead of shedding a portion of the local traffic, each agent leases a
+// slice of the budget through the distributed cache of the agent group. The
+// lease is sized to the incoming concurrency of the agent and is renewed
+// every `lease_interval`, which gives back the unused part of the budget to
+// the other agents. The budget is shared fairly between the demands of the
+// agents, and the leases never add up to more than the budget: an agent
+// whose share is held by others gets it once they shrink their leases at
+// their next renewal. An idle agent keeps up to its fair share of the budget
+// not demanded by the others, so that it can admit flows right away.
+//
+// :::note
+// Until the first budget is received from the controller, flows are not
+// limited by this actuator.
+// :::
+type ConcurrencyBudgetActuator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input ports for the Concurrency Budget Actuator component.
+	InPorts *ConcurrencyBudgetActuator_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty"`
+	// Interval at which agents renew their leases of the budget.
+	LeaseInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=lease_interval,json=leaseInterval,proto3" json:"lease_interval,omitempty" default:"1s"` // @gotags: default:"1s"
+	// Leases that are not renewed within this duration are given back to the
+	// other agents, e.g. when an agent goes away.
+	LeaseTtl *durationpb.Duration `protobuf:"bytes,3,opt,name=lease_ttl,json=leaseTtl,proto3" json:"lease_ttl,omitempty" default:"5s"` // @gotags: default:"5s"
+}
+
+func (x *ConcurrencyBudgetActuator) Reset() {
+	*x = ConcurrencyBudgetActuator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConcurrencyBudgetActuator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyBudgetActuator) ProtoMessage() {}
+
+func (x *ConcurrencyBudgetActuator) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConcurrencyBudgetActuator.ProtoReflect.Descriptor instead.
+func (*ConcurrencyBudgetActuator) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ConcurrencyBudgetActuator) GetInPorts() *ConcurrencyBudgetActuator_Ins {
+	if x != nil {
+		return x.InPorts
+	}
+	return nil
+}
+
+func (x *ConcurrencyBudgetActuator) GetLeaseInterval() *durationpb.Duration {
+	if x != nil {
+		return x.LeaseInterval
+	}
+	return nil
+}
+
+func (x *ConcurrencyBudgetActuator) GetLeaseTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LeaseTtl
+	}
+	return nil
+}
+
 // Component that runs a Prometheus query periodically and returns the result as an output signal
 type PromQL struct {
 	state         protoimpl.MessageState
@@ -1589,7 +2616,7 @@ type PromQL struct {
 func (x *PromQL) Reset() {
 	*x = PromQL{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[16]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1602,7 +2629,7 @@ func (x *PromQL) String() string {
 func (*PromQL) ProtoMessage() {}
 
 func (x *PromQL) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[16]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,7 +2642,7 @@ func (x *PromQL) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PromQL.ProtoReflect.Descriptor instead.
 func (*PromQL) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{16}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PromQL) GetOutPorts() *PromQL_Outs {
@@ -1654,7 +2681,7 @@ type Constant struct {
 func (x *Constant) Reset() {
 	*x = Constant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[17]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1667,7 +2694,7 @@ func (x *Constant) String() string {
 func (*Constant) ProtoMessage() {}
 
 func (x *Constant) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[17]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1680,7 +2707,7 @@ func (x *Constant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Constant.ProtoReflect.Descriptor instead.
 func (*Constant) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{17}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Constant) GetOutPorts() *Constant_Outs {
@@ -1697,6 +2724,175 @@ func (x *Constant) GetValue() float64 {
 	return 0
 }
 
+// Component that emits a value read from the dynamic config of the policy
+//
+// Allows changing an input of the circuit at runtime without redeploying the policy, eg. a kill-switch or a manual load-shed floor.
+//
+// Example of the dynamic config of a policy with a Variable with `dynamic_config_key: load_shed_floor`:
+// ```yaml
+// load_shed_floor:
+//   value: 0.5
+// ```
+type Variable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output ports for the Variable component.
+	OutPorts *Variable_Outs `protobuf:"bytes,1,opt,name=out_ports,json=outPorts,proto3" json:"out_ports,omitempty"`
+	// Configuration key for DynamicConfig.
+	DynamicConfigKey string `protobuf:"bytes,2,opt,name=dynamic_config_key,json=dynamicConfigKey,proto3" json:"dynamic_config_key,omitempty" validate:"required"` // @gotags: validate:"required"
+	// Configuration used while the dynamic config of the policy doesn't set the key.
+	DefaultConfig *Variable_DynamicConfig `protobuf:"bytes,3,opt,name=default_config,json=defaultConfig,proto3" json:"default_config,omitempty"`
+}
+
+func (x *Variable) Reset() {
+	*x = Variable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Variable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variable) ProtoMessage() {}
+
+func (x *Variable) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variable.ProtoReflect.Descriptor instead.
+func (*Variable) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Variable) GetOutPorts() *Variable_Outs {
+	if x != nil {
+		return x.OutPorts
+	}
+	return nil
+}
+
+func (x *Variable) GetDynamicConfigKey() string {
+	if x != nil {
+		return x.DynamicConfigKey
+	}
+	return ""
+}
+
+func (x *Variable) GetDefaultConfig() *Variable_DynamicConfig {
+	if x != nil {
+		return x.DefaultConfig
+	}
+	return nil
+}
+
+// Component that emits values on a cron schedule
+//
+// Each entry starts emitting its value at the times given by its cron expression, until another entry takes over.
+// The transition to the value of an entry can be made gradual with a linear ramp.
+//
+// Example of a rate limit lowered during a nightly batch window:
+// ```yaml
+// time_zone: Europe/Warsaw
+// default_value: 100
+// entries:
+//   - cron: "0 22 * * *"
+//     value: 20
+//     ramp: 600s
+//   - cron: "0 6 * * *"
+//     value: 100
+// ```
+//
+// Schedules are evaluated with the clock of the circuit. Entries which fired up to 32 days before
+// the start of the policy are taken into account, so that the output is correct right away.
+type Schedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output ports for the Schedule component.
+	OutPorts *Schedule_Outs `protobuf:"bytes,1,opt,name=out_ports,json=outPorts,proto3" json:"out_ports,omitempty"`
+	// Time zone of the cron expressions, as an [IANA Time Zone](https://www.iana.org/time-zones) name, eg. `America/New_York`.
+	TimeZone string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty" default:"UTC"` // @gotags: default:"UTC"
+	// Entries of the schedule.
+	Entries []*Schedule_Entry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty" validate:"dive"` // @gotags: validate:"dive"
+	// The value emitted before any of the entries takes effect.
+	DefaultValue float64 `protobuf:"fixed64,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Schedule) GetOutPorts() *Schedule_Outs {
+	if x != nil {
+		return x.OutPorts
+	}
+	return nil
+}
+
+func (x *Schedule) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Schedule) GetEntries() []*Schedule_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Schedule) GetDefaultValue() float64 {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return 0
+}
+
 // Takes an input signal and emits the square root of it multiplied by scale as an output
 //
 // $$
@@ -1718,7 +2914,7 @@ type Sqrt struct {
 func (x *Sqrt) Reset() {
 	*x = Sqrt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[18]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1731,7 +2927,7 @@ func (x *Sqrt) String() string {
 func (*Sqrt) ProtoMessage() {}
 
 func (x *Sqrt) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[18]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,7 +2940,7 @@ func (x *Sqrt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sqrt.ProtoReflect.Descriptor instead.
 func (*Sqrt) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{18}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Sqrt) GetInPorts() *Sqrt_Ins {
@@ -1787,7 +2983,7 @@ type Extrapolator struct {
 func (x *Extrapolator) Reset() {
 	*x = Extrapolator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[19]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1800,7 +2996,7 @@ func (x *Extrapolator) String() string {
 func (*Extrapolator) ProtoMessage() {}
 
 func (x *Extrapolator) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[19]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1813,7 +3009,7 @@ func (x *Extrapolator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Extrapolator.ProtoReflect.Descriptor instead.
 func (*Extrapolator) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{19}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Extrapolator) GetInPorts() *Extrapolator_Ins {
@@ -1854,7 +3050,7 @@ type Max struct {
 func (x *Max) Reset() {
 	*x = Max{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[20]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1867,7 +3063,7 @@ func (x *Max) String() string {
 func (*Max) ProtoMessage() {}
 
 func (x *Max) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[20]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1880,7 +3076,7 @@ func (x *Max) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Max.ProtoReflect.Descriptor instead.
 func (*Max) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{20}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Max) GetInPorts() *Max_Ins {
@@ -1913,7 +3109,7 @@ type Min struct {
 func (x *Min) Reset() {
 	*x = Min{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[21]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1926,7 +3122,7 @@ func (x *Min) String() string {
 func (*Min) ProtoMessage() {}
 
 func (x *Min) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[21]
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1939,7 +3135,7 @@ func (x *Min) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Min.ProtoReflect.Descriptor instead.
 func (*Min) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{21}
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Min) GetInPorts() *Min_Ins {
@@ -1956,45 +3152,41 @@ func (x *Min) GetOutPorts() *Min_Outs {
 	return nil
 }
 
-// Inputs for the Gradient Controller component.
-type GradientController_Ins struct {
+// Accumulates the input signal over time
+//
+// $$
+// \text{output}_t = \text{output}_{t-1} + \text{input}_t \cdot \text{evaluation\_interval}
+// $$
+//
+// Invalid input readings are not accumulated. The output starts at zero.
+type Integrator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Signal to be used for the gradient computation.
-	Signal *Port `protobuf:"bytes,1,opt,name=signal,proto3" json:"signal,omitempty"`
-	// Setpoint to be used for the gradient computation.
-	Setpoint *Port `protobuf:"bytes,2,opt,name=setpoint,proto3" json:"setpoint,omitempty"`
-	// Optimize signal is added to the output of the gradient calculation.
-	Optimize *Port `protobuf:"bytes,3,opt,name=optimize,proto3" json:"optimize,omitempty"`
-	// Maximum value to limit the output signal.
-	Max *Port `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
-	// Minimum value to limit the output signal.
-	Min *Port `protobuf:"bytes,5,opt,name=min,proto3" json:"min,omitempty"`
-	// Actual current value of the control variable.
-	//
-	// This signal is multiplied by the gradient to produce the output.
-	ControlVariable *Port `protobuf:"bytes,6,opt,name=control_variable,json=controlVariable,proto3" json:"control_variable,omitempty"`
+	// Input ports for the Integrator component.
+	InPorts *Integrator_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty"`
+	// Output ports for the Integrator component.
+	OutPorts *Integrator_Outs `protobuf:"bytes,2,opt,name=out_ports,json=outPorts,proto3" json:"out_ports,omitempty"`
 }
 
-func (x *GradientController_Ins) Reset() {
-	*x = GradientController_Ins{}
+func (x *Integrator) Reset() {
+	*x = Integrator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[24]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradientController_Ins) String() string {
+func (x *Integrator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradientController_Ins) ProtoMessage() {}
+func (*Integrator) ProtoMessage() {}
 
-func (x *GradientController_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[24]
+func (x *Integrator) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2005,80 +3197,135 @@ func (x *GradientController_Ins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradientController_Ins.ProtoReflect.Descriptor instead.
-func (*GradientController_Ins) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use Integrator.ProtoReflect.Descriptor instead.
+func (*Integrator) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GradientController_Ins) GetSignal() *Port {
+func (x *Integrator) GetInPorts() *Integrator_Ins {
 	if x != nil {
-		return x.Signal
+		return x.InPorts
 	}
 	return nil
 }
 
-func (x *GradientController_Ins) GetSetpoint() *Port {
+func (x *Integrator) GetOutPorts() *Integrator_Outs {
 	if x != nil {
-		return x.Setpoint
+		return x.OutPorts
 	}
 	return nil
 }
 
-func (x *GradientController_Ins) GetOptimize() *Port {
-	if x != nil {
-		return x.Optimize
+// Emits the rate of change of the input signal over a window
+//
+// $$
+// \text{output}_t = \frac{\text{input}_t - \text{input}_{t - \text{window}}}{\text{window}}
+// $$
+//
+// The oldest valid reading within the window is used, along with its timestamp, so that invalid
+// readings shorten the window instead of invalidating the output. The output is invalid
+// unless the window contains at least two valid readings.
+type Differentiator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input ports for the Differentiator component.
+	InPorts *Differentiator_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty"`
+	// Output ports for the Differentiator component.
+	OutPorts *Differentiator_Outs `protobuf:"bytes,2,opt,name=out_ports,json=outPorts,proto3" json:"out_ports,omitempty"`
+	// Duration of the window the rate of change is computed over.
+	Window *durationpb.Duration `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty" default:"5s"` // @gotags: default:"5s"
+}
+
+func (x *Differentiator) Reset() {
+	*x = Differentiator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GradientController_Ins) GetMax() *Port {
+func (x *Differentiator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Differentiator) ProtoMessage() {}
+
+func (x *Differentiator) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Differentiator.ProtoReflect.Descriptor instead.
+func (*Differentiator) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Differentiator) GetInPorts() *Differentiator_Ins {
 	if x != nil {
-		return x.Max
+		return x.InPorts
 	}
 	return nil
 }
 
-func (x *GradientController_Ins) GetMin() *Port {
+func (x *Differentiator) GetOutPorts() *Differentiator_Outs {
 	if x != nil {
-		return x.Min
+		return x.OutPorts
 	}
 	return nil
 }
 
-func (x *GradientController_Ins) GetControlVariable() *Port {
+func (x *Differentiator) GetWindow() *durationpb.Duration {
 	if x != nil {
-		return x.ControlVariable
+		return x.Window
 	}
 	return nil
 }
 
-// Outputs for the Gradient Controller component.
-type GradientController_Outs struct {
+// Emits the difference between the current and the previous reading of the input signal
+//
+// $$
+// \text{output}_t = \text{input}_t - \text{input}_{t-1}
+// $$
+//
+// The output is invalid if either of the readings is invalid.
+type Delta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Computed desired value of the control variable.
-	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// Input ports for the Delta component.
+	InPorts *Delta_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty"`
+	// Output ports for the Delta component.
+	OutPorts *Delta_Outs `protobuf:"bytes,2,opt,name=out_ports,json=outPorts,proto3" json:"out_ports,omitempty"`
 }
 
-func (x *GradientController_Outs) Reset() {
-	*x = GradientController_Outs{}
+func (x *Delta) Reset() {
+	*x = Delta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[25]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradientController_Outs) String() string {
+func (x *Delta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradientController_Outs) ProtoMessage() {}
+func (*Delta) ProtoMessage() {}
 
-func (x *GradientController_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[25]
+func (x *Delta) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2089,63 +3336,60 @@ func (x *GradientController_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradientController_Outs.ProtoReflect.Descriptor instead.
-func (*GradientController_Outs) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{7, 1}
+// Deprecated: Use Delta.ProtoReflect.Descriptor instead.
+func (*Delta) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GradientController_Outs) GetOutput() *Port {
+func (x *Delta) GetInPorts() *Delta_Ins {
 	if x != nil {
-		return x.Output
+		return x.InPorts
 	}
 	return nil
 }
 
-// Inputs for the EMA component.
-type EMA_Ins struct {
+func (x *Delta) GetOutPorts() *Delta_Outs {
+	if x != nil {
+		return x.OutPorts
+	}
+	return nil
+}
+
+// Samples the input signal and holds the value for a duration
+//
+// The first valid reading of the input signal is emitted for `hold_for` duration, regardless of
+// the readings received in the meantime (including invalid ones). Then the next valid reading is sampled.
+// While nothing is held, the input signal is emitted as is.
+type Holder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Input signal to be used for the EMA computation.
-	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
-	// Upper bound of the moving average.
-	//
-	// Used during the warm-up stage: if the signal would exceed `max_envelope`
-	// it's multiplied by `correction_factor_on_max_envelope_violation` **once per tick**.
-	//
-	// :::note
-	// If the signal deviates from `max_envelope` faster than the correction
-	// faster, it might end up exceeding the envelope.
-	// :::
-	//
-	// :::note
-	// The envelope logic is **not** used outside the warm-up stage!
-	// :::
-	MaxEnvelope *Port `protobuf:"bytes,2,opt,name=max_envelope,json=maxEnvelope,proto3" json:"max_envelope,omitempty"`
-	// Lower bound of the moving average.
-	//
-	// Used during the warm-up stage analogously to `max_envelope`.
-	MinEnvelope *Port `protobuf:"bytes,3,opt,name=min_envelope,json=minEnvelope,proto3" json:"min_envelope,omitempty"`
+	// Input ports for the Holder component.
+	InPorts *Holder_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty"`
+	// Output ports for the Holder component.
+	OutPorts *Holder_Outs `protobuf:"bytes,2,opt,name=out_ports,json=outPorts,proto3" json:"out_ports,omitempty"`
+	// Duration for which a sampled value is held.
+	HoldFor *durationpb.Duration `protobuf:"bytes,3,opt,name=hold_for,json=holdFor,proto3" json:"hold_for,omitempty" default:"5s"` // @gotags: default:"5s"
 }
 
-func (x *EMA_Ins) Reset() {
-	*x = EMA_Ins{}
+func (x *Holder) Reset() {
+	*x = Holder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[26]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EMA_Ins) String() string {
+func (x *Holder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EMA_Ins) ProtoMessage() {}
+func (*Holder) ProtoMessage() {}
 
-func (x *EMA_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[26]
+func (x *Holder) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2156,59 +3400,64 @@ func (x *EMA_Ins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EMA_Ins.ProtoReflect.Descriptor instead.
-func (*EMA_Ins) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use Holder.ProtoReflect.Descriptor instead.
+func (*Holder) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *EMA_Ins) GetInput() *Port {
+func (x *Holder) GetInPorts() *Holder_Ins {
 	if x != nil {
-		return x.Input
+		return x.InPorts
 	}
 	return nil
 }
 
-func (x *EMA_Ins) GetMaxEnvelope() *Port {
+func (x *Holder) GetOutPorts() *Holder_Outs {
 	if x != nil {
-		return x.MaxEnvelope
+		return x.OutPorts
 	}
 	return nil
 }
 
-func (x *EMA_Ins) GetMinEnvelope() *Port {
+func (x *Holder) GetHoldFor() *durationpb.Duration {
 	if x != nil {
-		return x.MinEnvelope
+		return x.HoldFor
 	}
 	return nil
 }
 
-// Outputs for the EMA component.
-type EMA_Outs struct {
+// Takes a list of input signals and emits the first valid one
+//
+// Useful for fallbacks, eg. to a [Constant](#v1-constant) signal when a [PromQL](#v1-prom-q-l) query returns no data.
+// The output is invalid if none of the input signals is valid.
+type FirstValid struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Exponential moving average of the series of reading as an output signal.
-	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// Input ports for the FirstValid component.
+	InPorts *FirstValid_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty"`
+	// Output ports for the FirstValid component.
+	OutPorts *FirstValid_Outs `protobuf:"bytes,2,opt,name=out_ports,json=outPorts,proto3" json:"out_ports,omitempty"`
 }
 
-func (x *EMA_Outs) Reset() {
-	*x = EMA_Outs{}
+func (x *FirstValid) Reset() {
+	*x = FirstValid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[27]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EMA_Outs) String() string {
+func (x *FirstValid) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EMA_Outs) ProtoMessage() {}
+func (*FirstValid) ProtoMessage() {}
 
-func (x *EMA_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[27]
+func (x *FirstValid) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2219,47 +3468,57 @@ func (x *EMA_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EMA_Outs.ProtoReflect.Descriptor instead.
-func (*EMA_Outs) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use FirstValid.ProtoReflect.Descriptor instead.
+func (*FirstValid) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *EMA_Outs) GetOutput() *Port {
+func (x *FirstValid) GetInPorts() *FirstValid_Ins {
 	if x != nil {
-		return x.Output
+		return x.InPorts
 	}
 	return nil
 }
 
-// Inputs for the Arithmetic Combinator component.
-type ArithmeticCombinator_Ins struct {
+func (x *FirstValid) GetOutPorts() *FirstValid_Outs {
+	if x != nil {
+		return x.OutPorts
+	}
+	return nil
+}
+
+// Logical negation of the input signal
+//
+// Emits 1.0 if the input signal is 0.0 and 0.0 otherwise, eg. to invert the output of a [Decider](#v1-decider).
+// The output is invalid if the input signal is invalid.
+type Inverter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Left hand side of the arithmetic operation.
-	Lhs *Port `protobuf:"bytes,1,opt,name=lhs,proto3" json:"lhs,omitempty"`
-	// Right hand side of the arithmetic operation.
-	Rhs *Port `protobuf:"bytes,2,opt,name=rhs,proto3" json:"rhs,omitempty"`
+	// Input ports for the Inverter component.
+	InPorts *Inverter_Ins `protobuf:"bytes,1,opt,name=in_ports,json=inPorts,proto3" json:"in_ports,omitempty"`
+	// Output ports for the Inverter component.
+	OutPorts *Inverter_Outs `protobuf:"bytes,2,opt,name=out_ports,json=outPorts,proto3" json:"out_ports,omitempty"`
 }
 
-func (x *ArithmeticCombinator_Ins) Reset() {
-	*x = ArithmeticCombinator_Ins{}
+func (x *Inverter) Reset() {
+	*x = Inverter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[28]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArithmeticCombinator_Ins) String() string {
+func (x *Inverter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArithmeticCombinator_Ins) ProtoMessage() {}
+func (*Inverter) ProtoMessage() {}
 
-func (x *ArithmeticCombinator_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[28]
+func (x *Inverter) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,52 +3529,64 @@ func (x *ArithmeticCombinator_Ins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArithmeticCombinator_Ins.ProtoReflect.Descriptor instead.
-func (*ArithmeticCombinator_Ins) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use Inverter.ProtoReflect.Descriptor instead.
+func (*Inverter) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ArithmeticCombinator_Ins) GetLhs() *Port {
+func (x *Inverter) GetInPorts() *Inverter_Ins {
 	if x != nil {
-		return x.Lhs
+		return x.InPorts
 	}
 	return nil
 }
 
-func (x *ArithmeticCombinator_Ins) GetRhs() *Port {
+func (x *Inverter) GetOutPorts() *Inverter_Outs {
 	if x != nil {
-		return x.Rhs
+		return x.OutPorts
 	}
 	return nil
 }
 
-// Outputs for the Arithmetic Combinator component.
-type ArithmeticCombinator_Outs struct {
+// Inputs for the Gradient Controller component.
+type GradientController_Ins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Result of arithmetic operation.
-	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// Signal to be used for the gradient computation.
+	Signal *Port `protobuf:"bytes,1,opt,name=signal,proto3" json:"signal,omitempty"`
+	// Setpoint to be used for the gradient computation.
+	Setpoint *Port `protobuf:"bytes,2,opt,name=setpoint,proto3" json:"setpoint,omitempty"`
+	// Optimize signal is added to the output of the gradient calculation.
+	Optimize *Port `protobuf:"bytes,3,opt,name=optimize,proto3" json:"optimize,omitempty"`
+	// Maximum value to limit the output signal.
+	Max *Port `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	// Minimum value to limit the output signal.
+	Min *Port `protobuf:"bytes,5,opt,name=min,proto3" json:"min,omitempty"`
+	// Actual current value of the control variable.
+	//
+	// This signal is multiplied by the gradient to produce the output.
+	ControlVariable *Port `protobuf:"bytes,6,opt,name=control_variable,json=controlVariable,proto3" json:"control_variable,omitempty"`
 }
 
-func (x *ArithmeticCombinator_Outs) Reset() {
-	*x = ArithmeticCombinator_Outs{}
+func (x *GradientController_Ins) Reset() {
+	*x = GradientController_Ins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[29]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArithmeticCombinator_Outs) String() string {
+func (x *GradientController_Ins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArithmeticCombinator_Outs) ProtoMessage() {}
+func (*GradientController_Ins) ProtoMessage() {}
 
-func (x *ArithmeticCombinator_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[29]
+func (x *GradientController_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2326,103 +3597,80 @@ func (x *ArithmeticCombinator_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArithmeticCombinator_Outs.ProtoReflect.Descriptor instead.
-func (*ArithmeticCombinator_Outs) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{9, 1}
+// Deprecated: Use GradientController_Ins.ProtoReflect.Descriptor instead.
+func (*GradientController_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{10, 0}
 }
 
-func (x *ArithmeticCombinator_Outs) GetOutput() *Port {
+func (x *GradientController_Ins) GetSignal() *Port {
 	if x != nil {
-		return x.Output
+		return x.Signal
 	}
 	return nil
 }
 
-// Inputs for the Decider component.
-type Decider_Ins struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Left hand side input signal for the comparison operation.
-	Lhs *Port `protobuf:"bytes,1,opt,name=lhs,proto3" json:"lhs,omitempty"`
-	// Right hand side input signal for the comparison operation.
-	Rhs *Port `protobuf:"bytes,2,opt,name=rhs,proto3" json:"rhs,omitempty"`
-}
-
-func (x *Decider_Ins) Reset() {
-	*x = Decider_Ins{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GradientController_Ins) GetSetpoint() *Port {
+	if x != nil {
+		return x.Setpoint
 	}
+	return nil
 }
 
-func (x *Decider_Ins) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Decider_Ins) ProtoMessage() {}
-
-func (x *Decider_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GradientController_Ins) GetOptimize() *Port {
+	if x != nil {
+		return x.Optimize
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Decider_Ins.ProtoReflect.Descriptor instead.
-func (*Decider_Ins) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{10, 0}
+func (x *GradientController_Ins) GetMax() *Port {
+	if x != nil {
+		return x.Max
+	}
+	return nil
 }
 
-func (x *Decider_Ins) GetLhs() *Port {
+func (x *GradientController_Ins) GetMin() *Port {
 	if x != nil {
-		return x.Lhs
+		return x.Min
 	}
 	return nil
 }
 
-func (x *Decider_Ins) GetRhs() *Port {
+func (x *GradientController_Ins) GetControlVariable() *Port {
 	if x != nil {
-		return x.Rhs
+		return x.ControlVariable
 	}
 	return nil
 }
 
-// Outputs for the Decider component.
-type Decider_Outs struct {
+// Outputs for the Gradient Controller component.
+type GradientController_Outs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Selected signal (1.0 or 0.0).
+	// Computed desired value of the control variable.
 	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *Decider_Outs) Reset() {
-	*x = Decider_Outs{}
+func (x *GradientController_Outs) Reset() {
+	*x = GradientController_Outs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[31]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Decider_Outs) String() string {
+func (x *GradientController_Outs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Decider_Outs) ProtoMessage() {}
+func (*GradientController_Outs) ProtoMessage() {}
 
-func (x *Decider_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[31]
+func (x *GradientController_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2433,49 +3681,53 @@ func (x *Decider_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Decider_Outs.ProtoReflect.Descriptor instead.
-func (*Decider_Outs) Descriptor() ([]byte, []int) {
+// Deprecated: Use GradientController_Outs.ProtoReflect.Descriptor instead.
+func (*GradientController_Outs) Descriptor() ([]byte, []int) {
 	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{10, 1}
 }
 
-func (x *Decider_Outs) GetOutput() *Port {
+func (x *GradientController_Outs) GetOutput() *Port {
 	if x != nil {
 		return x.Output
 	}
 	return nil
 }
 
-// Inputs for the Switcher component.
-type Switcher_Ins struct {
+// Inputs for the PID Controller component.
+type PIDController_Ins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Output signal when switch is valid and not 0.0.
-	OnTrue *Port `protobuf:"bytes,1,opt,name=on_true,json=onTrue,proto3" json:"on_true,omitempty"`
-	// Output signal when switch is invalid or 0.0.
-	OnFalse *Port `protobuf:"bytes,2,opt,name=on_false,json=onFalse,proto3" json:"on_false,omitempty"`
-	// Decides whether to return on_true or on_false.
-	Switch *Port `protobuf:"bytes,3,opt,name=switch,proto3" json:"switch,omitempty"`
+	// Signal to be compared with the setpoint.
+	Signal *Port `protobuf:"bytes,1,opt,name=signal,proto3" json:"signal,omitempty"`
+	// Setpoint to be used for the error computation.
+	Setpoint *Port `protobuf:"bytes,2,opt,name=setpoint,proto3" json:"setpoint,omitempty"`
+	// Optimize signal is added to the output of the PID computation, it is not accumulated in the integral term.
+	Optimize *Port `protobuf:"bytes,3,opt,name=optimize,proto3" json:"optimize,omitempty"`
+	// Maximum value to limit the output signal.
+	Max *Port `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	// Minimum value to limit the output signal.
+	Min *Port `protobuf:"bytes,5,opt,name=min,proto3" json:"min,omitempty"`
 }
 
-func (x *Switcher_Ins) Reset() {
-	*x = Switcher_Ins{}
+func (x *PIDController_Ins) Reset() {
+	*x = PIDController_Ins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[32]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Switcher_Ins) String() string {
+func (x *PIDController_Ins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Switcher_Ins) ProtoMessage() {}
+func (*PIDController_Ins) ProtoMessage() {}
 
-func (x *Switcher_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[32]
+func (x *PIDController_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2486,59 +3738,73 @@ func (x *Switcher_Ins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Switcher_Ins.ProtoReflect.Descriptor instead.
-func (*Switcher_Ins) Descriptor() ([]byte, []int) {
+// Deprecated: Use PIDController_Ins.ProtoReflect.Descriptor instead.
+func (*PIDController_Ins) Descriptor() ([]byte, []int) {
 	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{11, 0}
 }
 
-func (x *Switcher_Ins) GetOnTrue() *Port {
+func (x *PIDController_Ins) GetSignal() *Port {
 	if x != nil {
-		return x.OnTrue
+		return x.Signal
 	}
 	return nil
 }
 
-func (x *Switcher_Ins) GetOnFalse() *Port {
+func (x *PIDController_Ins) GetSetpoint() *Port {
 	if x != nil {
-		return x.OnFalse
+		return x.Setpoint
 	}
 	return nil
 }
 
-func (x *Switcher_Ins) GetSwitch() *Port {
+func (x *PIDController_Ins) GetOptimize() *Port {
 	if x != nil {
-		return x.Switch
+		return x.Optimize
 	}
 	return nil
 }
 
-// Outputs for the Switcher component.
-type Switcher_Outs struct {
+func (x *PIDController_Ins) GetMax() *Port {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *PIDController_Ins) GetMin() *Port {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+// Outputs for the PID Controller component.
+type PIDController_Outs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Selected signal (on_true or on_false).
+	// Computed desired value of the control variable.
 	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *Switcher_Outs) Reset() {
-	*x = Switcher_Outs{}
+func (x *PIDController_Outs) Reset() {
+	*x = PIDController_Outs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[33]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Switcher_Outs) String() string {
+func (x *PIDController_Outs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Switcher_Outs) ProtoMessage() {}
+func (*PIDController_Outs) ProtoMessage() {}
 
-func (x *Switcher_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[33]
+func (x *PIDController_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2549,46 +3815,63 @@ func (x *Switcher_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Switcher_Outs.ProtoReflect.Descriptor instead.
-func (*Switcher_Outs) Descriptor() ([]byte, []int) {
+// Deprecated: Use PIDController_Outs.ProtoReflect.Descriptor instead.
+func (*PIDController_Outs) Descriptor() ([]byte, []int) {
 	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{11, 1}
 }
 
-func (x *Switcher_Outs) GetOutput() *Port {
+func (x *PIDController_Outs) GetOutput() *Port {
 	if x != nil {
 		return x.Output
 	}
 	return nil
 }
 
-type RateLimiter_LazySync struct {
+// Inputs for the EMA component.
+type EMA_Ins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Enables lazy sync
-	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" default:"false"` // @gotags: default:"false"
-	// Number of times to lazy sync within the _limit\_reset\_interval_.
-	NumSync uint32 `protobuf:"varint,2,opt,name=num_sync,json=numSync,proto3" json:"num_sync,omitempty" default:"5" validate:"gt=0"` // @gotags: default:"5" validate:"gt=0"
+	// Input signal to be used for the EMA computation.
+	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// Upper bound of the moving average.
+	//
+	// Used during the warm-up stage: if the signal would exceed `max_envelope`
+	// it's multiplied by `correction_factor_on_max_envelope_violation` **once per tick**.
+	//
+	// :::note
+	// If the signal deviates from `max_envelope` faster than the correction
+	// faster, it might end up exceeding the envelope.
+	// :::
+	//
+	// :::note
+	// The envelope logic is **not** used outside the warm-up stage!
+	// :::
+	MaxEnvelope *Port `protobuf:"bytes,2,opt,name=max_envelope,json=maxEnvelope,proto3" json:"max_envelope,omitempty"`
+	// Lower bound of the moving average.
+	//
+	// Used during the warm-up stage analogously to `max_envelope`.
+	MinEnvelope *Port `protobuf:"bytes,3,opt,name=min_envelope,json=minEnvelope,proto3" json:"min_envelope,omitempty"`
 }
 
-func (x *RateLimiter_LazySync) Reset() {
-	*x = RateLimiter_LazySync{}
+func (x *EMA_Ins) Reset() {
+	*x = EMA_Ins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[34]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RateLimiter_LazySync) String() string {
+func (x *EMA_Ins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimiter_LazySync) ProtoMessage() {}
+func (*EMA_Ins) ProtoMessage() {}
 
-func (x *RateLimiter_LazySync) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[34]
+func (x *EMA_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2599,52 +3882,59 @@ func (x *RateLimiter_LazySync) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateLimiter_LazySync.ProtoReflect.Descriptor instead.
-func (*RateLimiter_LazySync) Descriptor() ([]byte, []int) {
+// Deprecated: Use EMA_Ins.ProtoReflect.Descriptor instead.
+func (*EMA_Ins) Descriptor() ([]byte, []int) {
 	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{12, 0}
 }
 
-func (x *RateLimiter_LazySync) GetEnabled() bool {
+func (x *EMA_Ins) GetInput() *Port {
 	if x != nil {
-		return x.Enabled
+		return x.Input
 	}
-	return false
+	return nil
 }
 
-func (x *RateLimiter_LazySync) GetNumSync() uint32 {
+func (x *EMA_Ins) GetMaxEnvelope() *Port {
 	if x != nil {
-		return x.NumSync
+		return x.MaxEnvelope
 	}
-	return 0
+	return nil
 }
 
-// Dynamic Configuration for the rate limiter
-type RateLimiter_DynamicConfig struct {
+func (x *EMA_Ins) GetMinEnvelope() *Port {
+	if x != nil {
+		return x.MinEnvelope
+	}
+	return nil
+}
+
+// Outputs for the EMA component.
+type EMA_Outs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Allows to specify different limits for particular label values.
-	Overrides []*RateLimiter_Override `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	// Exponential moving average of the series of reading as an output signal.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *RateLimiter_DynamicConfig) Reset() {
-	*x = RateLimiter_DynamicConfig{}
+func (x *EMA_Outs) Reset() {
+	*x = EMA_Outs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[35]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RateLimiter_DynamicConfig) String() string {
+func (x *EMA_Outs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimiter_DynamicConfig) ProtoMessage() {}
+func (*EMA_Outs) ProtoMessage() {}
 
-func (x *RateLimiter_DynamicConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[35]
+func (x *EMA_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2655,46 +3945,45 @@ func (x *RateLimiter_DynamicConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateLimiter_DynamicConfig.ProtoReflect.Descriptor instead.
-func (*RateLimiter_DynamicConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EMA_Outs.ProtoReflect.Descriptor instead.
+func (*EMA_Outs) Descriptor() ([]byte, []int) {
 	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{12, 1}
 }
 
-func (x *RateLimiter_DynamicConfig) GetOverrides() []*RateLimiter_Override {
+func (x *EMA_Outs) GetOutput() *Port {
 	if x != nil {
-		return x.Overrides
+		return x.Output
 	}
 	return nil
 }
 
-type RateLimiter_Override struct {
+// Inputs for the Holt-Winters component.
+type HoltWinters_Ins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Value of the label for which the override should be applied.
-	LabelValue string `protobuf:"bytes,1,opt,name=label_value,json=labelValue,proto3" json:"label_value,omitempty" validate:"required"` // @gotags: validate:"required"
-	// Amount by which the _in\_ports.limit_ should be multiplied for this label value.
-	LimitScaleFactor float64 `protobuf:"fixed64,2,opt,name=limit_scale_factor,json=limitScaleFactor,proto3" json:"limit_scale_factor,omitempty" default:"1.0"` // @gotags: default:"1.0"
+	// Input signal to be smoothed.
+	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
 }
 
-func (x *RateLimiter_Override) Reset() {
-	*x = RateLimiter_Override{}
+func (x *HoltWinters_Ins) Reset() {
+	*x = HoltWinters_Ins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[36]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RateLimiter_Override) String() string {
+func (x *HoltWinters_Ins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimiter_Override) ProtoMessage() {}
+func (*HoltWinters_Ins) ProtoMessage() {}
 
-func (x *RateLimiter_Override) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[36]
+func (x *HoltWinters_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2705,58 +3994,49 @@ func (x *RateLimiter_Override) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateLimiter_Override.ProtoReflect.Descriptor instead.
-func (*RateLimiter_Override) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{12, 2}
-}
-
-func (x *RateLimiter_Override) GetLabelValue() string {
-	if x != nil {
-		return x.LabelValue
-	}
-	return ""
+// Deprecated: Use HoltWinters_Ins.ProtoReflect.Descriptor instead.
+func (*HoltWinters_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{13, 0}
 }
 
-func (x *RateLimiter_Override) GetLimitScaleFactor() float64 {
+func (x *HoltWinters_Ins) GetInput() *Port {
 	if x != nil {
-		return x.LimitScaleFactor
+		return x.Input
 	}
-	return 0
+	return nil
 }
 
-// Inputs for the RateLimiter component
-type RateLimiter_Ins struct {
+// Outputs for the Holt-Winters component.
+type HoltWinters_Outs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Number of flows allowed per _limit\_reset\_interval_ per each label.
-	// Negative values disable the ratelimiter.
-	//
-	// :::tip
-	// Negative limit can be useful to _conditionally_ enable the ratelimiter
-	// under certain circumstances. [Decider](#v1-decider) might be helpful.
-	// :::
-	Limit *Port `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty" validate:"required"` // @gotags: validate:"required"
+	// Smoothed signal, the level with the seasonal component of the current tick.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// Trend of the level, as change per second.
+	Trend *Port `protobuf:"bytes,2,opt,name=trend,proto3" json:"trend,omitempty"`
+	// Value of the signal forecasted forecast_horizon ahead.
+	Forecast *Port `protobuf:"bytes,3,opt,name=forecast,proto3" json:"forecast,omitempty"`
 }
 
-func (x *RateLimiter_Ins) Reset() {
-	*x = RateLimiter_Ins{}
+func (x *HoltWinters_Outs) Reset() {
+	*x = HoltWinters_Outs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[37]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RateLimiter_Ins) String() string {
+func (x *HoltWinters_Outs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimiter_Ins) ProtoMessage() {}
+func (*HoltWinters_Outs) ProtoMessage() {}
 
-func (x *RateLimiter_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[37]
+func (x *HoltWinters_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2767,55 +4047,61 @@ func (x *RateLimiter_Ins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateLimiter_Ins.ProtoReflect.Descriptor instead.
-func (*RateLimiter_Ins) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{12, 3}
+// Deprecated: Use HoltWinters_Outs.ProtoReflect.Descriptor instead.
+func (*HoltWinters_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{13, 1}
 }
 
-func (x *RateLimiter_Ins) GetLimit() *Port {
+func (x *HoltWinters_Outs) GetOutput() *Port {
 	if x != nil {
-		return x.Limit
+		return x.Output
 	}
 	return nil
 }
 
-// WorkloadParameters defines parameters such as priority, tokens and fairness key that are applicable to flows within a workload.
-type Scheduler_WorkloadParameters struct {
+func (x *HoltWinters_Outs) GetTrend() *Port {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+func (x *HoltWinters_Outs) GetForecast() *Port {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+// Inputs for the Arithmetic Combinator component.
+type ArithmeticCombinator_Ins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Describes priority level of the requests within the workload.
-	// Priority level ranges from 0 to 255.
-	// Higher numbers means higher priority level.
-	Priority uint32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty" validate:"gte=0,lte=255"` // @gotags: validate:"gte=0,lte=255"
-	// Tokens determines the cost of admitting a single request the workload, which is typically defined as milliseconds of response latency.
-	// This override is applicable only if `auto_tokens` is set to false.
-	Tokens uint64 `protobuf:"varint,2,opt,name=tokens,proto3" json:"tokens,omitempty" default:"1"` // @gotags: default:"1"
-	// Fairness key is a label key that can be used to provide fairness within a workload.
-	// Any [flow label](/concepts/flow-control/flow-label.md) can be used here. Eg. if
-	// you have a classifier that sets `user` flow label, you might want to set
-	// `fairness_key = "user"`.
-	FairnessKey string `protobuf:"bytes,3,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	// Left hand side of the arithmetic operation.
+	Lhs *Port `protobuf:"bytes,1,opt,name=lhs,proto3" json:"lhs,omitempty"`
+	// Right hand side of the arithmetic operation.
+	Rhs *Port `protobuf:"bytes,2,opt,name=rhs,proto3" json:"rhs,omitempty"`
 }
 
-func (x *Scheduler_WorkloadParameters) Reset() {
-	*x = Scheduler_WorkloadParameters{}
+func (x *ArithmeticCombinator_Ins) Reset() {
+	*x = ArithmeticCombinator_Ins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[38]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Scheduler_WorkloadParameters) String() string {
+func (x *ArithmeticCombinator_Ins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Scheduler_WorkloadParameters) ProtoMessage() {}
+func (*ArithmeticCombinator_Ins) ProtoMessage() {}
 
-func (x *Scheduler_WorkloadParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[38]
+func (x *ArithmeticCombinator_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2826,62 +4112,52 @@ func (x *Scheduler_WorkloadParameters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Scheduler_WorkloadParameters.ProtoReflect.Descriptor instead.
-func (*Scheduler_WorkloadParameters) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArithmeticCombinator_Ins.ProtoReflect.Descriptor instead.
+func (*ArithmeticCombinator_Ins) Descriptor() ([]byte, []int) {
 	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{14, 0}
 }
 
-func (x *Scheduler_WorkloadParameters) GetPriority() uint32 {
-	if x != nil {
-		return x.Priority
-	}
-	return 0
-}
-
-func (x *Scheduler_WorkloadParameters) GetTokens() uint64 {
+func (x *ArithmeticCombinator_Ins) GetLhs() *Port {
 	if x != nil {
-		return x.Tokens
+		return x.Lhs
 	}
-	return 0
+	return nil
 }
 
-func (x *Scheduler_WorkloadParameters) GetFairnessKey() string {
+func (x *ArithmeticCombinator_Ins) GetRhs() *Port {
 	if x != nil {
-		return x.FairnessKey
+		return x.Rhs
 	}
-	return ""
+	return nil
 }
 
-// Workload defines a class of requests that preferably have similar properties such as response latency or desired priority.
-type Scheduler_Workload struct {
+// Outputs for the Arithmetic Combinator component.
+type ArithmeticCombinator_Outs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// WorkloadParameters associated with flows matching the label matcher.
-	WorkloadParameters *Scheduler_WorkloadParameters `protobuf:"bytes,1,opt,name=workload_parameters,json=workloadParameters,proto3" json:"workload_parameters,omitempty"`
-	// Label Matcher to select a Workload based on
-	// [flow labels](/concepts/flow-control/flow-label.md).
-	LabelMatcher *v11.LabelMatcher `protobuf:"bytes,2,opt,name=label_matcher,json=labelMatcher,proto3" json:"label_matcher,omitempty"`
+	// Result of arithmetic operation.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *Scheduler_Workload) Reset() {
-	*x = Scheduler_Workload{}
+func (x *ArithmeticCombinator_Outs) Reset() {
+	*x = ArithmeticCombinator_Outs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[39]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Scheduler_Workload) String() string {
+func (x *ArithmeticCombinator_Outs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Scheduler_Workload) ProtoMessage() {}
+func (*ArithmeticCombinator_Outs) ProtoMessage() {}
 
-func (x *Scheduler_Workload) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[39]
+func (x *ArithmeticCombinator_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2892,66 +4168,47 @@ func (x *Scheduler_Workload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Scheduler_Workload.ProtoReflect.Descriptor instead.
-func (*Scheduler_Workload) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArithmeticCombinator_Outs.ProtoReflect.Descriptor instead.
+func (*ArithmeticCombinator_Outs) Descriptor() ([]byte, []int) {
 	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{14, 1}
 }
 
-func (x *Scheduler_Workload) GetWorkloadParameters() *Scheduler_WorkloadParameters {
-	if x != nil {
-		return x.WorkloadParameters
-	}
-	return nil
-}
-
-func (x *Scheduler_Workload) GetLabelMatcher() *v11.LabelMatcher {
+func (x *ArithmeticCombinator_Outs) GetOutput() *Port {
 	if x != nil {
-		return x.LabelMatcher
+		return x.Output
 	}
 	return nil
 }
 
-// Output for the Scheduler component.
-type Scheduler_Outs struct {
+// Inputs for the Decider component.
+type Decider_Ins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Accepted concurrency is the number of accepted tokens per second.
-	//
-	// :::info
-	// **Accepted tokens** are tokens associated with
-	// [flows](/concepts/flow-control/flow-control.md#flow) that were accepted by
-	// this scheduler. Number of tokens for a flow is determined by a
-	// [workload parameters](#scheduler-workload-parameters) that the flow was assigned to (either
-	// via `auto_tokens` or explicitly by `Workload.tokens`).
-	// :::
-	//
-	// Value of this signal is the sum across all the relevant schedulers.
-	AcceptedConcurrency *Port `protobuf:"bytes,1,opt,name=accepted_concurrency,json=acceptedConcurrency,proto3" json:"accepted_concurrency,omitempty"`
-	// Incoming concurrency is the number of incoming tokens/sec.
-	// This is the same as `accepted_concurrency`, but across all the flows
-	// entering scheduler, including rejected ones.
-	IncomingConcurrency *Port `protobuf:"bytes,2,opt,name=incoming_concurrency,json=incomingConcurrency,proto3" json:"incoming_concurrency,omitempty"`
+	// Left hand side input signal for the comparison operation.
+	Lhs *Port `protobuf:"bytes,1,opt,name=lhs,proto3" json:"lhs,omitempty"`
+	// Right hand side input signal for the comparison operation.
+	Rhs *Port `protobuf:"bytes,2,opt,name=rhs,proto3" json:"rhs,omitempty"`
 }
 
-func (x *Scheduler_Outs) Reset() {
-	*x = Scheduler_Outs{}
+func (x *Decider_Ins) Reset() {
+	*x = Decider_Ins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[40]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Scheduler_Outs) String() string {
+func (x *Decider_Ins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Scheduler_Outs) ProtoMessage() {}
+func (*Decider_Ins) ProtoMessage() {}
 
-func (x *Scheduler_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[40]
+func (x *Decider_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2962,53 +4219,52 @@ func (x *Scheduler_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Scheduler_Outs.ProtoReflect.Descriptor instead.
-func (*Scheduler_Outs) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{14, 2}
+// Deprecated: Use Decider_Ins.ProtoReflect.Descriptor instead.
+func (*Decider_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{15, 0}
 }
 
-func (x *Scheduler_Outs) GetAcceptedConcurrency() *Port {
+func (x *Decider_Ins) GetLhs() *Port {
 	if x != nil {
-		return x.AcceptedConcurrency
+		return x.Lhs
 	}
 	return nil
 }
 
-func (x *Scheduler_Outs) GetIncomingConcurrency() *Port {
+func (x *Decider_Ins) GetRhs() *Port {
 	if x != nil {
-		return x.IncomingConcurrency
+		return x.Rhs
 	}
 	return nil
 }
 
-// Input for the Load Shed Actuator component.
-type LoadShedActuator_Ins struct {
+// Outputs for the Decider component.
+type Decider_Outs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Load shedding factor is a fraction of [incoming
-	// concurrency](#v1-scheduler-outs) that needs to be dropped.
-	LoadShedFactor *Port `protobuf:"bytes,1,opt,name=load_shed_factor,json=loadShedFactor,proto3" json:"load_shed_factor,omitempty"`
+	// Selected signal (1.0 or 0.0).
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *LoadShedActuator_Ins) Reset() {
-	*x = LoadShedActuator_Ins{}
+func (x *Decider_Outs) Reset() {
+	*x = Decider_Outs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[41]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoadShedActuator_Ins) String() string {
+func (x *Decider_Outs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadShedActuator_Ins) ProtoMessage() {}
+func (*Decider_Outs) ProtoMessage() {}
 
-func (x *LoadShedActuator_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[41]
+func (x *Decider_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3019,45 +4275,49 @@ func (x *LoadShedActuator_Ins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadShedActuator_Ins.ProtoReflect.Descriptor instead.
-func (*LoadShedActuator_Ins) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use Decider_Outs.ProtoReflect.Descriptor instead.
+func (*Decider_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{15, 1}
 }
 
-func (x *LoadShedActuator_Ins) GetLoadShedFactor() *Port {
+func (x *Decider_Outs) GetOutput() *Port {
 	if x != nil {
-		return x.LoadShedFactor
+		return x.Output
 	}
 	return nil
 }
 
-// Output for the PromQL component.
-type PromQL_Outs struct {
+// Inputs for the Switcher component.
+type Switcher_Ins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The result of the Prometheus query as an output signal.
-	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// Output signal when switch is valid and not 0.0.
+	OnTrue *Port `protobuf:"bytes,1,opt,name=on_true,json=onTrue,proto3" json:"on_true,omitempty"`
+	// Output signal when switch is invalid or 0.0.
+	OnFalse *Port `protobuf:"bytes,2,opt,name=on_false,json=onFalse,proto3" json:"on_false,omitempty"`
+	// Decides whether to return on_true or on_false.
+	Switch *Port `protobuf:"bytes,3,opt,name=switch,proto3" json:"switch,omitempty"`
 }
 
-func (x *PromQL_Outs) Reset() {
-	*x = PromQL_Outs{}
+func (x *Switcher_Ins) Reset() {
+	*x = Switcher_Ins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[42]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PromQL_Outs) String() string {
+func (x *Switcher_Ins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromQL_Outs) ProtoMessage() {}
+func (*Switcher_Ins) ProtoMessage() {}
 
-func (x *PromQL_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[42]
+func (x *Switcher_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3068,45 +4328,59 @@ func (x *PromQL_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromQL_Outs.ProtoReflect.Descriptor instead.
-func (*PromQL_Outs) Descriptor() ([]byte, []int) {
+// Deprecated: Use Switcher_Ins.ProtoReflect.Descriptor instead.
+func (*Switcher_Ins) Descriptor() ([]byte, []int) {
 	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{16, 0}
 }
 
-func (x *PromQL_Outs) GetOutput() *Port {
+func (x *Switcher_Ins) GetOnTrue() *Port {
 	if x != nil {
-		return x.Output
+		return x.OnTrue
 	}
 	return nil
 }
 
-// Outputs for the Constant component.
-type Constant_Outs struct {
+func (x *Switcher_Ins) GetOnFalse() *Port {
+	if x != nil {
+		return x.OnFalse
+	}
+	return nil
+}
+
+func (x *Switcher_Ins) GetSwitch() *Port {
+	if x != nil {
+		return x.Switch
+	}
+	return nil
+}
+
+// Outputs for the Switcher component.
+type Switcher_Outs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The constant value is emitted to the output port.
+	// Selected signal (on_true or on_false).
 	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *Constant_Outs) Reset() {
-	*x = Constant_Outs{}
+func (x *Switcher_Outs) Reset() {
+	*x = Switcher_Outs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[43]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Constant_Outs) String() string {
+func (x *Switcher_Outs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Constant_Outs) ProtoMessage() {}
+func (*Switcher_Outs) ProtoMessage() {}
 
-func (x *Constant_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[43]
+func (x *Switcher_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3117,45 +4391,46 @@ func (x *Constant_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Constant_Outs.ProtoReflect.Descriptor instead.
-func (*Constant_Outs) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use Switcher_Outs.ProtoReflect.Descriptor instead.
+func (*Switcher_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{16, 1}
 }
 
-func (x *Constant_Outs) GetOutput() *Port {
+func (x *Switcher_Outs) GetOutput() *Port {
 	if x != nil {
 		return x.Output
 	}
 	return nil
 }
 
-// Inputs for the Sqrt component.
-type Sqrt_Ins struct {
+// Inputs for the QuotaLimiter component
+type QuotaLimiter_Ins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Input signal.
-	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// Number of flows allowed per window per each label.
+	// Negative values disable the quota limiter.
+	Limit *Port `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *Sqrt_Ins) Reset() {
-	*x = Sqrt_Ins{}
+func (x *QuotaLimiter_Ins) Reset() {
+	*x = QuotaLimiter_Ins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[44]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sqrt_Ins) String() string {
+func (x *QuotaLimiter_Ins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sqrt_Ins) ProtoMessage() {}
+func (*QuotaLimiter_Ins) ProtoMessage() {}
 
-func (x *Sqrt_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[44]
+func (x *QuotaLimiter_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3166,45 +4441,46 @@ func (x *Sqrt_Ins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sqrt_Ins.ProtoReflect.Descriptor instead.
-func (*Sqrt_Ins) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use QuotaLimiter_Ins.ProtoReflect.Descriptor instead.
+func (*QuotaLimiter_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{17, 0}
 }
 
-func (x *Sqrt_Ins) GetInput() *Port {
+func (x *QuotaLimiter_Ins) GetLimit() *Port {
 	if x != nil {
-		return x.Input
+		return x.Limit
 	}
 	return nil
 }
 
-// Outputs for the Sqrt component.
-type Sqrt_Outs struct {
+type RateLimiter_LazySync struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Output signal.
-	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// Enables lazy sync
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" default:"false"` // @gotags: default:"false"
+	// Number of times to lazy sync within the _limit\_reset\_interval_.
+	NumSync uint32 `protobuf:"varint,2,opt,name=num_sync,json=numSync,proto3" json:"num_sync,omitempty" default:"5" validate:"gt=0"` // @gotags: default:"5" validate:"gt=0"
 }
 
-func (x *Sqrt_Outs) Reset() {
-	*x = Sqrt_Outs{}
+func (x *RateLimiter_LazySync) Reset() {
+	*x = RateLimiter_LazySync{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[45]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sqrt_Outs) String() string {
+func (x *RateLimiter_LazySync) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sqrt_Outs) ProtoMessage() {}
+func (*RateLimiter_LazySync) ProtoMessage() {}
 
-func (x *Sqrt_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[45]
+func (x *RateLimiter_LazySync) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3215,45 +4491,52 @@ func (x *Sqrt_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sqrt_Outs.ProtoReflect.Descriptor instead.
-func (*Sqrt_Outs) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{18, 1}
+// Deprecated: Use RateLimiter_LazySync.ProtoReflect.Descriptor instead.
+func (*RateLimiter_LazySync) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{18, 0}
 }
 
-func (x *Sqrt_Outs) GetOutput() *Port {
+func (x *RateLimiter_LazySync) GetEnabled() bool {
 	if x != nil {
-		return x.Output
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-// Inputs for the Extrapolator component.
-type Extrapolator_Ins struct {
+func (x *RateLimiter_LazySync) GetNumSync() uint32 {
+	if x != nil {
+		return x.NumSync
+	}
+	return 0
+}
+
+// Dynamic Configuration for the rate limiter
+type RateLimiter_DynamicConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Input signal for the Extrapolator component.
-	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// Allows to specify different limits for particular label values.
+	Overrides []*RateLimiter_Override `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
-func (x *Extrapolator_Ins) Reset() {
-	*x = Extrapolator_Ins{}
+func (x *RateLimiter_DynamicConfig) Reset() {
+	*x = RateLimiter_DynamicConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[46]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Extrapolator_Ins) String() string {
+func (x *RateLimiter_DynamicConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Extrapolator_Ins) ProtoMessage() {}
+func (*RateLimiter_DynamicConfig) ProtoMessage() {}
 
-func (x *Extrapolator_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[46]
+func (x *RateLimiter_DynamicConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3264,45 +4547,55 @@ func (x *Extrapolator_Ins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Extrapolator_Ins.ProtoReflect.Descriptor instead.
-func (*Extrapolator_Ins) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{19, 0}
+// Deprecated: Use RateLimiter_DynamicConfig.ProtoReflect.Descriptor instead.
+func (*RateLimiter_DynamicConfig) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{18, 1}
 }
 
-func (x *Extrapolator_Ins) GetInput() *Port {
+func (x *RateLimiter_DynamicConfig) GetOverrides() []*RateLimiter_Override {
 	if x != nil {
-		return x.Input
+		return x.Overrides
 	}
 	return nil
 }
 
-// Outputs for the Extrapolator component.
-type Extrapolator_Outs struct {
+type RateLimiter_Override struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Extrapolated signal.
-	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// Value of the label for which the override should be applied.
+	//
+	// Used when the rate limiter is keyed by a single label, `*` matches any value.
+	LabelValue string `protobuf:"bytes,1,opt,name=label_value,json=labelValue,proto3" json:"label_value,omitempty" validate:"required_without=LabelValues"` // @gotags: validate:"required_without=LabelValues"
+	// Amount by which the _in\_ports.limit_ should be multiplied for this label value.
+	LimitScaleFactor float64 `protobuf:"fixed64,2,opt,name=limit_scale_factor,json=limitScaleFactor,proto3" json:"limit_scale_factor,omitempty" default:"1.0"` // @gotags: default:"1.0"
+	// Values of the _label\_keys_ for which the override should be applied, in the same order.
+	//
+	// Used when the rate limiter is keyed by multiple labels. `*` matches any
+	// value, eg. `["acme", "*"]` applies to all endpoints of the _acme_ tenant.
+	// Overrides with exact values take precedence, otherwise the first matching
+	// override is applied.
+	LabelValues []string `protobuf:"bytes,3,rep,name=label_values,json=labelValues,proto3" json:"label_values,omitempty" validate:"required_without=LabelValue"` // @gotags: validate:"required_without=LabelValue"
 }
 
-func (x *Extrapolator_Outs) Reset() {
-	*x = Extrapolator_Outs{}
+func (x *RateLimiter_Override) Reset() {
+	*x = RateLimiter_Override{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[47]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Extrapolator_Outs) String() string {
+func (x *RateLimiter_Override) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Extrapolator_Outs) ProtoMessage() {}
+func (*RateLimiter_Override) ProtoMessage() {}
 
-func (x *Extrapolator_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[47]
+func (x *RateLimiter_Override) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3313,45 +4606,75 @@ func (x *Extrapolator_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Extrapolator_Outs.ProtoReflect.Descriptor instead.
-func (*Extrapolator_Outs) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{19, 1}
+// Deprecated: Use RateLimiter_Override.ProtoReflect.Descriptor instead.
+func (*RateLimiter_Override) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{18, 2}
 }
 
-func (x *Extrapolator_Outs) GetOutput() *Port {
+func (x *RateLimiter_Override) GetLabelValue() string {
 	if x != nil {
-		return x.Output
+		return x.LabelValue
+	}
+	return ""
+}
+
+func (x *RateLimiter_Override) GetLimitScaleFactor() float64 {
+	if x != nil {
+		return x.LimitScaleFactor
+	}
+	return 0
+}
+
+func (x *RateLimiter_Override) GetLabelValues() []string {
+	if x != nil {
+		return x.LabelValues
 	}
 	return nil
 }
 
-// Inputs for the Max component.
-type Max_Ins struct {
+// Inputs for the RateLimiter component
+//
+// Exactly one of _limit_ and _fill\_rate_ must be connected.
+type RateLimiter_Ins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Array of input signals.
-	Inputs []*Port `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Number of flows allowed per _limit\_reset\_interval_ per each label.
+	// Negative values disable the ratelimiter.
+	//
+	// :::tip
+	// Negative limit can be useful to _conditionally_ enable the ratelimiter
+	// under certain circumstances. [Decider](#v1-decider) might be helpful.
+	// :::
+	Limit *Port `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Number of tokens added per second to the token bucket of each label.
+	// Connecting this port switches the ratelimiter to token bucket mode.
+	// Negative values disable the ratelimiter.
+	FillRate *Port `protobuf:"bytes,2,opt,name=fill_rate,json=fillRate,proto3" json:"fill_rate,omitempty"`
+	// Maximum number of tokens in the token bucket of each label, that is,
+	// the largest allowed burst of flows. Defaults to _fill\_rate_ when not
+	// connected or invalid. Used only in token bucket mode.
+	BucketCapacity *Port `protobuf:"bytes,3,opt,name=bucket_capacity,json=bucketCapacity,proto3" json:"bucket_capacity,omitempty"`
 }
 
-func (x *Max_Ins) Reset() {
-	*x = Max_Ins{}
+func (x *RateLimiter_Ins) Reset() {
+	*x = RateLimiter_Ins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[48]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Max_Ins) String() string {
+func (x *RateLimiter_Ins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Max_Ins) ProtoMessage() {}
+func (*RateLimiter_Ins) ProtoMessage() {}
 
-func (x *Max_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[48]
+func (x *RateLimiter_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3362,45 +4685,77 @@ func (x *Max_Ins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Max_Ins.ProtoReflect.Descriptor instead.
-func (*Max_Ins) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use RateLimiter_Ins.ProtoReflect.Descriptor instead.
+func (*RateLimiter_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{18, 3}
 }
 
-func (x *Max_Ins) GetInputs() []*Port {
+func (x *RateLimiter_Ins) GetLimit() *Port {
 	if x != nil {
-		return x.Inputs
+		return x.Limit
 	}
 	return nil
 }
 
-// Output for the Max component.
-type Max_Outs struct {
+func (x *RateLimiter_Ins) GetFillRate() *Port {
+	if x != nil {
+		return x.FillRate
+	}
+	return nil
+}
+
+func (x *RateLimiter_Ins) GetBucketCapacity() *Port {
+	if x != nil {
+		return x.BucketCapacity
+	}
+	return nil
+}
+
+// WorkloadParameters defines parameters such as priority, tokens and fairness key that are applicable to flows within a workload.
+type Scheduler_WorkloadParameters struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Signal with maximum value as an output signal.
-	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// Describes priority level of the requests within the workload.
+	// Priority level ranges from 0 to 255.
+	// Higher numbers means higher priority level.
+	Priority uint32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty" validate:"gte=0,lte=255"` // @gotags: validate:"gte=0,lte=255"
+	// Tokens determines the cost of admitting a single request the workload, which is typically defined as milliseconds of response latency.
+	// This override is applicable only if `auto_tokens` is set to false.
+	Tokens uint64 `protobuf:"varint,2,opt,name=tokens,proto3" json:"tokens,omitempty" default:"1"` // @gotags: default:"1"
+	// Fairness key is a label key that can be used to provide fairness within a workload.
+	// Any [flow label](/concepts/flow-control/flow-label.md) can be used here. Eg. if
+	// you have a classifier that sets `user` flow label, you might want to set
+	// `fairness_key = "user"`.
+	FairnessKey string `protobuf:"bytes,3,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	// Maximum number of requests of this workload that can wait in the scheduler queue.
+	// Requests arriving when the queue is full are rejected right away.
+	// Zero means unlimited.
+	MaxQueuedRequests uint64 `protobuf:"varint,4,opt,name=max_queued_requests,json=maxQueuedRequests,proto3" json:"max_queued_requests,omitempty"`
+	// Maximum number of tokens of this workload that can wait in the scheduler queue.
+	// Requests that would exceed this limit are rejected right away.
+	// Zero means unlimited.
+	MaxQueuedTokens uint64 `protobuf:"varint,5,opt,name=max_queued_tokens,json=maxQueuedTokens,proto3" json:"max_queued_tokens,omitempty"`
 }
 
-func (x *Max_Outs) Reset() {
-	*x = Max_Outs{}
+func (x *Scheduler_WorkloadParameters) Reset() {
+	*x = Scheduler_WorkloadParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[49]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Max_Outs) String() string {
+func (x *Scheduler_WorkloadParameters) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Max_Outs) ProtoMessage() {}
+func (*Scheduler_WorkloadParameters) ProtoMessage() {}
 
-func (x *Max_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[49]
+func (x *Scheduler_WorkloadParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3411,45 +4766,76 @@ func (x *Max_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Max_Outs.ProtoReflect.Descriptor instead.
-func (*Max_Outs) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{20, 1}
+// Deprecated: Use Scheduler_WorkloadParameters.ProtoReflect.Descriptor instead.
+func (*Scheduler_WorkloadParameters) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{20, 0}
 }
 
-func (x *Max_Outs) GetOutput() *Port {
+func (x *Scheduler_WorkloadParameters) GetPriority() uint32 {
 	if x != nil {
-		return x.Output
+		return x.Priority
 	}
-	return nil
+	return 0
 }
 
-// Inputs for the Min component.
-type Min_Ins struct {
+func (x *Scheduler_WorkloadParameters) GetTokens() uint64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+func (x *Scheduler_WorkloadParameters) GetFairnessKey() string {
+	if x != nil {
+		return x.FairnessKey
+	}
+	return ""
+}
+
+func (x *Scheduler_WorkloadParameters) GetMaxQueuedRequests() uint64 {
+	if x != nil {
+		return x.MaxQueuedRequests
+	}
+	return 0
+}
+
+func (x *Scheduler_WorkloadParameters) GetMaxQueuedTokens() uint64 {
+	if x != nil {
+		return x.MaxQueuedTokens
+	}
+	return 0
+}
+
+// Workload defines a class of requests that preferably have similar properties such as response latency or desired priority.
+type Scheduler_Workload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Array of input signals.
-	Inputs []*Port `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// WorkloadParameters associated with flows matching the label matcher.
+	WorkloadParameters *Scheduler_WorkloadParameters `protobuf:"bytes,1,opt,name=workload_parameters,json=workloadParameters,proto3" json:"workload_parameters,omitempty"`
+	// Label Matcher to select a Workload based on
+	// [flow labels](/concepts/flow-control/flow-label.md).
+	LabelMatcher *v11.LabelMatcher `protobuf:"bytes,2,opt,name=label_matcher,json=labelMatcher,proto3" json:"label_matcher,omitempty"`
 }
 
-func (x *Min_Ins) Reset() {
-	*x = Min_Ins{}
+func (x *Scheduler_Workload) Reset() {
+	*x = Scheduler_Workload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[50]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Min_Ins) String() string {
+func (x *Scheduler_Workload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Min_Ins) ProtoMessage() {}
+func (*Scheduler_Workload) ProtoMessage() {}
 
-func (x *Min_Ins) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[50]
+func (x *Scheduler_Workload) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3460,45 +4846,77 @@ func (x *Min_Ins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Min_Ins.ProtoReflect.Descriptor instead.
-func (*Min_Ins) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{21, 0}
+// Deprecated: Use Scheduler_Workload.ProtoReflect.Descriptor instead.
+func (*Scheduler_Workload) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{20, 1}
 }
 
-func (x *Min_Ins) GetInputs() []*Port {
+func (x *Scheduler_Workload) GetWorkloadParameters() *Scheduler_WorkloadParameters {
 	if x != nil {
-		return x.Inputs
+		return x.WorkloadParameters
 	}
 	return nil
 }
 
-// Output ports for the Min component.
-type Min_Outs struct {
+func (x *Scheduler_Workload) GetLabelMatcher() *v11.LabelMatcher {
+	if x != nil {
+		return x.LabelMatcher
+	}
+	return nil
+}
+
+// Output for the Scheduler component.
+type Scheduler_Outs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Signal with minimum value as an output signal.
-	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	// Accepted concurrency is the number of accepted tokens per second.
+	//
+	// :::info
+	// **Accepted tokens** are tokens associated with
+	// [flows](/concepts/flow-control/flow-control.md#flow) that were accepted by
+	// this scheduler. Number of tokens for a flow is determined by a
+	// [workload parameters](#scheduler-workload-parameters) that the flow was assigned to (either
+	// via `auto_tokens` or explicitly by `Workload.tokens`).
+	// :::
+	//
+	// Value of this signal is the sum across all the relevant schedulers.
+	AcceptedConcurrency *Port `protobuf:"bytes,1,opt,name=accepted_concurrency,json=acceptedConcurrency,proto3" json:"accepted_concurrency,omitempty"`
+	// Incoming concurrency is the number of incoming tokens/sec.
+	// This is the same as `accepted_concurrency`, but across all the flows
+	// entering scheduler, including rejected ones.
+	IncomingConcurrency *Port `protobuf:"bytes,2,opt,name=incoming_concurrency,json=incomingConcurrency,proto3" json:"incoming_concurrency,omitempty"`
+	// Queued requests is the number of requests waiting in the scheduler queue.
+	//
+	// Value of this signal is the sum across all the relevant schedulers and
+	// workloads, see `workload_queued_requests` for the queue depth of each workload.
+	QueuedRequests *Port `protobuf:"bytes,3,opt,name=queued_requests,json=queuedRequests,proto3" json:"queued_requests,omitempty"`
+	// Queued requests of each workload, the n-th signal is the number of requests
+	// of the n-th entry of `workloads` waiting in the scheduler queue.
+	// The default workload is not included.
+	//
+	// Value of each signal is the sum across all the relevant schedulers.
+	WorkloadQueuedRequests []*Port `protobuf:"bytes,4,rep,name=workload_queued_requests,json=workloadQueuedRequests,proto3" json:"workload_queued_requests,omitempty"`
 }
 
-func (x *Min_Outs) Reset() {
-	*x = Min_Outs{}
+func (x *Scheduler_Outs) Reset() {
+	*x = Scheduler_Outs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[51]
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Min_Outs) String() string {
+func (x *Scheduler_Outs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Min_Outs) ProtoMessage() {}
+func (*Scheduler_Outs) ProtoMessage() {}
 
-func (x *Min_Outs) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[51]
+func (x *Scheduler_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3509,871 +4927,3417 @@ func (x *Min_Outs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Min_Outs.ProtoReflect.Descriptor instead.
-func (*Min_Outs) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{21, 1}
+// Deprecated: Use Scheduler_Outs.ProtoReflect.Descriptor instead.
+func (*Scheduler_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{20, 2}
 }
 
-func (x *Min_Outs) GetOutput() *Port {
+func (x *Scheduler_Outs) GetAcceptedConcurrency() *Port {
 	if x != nil {
-		return x.Output
+		return x.AcceptedConcurrency
 	}
 	return nil
 }
 
-var File_aperture_policy_language_v1_policy_proto protoreflect.FileDescriptor
+func (x *Scheduler_Outs) GetIncomingConcurrency() *Port {
+	if x != nil {
+		return x.IncomingConcurrency
+	}
+	return nil
+}
 
-var file_aperture_policy_language_v1_policy_proto_rawDesc = []byte{
-	0x0a, 0x28, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x61, 0x70, 0x65, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x32, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
-	0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x61, 0x70, 0x65,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
-	0x65, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2b, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2f,
-	0x76, 0x31, 0x2f, 0x66, 0x6c, 0x75, 0x78, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
-	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x62, 0x0a,
-	0x13, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x69, 0x65, 0x73, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0b, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x12, 0x5c, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x1a,
-	0x63, 0x0a, 0x10, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x8e, 0x01, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
-	0x3e, 0x0a, 0x07, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x52, 0x07, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12,
-	0x44, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x07, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69,
-	0x74, 0x12, 0x68, 0x0a, 0x13, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x82, 0x03,
-	0x16, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
-	0x06, 0x1a, 0x04, 0x30, 0x2e, 0x35, 0x73, 0x52, 0x12, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x73, 0x22, 0x96, 0x02, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x12, 0x57, 0x0a, 0x0b, 0x66, 0x6c, 0x75, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
-	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x46,
-	0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
-	0x66, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x49, 0x0a, 0x0b, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
-	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x65, 0x0a, 0x0f, 0x46, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65,
-	0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x07, 0x0a,
-	0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x62, 0x0a, 0x13, 0x67, 0x72,
-	0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x12, 0x67, 0x72, 0x61, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x34,
-	0x0a, 0x03, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70,
-	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x4d, 0x41, 0x48, 0x00, 0x52,
-	0x03, 0x65, 0x6d, 0x61, 0x12, 0x68, 0x0a, 0x15, 0x61, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x65, 0x74,
-	0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x62,
-	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x14, 0x61, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x65, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x40,
-	0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x63, 0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x72,
-	0x12, 0x43, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x0c, 0x72, 0x61, 0x74,
-	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d,
-	0x71, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x51, 0x4c, 0x48, 0x00, 0x52,
-	0x06, 0x70, 0x72, 0x6f, 0x6d, 0x71, 0x6c, 0x12, 0x43, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x04,
-	0x73, 0x71, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x72, 0x74, 0x48, 0x00, 0x52,
-	0x04, 0x73, 0x71, 0x72, 0x74, 0x12, 0x4f, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f,
-	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70,
-	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x70,
-	0x6f, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x70,
-	0x6f, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x78, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x34, 0x0a, 0x03,
-	0x6d, 0x69, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x65, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x6d,
-	0x69, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22,
-	0x27, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb4, 0x06, 0x0a, 0x12, 0x47, 0x72, 0x61,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12,
-	0x4e, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
-	0x51, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72,
-	0x74, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x6d,
-	0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
-	0xef, 0xff, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x42, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78,
-	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xef, 0x7f, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x61, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x1a, 0xf6, 0x02, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
-	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x73, 0x65, 0x74,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69,
-	0x6d, 0x69, 0x7a, 0x65, 0x12, 0x33, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x6f, 0x72, 0x74, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x33, 0x0a, 0x03, 0x6d, 0x69, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
-	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x4c,
-	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x41, 0x0a, 0x04,
-	0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
-	0x8b, 0x07, 0x0a, 0x03, 0x45, 0x4d, 0x41, 0x12, 0x3f, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x65, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x4d, 0x41, 0x2e, 0x49, 0x6e, 0x73, 0x52,
-	0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70,
-	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x4d, 0x41, 0x2e, 0x4f, 0x75,
-	0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x0a,
-	0x65, 0x6d, 0x61, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17,
-	0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x12, 0x04, 0x1a, 0x02, 0x35, 0x73, 0x52, 0x09, 0x65, 0x6d, 0x61, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x12, 0x5b, 0x0a, 0x0e, 0x77, 0x61, 0x72, 0x6d, 0x5f, 0x75, 0x70, 0x5f, 0x77, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78,
-	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x30,
-	0x73, 0x52, 0x0c, 0x77, 0x61, 0x72, 0x6d, 0x55, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
-	0x99, 0x01, 0x0a, 0x2b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x76,
-	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78,
-	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00,
-	0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x82, 0x03, 0x1a, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x09, 0x1a, 0x07, 0x67, 0x74, 0x65, 0x3d,
-	0x31, 0x2e, 0x30, 0x52, 0x26, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x4f, 0x6e, 0x4d, 0x69, 0x6e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
-	0x70, 0x65, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x9f, 0x01, 0x0a, 0x2b,
-	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
-	0x65, 0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x42, 0x92, 0x41, 0x3f, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-	0xf0, 0x3f, 0x82, 0x03, 0x20, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x1a, 0x0d, 0x67, 0x74, 0x65, 0x3d, 0x30, 0x2c, 0x6c, 0x74,
-	0x65, 0x3d, 0x31, 0x2e, 0x30, 0x52, 0x26, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4f, 0x6e, 0x4d, 0x61, 0x78, 0x45, 0x6e, 0x76, 0x65,
-	0x6c, 0x6f, 0x70, 0x65, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xca, 0x01,
-	0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x44,
-	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x76, 0x65,
-	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x76, 0x65,
-	0x6c, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0b, 0x6d,
-	0x69, 0x6e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75,
-	0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xcf, 0x03,
-	0x0a, 0x14, 0x41, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x62,
-	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x50, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x65, 0x74, 0x69,
-	0x63, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52,
-	0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x61, 0x70,
-	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x65, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f,
-	0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x5c, 0x0a,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x40, 0x92, 0x41, 0x3d, 0x82, 0x03, 0x3a, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29, 0x1a, 0x27, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d,
-	0x61, 0x64, 0x64, 0x20, 0x73, 0x75, 0x62, 0x20, 0x6d, 0x75, 0x6c, 0x20, 0x64, 0x69, 0x76, 0x20,
-	0x78, 0x6f, 0x72, 0x20, 0x6c, 0x73, 0x68, 0x69, 0x66, 0x74, 0x20, 0x72, 0x73, 0x68, 0x69, 0x66,
-	0x74, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0x6f, 0x0a, 0x03, 0x49,
-	0x6e, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x6c, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
-	0x72, 0x74, 0x52, 0x03, 0x6c, 0x68, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x72, 0x68, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x03, 0x72, 0x68, 0x73, 0x1a, 0x41, 0x0a, 0x04,
-	0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
-	0xc1, 0x04, 0x0a, 0x07, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x08, 0x69,
-	0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
-	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69,
-	0x64, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73,
-	0x12, 0x46, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08,
-	0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x82,
-	0x03, 0x2d, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x1c, 0x1a, 0x1a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x67, 0x74, 0x20, 0x6c, 0x74,
-	0x20, 0x67, 0x74, 0x65, 0x20, 0x6c, 0x74, 0x65, 0x20, 0x65, 0x71, 0x20, 0x6e, 0x65, 0x71, 0x52,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x50, 0x0a, 0x08, 0x74, 0x72, 0x75,
-	0x65, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c,
-	0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02,
-	0x30, 0x73, 0x52, 0x07, 0x74, 0x72, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x52, 0x0a, 0x09, 0x66,
-	0x61, 0x6c, 0x73, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03,
-	0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
-	0x04, 0x1a, 0x02, 0x30, 0x73, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x46, 0x6f, 0x72, 0x1a,
-	0x6f, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x6c, 0x68, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x03, 0x6c, 0x68, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x72,
-	0x68, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x03, 0x72, 0x68, 0x73,
-	0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x22, 0x99, 0x03, 0x0a, 0x08, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x72,
-	0x12, 0x44, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69,
-	0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x65, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x72,
-	0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a,
-	0xba, 0x01, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x6e, 0x5f, 0x74, 0x72,
-	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x6e, 0x54,
-	0x72, 0x75, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x6f, 0x6e, 0x46, 0x61, 0x6c, 0x73,
-	0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x1a, 0x41, 0x0a, 0x04,
-	0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
-	0xdc, 0x08, 0x0a, 0x0b, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12,
-	0x6a, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x42,
-	0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x64, 0x0a, 0x08, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d,
-	0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x12, 0x68, 0x0a, 0x14, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74,
-	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x82,
-	0x03, 0x15, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x12, 0x05, 0x1a, 0x03, 0x36, 0x30, 0x73, 0x52, 0x12, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3e, 0x0a, 0x09, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21,
-	0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x52, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x4e, 0x0a, 0x09, 0x6c,
-	0x61, 0x7a, 0x79, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
-	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x7a, 0x79, 0x53, 0x79, 0x6e,
-	0x63, 0x52, 0x08, 0x6c, 0x61, 0x7a, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x2c, 0x0a, 0x12, 0x64,
-	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x57, 0x0a, 0x0b, 0x69, 0x6e, 0x69,
-	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x1a, 0x94, 0x01, 0x0a, 0x08, 0x4c, 0x61, 0x7a, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x12,
-	0x32, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x18, 0x92, 0x41, 0x15, 0x82, 0x03, 0x12, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x02, 0x20, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x54, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x39, 0x92, 0x41, 0x36, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78,
-	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00,
-	0x00, 0x00, 0x00, 0x00, 0x14, 0x40, 0x82, 0x03, 0x17, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x06, 0x1a, 0x04, 0x67, 0x74, 0x3d, 0x30,
-	0x52, 0x07, 0x6e, 0x75, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x1a, 0x60, 0x0a, 0x0d, 0x44, 0x79, 0x6e,
-	0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4f, 0x0a, 0x09, 0x6f, 0x76,
-	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
-	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
-	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
-	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x9d, 0x01, 0x0a, 0x08,
-	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92,
-	0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
-	0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4d, 0x0a, 0x12,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x82, 0x03, 0x19,
-	0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09,
-	0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x10, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x53, 0x63, 0x61, 0x6c, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x61, 0x0a, 0x03, 0x49,
-	0x6e, 0x73, 0x12, 0x5a, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x6f, 0x72, 0x74, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d,
-	0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xf2,
-	0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x67, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x5d,
-	0x0a, 0x12, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x75,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65,
-	0x64, 0x41, 0x63, 0x74, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x6c, 0x6f, 0x61,
-	0x64, 0x53, 0x68, 0x65, 0x64, 0x41, 0x63, 0x74, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x14, 0x0a,
-	0x12, 0x61, 0x63, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x22, 0xbd, 0x09, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x12, 0x48, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74,
-	0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x64, 0x0a, 0x08, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d,
-	0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x12, 0x4d, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72,
-	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73,
-	0x12, 0x79, 0x0a, 0x1b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x52, 0x19, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
-	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x61,
-	0x75, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x1c, 0x92, 0x41, 0x19, 0x82, 0x03, 0x16, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x06, 0x1a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x0a,
-	0x61, 0x75, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x63, 0x0a, 0x0e, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x01, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f,
-	0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00,
-	0x00, 0xe0, 0x3f, 0x82, 0x03, 0x1a, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x09, 0x1a, 0x07, 0x67, 0x74, 0x65, 0x3d, 0x30, 0x2e, 0x30,
-	0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
-	0x59, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x1d, 0x92, 0x41, 0x1a, 0x82, 0x03, 0x17, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x07, 0x1a, 0x05, 0x30, 0x2e, 0x34, 0x35, 0x73, 0x52, 0x0a,
-	0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0xb4, 0x01, 0x0a, 0x12, 0x57,
-	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x42, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x26, 0x92, 0x41, 0x23, 0x82, 0x03, 0x20, 0x0a, 0x0d, 0x78, 0x2d, 0x67,
-	0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x1a, 0x0d, 0x67, 0x74,
-	0x65, 0x3d, 0x30, 0x2c, 0x6c, 0x74, 0x65, 0x3d, 0x32, 0x35, 0x35, 0x52, 0x08, 0x70, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78,
-	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00,
-	0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21,
-	0x0a, 0x0c, 0x66, 0x61, 0x69, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x4b, 0x65,
-	0x79, 0x1a, 0xca, 0x01, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x6a,
-	0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70,
-	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
-	0x52, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x1a, 0xb2,
-	0x01, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x13, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
-	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x54, 0x0a,
-	0x14, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
-	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x13,
-	0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x22, 0xb4, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65, 0x64,
-	0x41, 0x63, 0x74, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x70, 0x65,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65,
-	0x64, 0x41, 0x63, 0x74, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69,
-	0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x52, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x4b, 0x0a,
-	0x10, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0e, 0x6c, 0x6f, 0x61, 0x64,
-	0x53, 0x68, 0x65, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x9e, 0x02, 0x0a, 0x06, 0x50,
-	0x72, 0x6f, 0x6d, 0x51, 0x4c, 0x12, 0x45, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x51, 0x4c, 0x2e, 0x4f, 0x75,
-	0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
-	0x67, 0x0a, 0x13, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x82, 0x03, 0x15, 0x0a,
-	0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x05, 0x1a,
-	0x03, 0x31, 0x30, 0x73, 0x52, 0x12, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73,
-	0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x08,
-	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70,
-	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12,
-	0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
-	0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xc7, 0x02, 0x0a, 0x04, 0x53,
-	0x71, 0x72, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x72, 0x74, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e,
-	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x72, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x73,
-	0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x63,
-	0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x82, 0x03,
-	0x19, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
-	0x09, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c,
-	0x65, 0x1a, 0x3e, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x22, 0x9e, 0x03, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f,
-	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
-	0x4b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x75,
-	0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x74, 0x0a, 0x1a,
-	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1b, 0x92, 0x41, 0x18,
-	0x82, 0x03, 0x15, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x12, 0x05, 0x1a, 0x03, 0x31, 0x30, 0x73, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x1a, 0x3e, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x8f, 0x02, 0x0a, 0x03, 0x4d, 0x61, 0x78, 0x12, 0x3f, 0x0a,
-	0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
-	0x78, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x42,
-	0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x61, 0x78, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72,
-	0x74, 0x73, 0x1a, 0x40, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
-	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
-	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
-	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x8f, 0x02, 0x0a, 0x03, 0x4d, 0x69, 0x6e, 0x12,
-	0x3f, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73,
-	0x12, 0x42, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50,
-	0x6f, 0x72, 0x74, 0x73, 0x1a, 0x40, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
-	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39,
-	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72,
-	0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x32, 0x7e, 0x0a, 0x0d, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6d, 0x0a, 0x0b, 0x41, 0x6c,
-	0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x94, 0x02, 0x0a, 0x1f, 0x63, 0x6f,
-	0x6d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x55, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x75, 0x78, 0x6e, 0x69, 0x6e,
-	0x6a, 0x61, 0x2f, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x65,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x50, 0x4c, 0xaa, 0x02, 0x1b, 0x41, 0x70, 0x65, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1b, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x5c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x27, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x5c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x1e, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x3a, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x3a, 0x3a, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Scheduler_Outs) GetQueuedRequests() *Port {
+	if x != nil {
+		return x.QueuedRequests
+	}
+	return nil
 }
 
-var (
-	file_aperture_policy_language_v1_policy_proto_rawDescOnce sync.Once
-	file_aperture_policy_language_v1_policy_proto_rawDescData = file_aperture_policy_language_v1_policy_proto_rawDesc
-)
+func (x *Scheduler_Outs) GetWorkloadQueuedRequests() []*Port {
+	if x != nil {
+		return x.WorkloadQueuedRequests
+	}
+	return nil
+}
 
-func file_aperture_policy_language_v1_policy_proto_rawDescGZIP() []byte {
-	file_aperture_policy_language_v1_policy_proto_rawDescOnce.Do(func() {
-		file_aperture_policy_language_v1_policy_proto_rawDescData = protoimpl.X.CompressGZIP(file_aperture_policy_language_v1_policy_proto_rawDescData)
-	})
-	return file_aperture_policy_language_v1_policy_proto_rawDescData
+// Controlled delay (CoDel) parameters of the scheduler queueing discipline.
+type Scheduler_CoDel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Target queueing delay.
+	// The scheduler is considered overloaded when the minimum queueing delay
+	// stays above `target` for a whole `interval`. While overloaded, requests
+	// that waited more than twice the `target` are dropped early, instead of
+	// waiting for their timeout.
+	Target *durationpb.Duration `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty" default:"0.005s"` // @gotags: default:"0.005s"
+	// Interval over which the minimum queueing delay is tracked.
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty" default:"0.1s"` // @gotags: default:"0.1s"
+	// Serve the newest requests first within each flow while the scheduler is overloaded.
+	//
+	// Under sustained overload, the oldest requests are likely to be abandoned
+	// by their clients already. Ordering across flows is still decided by
+	// priority and fairness.
+	AdaptiveLifo bool `protobuf:"varint,3,opt,name=adaptive_lifo,json=adaptiveLifo,proto3" json:"adaptive_lifo,omitempty"`
 }
 
-var file_aperture_policy_language_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
-var file_aperture_policy_language_v1_policy_proto_goTypes = []interface{}{
-	(*AllPoliciesResponse)(nil),          // 0: aperture.policy.language.v1.AllPoliciesResponse
-	(*AllPolicies)(nil),                  // 1: aperture.policy.language.v1.AllPolicies
-	(*Policy)(nil),                       // 2: aperture.policy.language.v1.Policy
-	(*Circuit)(nil),                      // 3: aperture.policy.language.v1.Circuit
-	(*Resources)(nil),                    // 4: aperture.policy.language.v1.Resources
-	(*Component)(nil),                    // 5: aperture.policy.language.v1.Component
-	(*Port)(nil),                         // 6: aperture.policy.language.v1.Port
-	(*GradientController)(nil),           // 7: aperture.policy.language.v1.GradientController
-	(*EMA)(nil),                          // 8: aperture.policy.language.v1.EMA
-	(*ArithmeticCombinator)(nil),         // 9: aperture.policy.language.v1.ArithmeticCombinator
-	(*Decider)(nil),                      // 10: aperture.policy.language.v1.Decider
-	(*Switcher)(nil),                     // 11: aperture.policy.language.v1.Switcher
-	(*RateLimiter)(nil),                  // 12: aperture.policy.language.v1.RateLimiter
-	(*ConcurrencyLimiter)(nil),           // 13: aperture.policy.language.v1.ConcurrencyLimiter
-	(*Scheduler)(nil),                    // 14: aperture.policy.language.v1.Scheduler
-	(*LoadShedActuator)(nil),             // 15: aperture.policy.language.v1.LoadShedActuator
-	(*PromQL)(nil),                       // 16: aperture.policy.language.v1.PromQL
-	(*Constant)(nil),                     // 17: aperture.policy.language.v1.Constant
-	(*Sqrt)(nil),                         // 18: aperture.policy.language.v1.Sqrt
-	(*Extrapolator)(nil),                 // 19: aperture.policy.language.v1.Extrapolator
-	(*Max)(nil),                          // 20: aperture.policy.language.v1.Max
-	(*Min)(nil),                          // 21: aperture.policy.language.v1.Min
-	nil,                                  // 22: aperture.policy.language.v1.AllPolicies.AllPoliciesEntry
-	nil,                                  // 23: aperture.policy.language.v1.Resources.FluxMetersEntry
-	(*GradientController_Ins)(nil),       // 24: aperture.policy.language.v1.GradientController.Ins
-	(*GradientController_Outs)(nil),      // 25: aperture.policy.language.v1.GradientController.Outs
-	(*EMA_Ins)(nil),                      // 26: aperture.policy.language.v1.EMA.Ins
-	(*EMA_Outs)(nil),                     // 27: aperture.policy.language.v1.EMA.Outs
-	(*ArithmeticCombinator_Ins)(nil),     // 28: aperture.policy.language.v1.ArithmeticCombinator.Ins
-	(*ArithmeticCombinator_Outs)(nil),    // 29: aperture.policy.language.v1.ArithmeticCombinator.Outs
-	(*Decider_Ins)(nil),                  // 30: aperture.policy.language.v1.Decider.Ins
-	(*Decider_Outs)(nil),                 // 31: aperture.policy.language.v1.Decider.Outs
-	(*Switcher_Ins)(nil),                 // 32: aperture.policy.language.v1.Switcher.Ins
-	(*Switcher_Outs)(nil),                // 33: aperture.policy.language.v1.Switcher.Outs
-	(*RateLimiter_LazySync)(nil),         // 34: aperture.policy.language.v1.RateLimiter.LazySync
-	(*RateLimiter_DynamicConfig)(nil),    // 35: aperture.policy.language.v1.RateLimiter.DynamicConfig
-	(*RateLimiter_Override)(nil),         // 36: aperture.policy.language.v1.RateLimiter.Override
-	(*RateLimiter_Ins)(nil),              // 37: aperture.policy.language.v1.RateLimiter.Ins
-	(*Scheduler_WorkloadParameters)(nil), // 38: aperture.policy.language.v1.Scheduler.WorkloadParameters
-	(*Scheduler_Workload)(nil),           // 39: aperture.policy.language.v1.Scheduler.Workload
-	(*Scheduler_Outs)(nil),               // 40: aperture.policy.language.v1.Scheduler.Outs
-	(*LoadShedActuator_Ins)(nil),         // 41: aperture.policy.language.v1.LoadShedActuator.Ins
-	(*PromQL_Outs)(nil),                  // 42: aperture.policy.language.v1.PromQL.Outs
-	(*Constant_Outs)(nil),                // 43: aperture.policy.language.v1.Constant.Outs
-	(*Sqrt_Ins)(nil),                     // 44: aperture.policy.language.v1.Sqrt.Ins
-	(*Sqrt_Outs)(nil),                    // 45: aperture.policy.language.v1.Sqrt.Outs
-	(*Extrapolator_Ins)(nil),             // 46: aperture.policy.language.v1.Extrapolator.Ins
-	(*Extrapolator_Outs)(nil),            // 47: aperture.policy.language.v1.Extrapolator.Outs
-	(*Max_Ins)(nil),                      // 48: aperture.policy.language.v1.Max.Ins
-	(*Max_Outs)(nil),                     // 49: aperture.policy.language.v1.Max.Outs
-	(*Min_Ins)(nil),                      // 50: aperture.policy.language.v1.Min.Ins
-	(*Min_Outs)(nil),                     // 51: aperture.policy.language.v1.Min.Outs
-	(*durationpb.Duration)(nil),          // 52: google.protobuf.Duration
-	(*Classifier)(nil),                   // 53: aperture.policy.language.v1.Classifier
-	(*v1.Selector)(nil),                  // 54: aperture.common.selector.v1.Selector
-	(*FluxMeter)(nil),                    // 55: aperture.policy.language.v1.FluxMeter
-	(*v11.LabelMatcher)(nil),             // 56: aperture.common.labelmatcher.v1.LabelMatcher
-	(*emptypb.Empty)(nil),                // 57: google.protobuf.Empty
+func (x *Scheduler_CoDel) Reset() {
+	*x = Scheduler_CoDel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_aperture_policy_language_v1_policy_proto_depIdxs = []int32{
-	1,   // 0: aperture.policy.language.v1.AllPoliciesResponse.all_policies:type_name -> aperture.policy.language.v1.AllPolicies
-	22,  // 1: aperture.policy.language.v1.AllPolicies.all_policies:type_name -> aperture.policy.language.v1.AllPolicies.AllPoliciesEntry
-	3,   // 2: aperture.policy.language.v1.Policy.circuit:type_name -> aperture.policy.language.v1.Circuit
-	4,   // 3: aperture.policy.language.v1.Policy.resources:type_name -> aperture.policy.language.v1.Resources
-	52,  // 4: aperture.policy.language.v1.Circuit.evaluation_interval:type_name -> google.protobuf.Duration
-	5,   // 5: aperture.policy.language.v1.Circuit.components:type_name -> aperture.policy.language.v1.Component
-	23,  // 6: aperture.policy.language.v1.Resources.flux_meters:type_name -> aperture.policy.language.v1.Resources.FluxMetersEntry
-	53,  // 7: aperture.policy.language.v1.Resources.classifiers:type_name -> aperture.policy.language.v1.Classifier
-	7,   // 8: aperture.policy.language.v1.Component.gradient_controller:type_name -> aperture.policy.language.v1.GradientController
-	8,   // 9: aperture.policy.language.v1.Component.ema:type_name -> aperture.policy.language.v1.EMA
-	9,   // 10: aperture.policy.language.v1.Component.arithmetic_combinator:type_name -> aperture.policy.language.v1.ArithmeticCombinator
-	10,  // 11: aperture.policy.language.v1.Component.decider:type_name -> aperture.policy.language.v1.Decider
-	11,  // 12: aperture.policy.language.v1.Component.switcher:type_name -> aperture.policy.language.v1.Switcher
-	13,  // 13: aperture.policy.language.v1.Component.concurrency_limiter:type_name -> aperture.policy.language.v1.ConcurrencyLimiter
-	12,  // 14: aperture.policy.language.v1.Component.rate_limiter:type_name -> aperture.policy.language.v1.RateLimiter
-	16,  // 15: aperture.policy.language.v1.Component.promql:type_name -> aperture.policy.language.v1.PromQL
-	17,  // 16: aperture.policy.language.v1.Component.constant:type_name -> aperture.policy.language.v1.Constant
-	18,  // 17: aperture.policy.language.v1.Component.sqrt:type_name -> aperture.policy.language.v1.Sqrt
-	19,  // 18: aperture.policy.language.v1.Component.extrapolator:type_name -> aperture.policy.language.v1.Extrapolator
-	20,  // 19: aperture.policy.language.v1.Component.max:type_name -> aperture.policy.language.v1.Max
-	21,  // 20: aperture.policy.language.v1.Component.min:type_name -> aperture.policy.language.v1.Min
-	24,  // 21: aperture.policy.language.v1.GradientController.in_ports:type_name -> aperture.policy.language.v1.GradientController.Ins
-	25,  // 22: aperture.policy.language.v1.GradientController.out_ports:type_name -> aperture.policy.language.v1.GradientController.Outs
-	26,  // 23: aperture.policy.language.v1.EMA.in_ports:type_name -> aperture.policy.language.v1.EMA.Ins
-	27,  // 24: aperture.policy.language.v1.EMA.out_ports:type_name -> aperture.policy.language.v1.EMA.Outs
-	52,  // 25: aperture.policy.language.v1.EMA.ema_window:type_name -> google.protobuf.Duration
-	52,  // 26: aperture.policy.language.v1.EMA.warm_up_window:type_name -> google.protobuf.Duration
-	28,  // 27: aperture.policy.language.v1.ArithmeticCombinator.in_ports:type_name -> aperture.policy.language.v1.ArithmeticCombinator.Ins
-	29,  // 28: aperture.policy.language.v1.ArithmeticCombinator.out_ports:type_name -> aperture.policy.language.v1.ArithmeticCombinator.Outs
-	30,  // 29: aperture.policy.language.v1.Decider.in_ports:type_name -> aperture.policy.language.v1.Decider.Ins
-	31,  // 30: aperture.policy.language.v1.Decider.out_ports:type_name -> aperture.policy.language.v1.Decider.Outs
-	52,  // 31: aperture.policy.language.v1.Decider.true_for:type_name -> google.protobuf.Duration
-	52,  // 32: aperture.policy.language.v1.Decider.false_for:type_name -> google.protobuf.Duration
-	32,  // 33: aperture.policy.language.v1.Switcher.in_ports:type_name -> aperture.policy.language.v1.Switcher.Ins
-	33,  // 34: aperture.policy.language.v1.Switcher.out_ports:type_name -> aperture.policy.language.v1.Switcher.Outs
-	37,  // 35: aperture.policy.language.v1.RateLimiter.in_ports:type_name -> aperture.policy.language.v1.RateLimiter.Ins
-	54,  // 36: aperture.policy.language.v1.RateLimiter.selector:type_name -> aperture.common.selector.v1.Selector
-	52,  // 37: aperture.policy.language.v1.RateLimiter.limit_reset_interval:type_name -> google.protobuf.Duration
-	34,  // 38: aperture.policy.language.v1.RateLimiter.lazy_sync:type_name -> aperture.policy.language.v1.RateLimiter.LazySync
-	35,  // 39: aperture.policy.language.v1.RateLimiter.init_config:type_name -> aperture.policy.language.v1.RateLimiter.DynamicConfig
-	14,  // 40: aperture.policy.language.v1.ConcurrencyLimiter.scheduler:type_name -> aperture.policy.language.v1.Scheduler
-	15,  // 41: aperture.policy.language.v1.ConcurrencyLimiter.load_shed_actuator:type_name -> aperture.policy.language.v1.LoadShedActuator
-	40,  // 42: aperture.policy.language.v1.Scheduler.out_ports:type_name -> aperture.policy.language.v1.Scheduler.Outs
-	54,  // 43: aperture.policy.language.v1.Scheduler.selector:type_name -> aperture.common.selector.v1.Selector
-	39,  // 44: aperture.policy.language.v1.Scheduler.workloads:type_name -> aperture.policy.language.v1.Scheduler.Workload
-	38,  // 45: aperture.policy.language.v1.Scheduler.default_workload_parameters:type_name -> aperture.policy.language.v1.Scheduler.WorkloadParameters
-	52,  // 46: aperture.policy.language.v1.Scheduler.max_timeout:type_name -> google.protobuf.Duration
-	41,  // 47: aperture.policy.language.v1.LoadShedActuator.in_ports:type_name -> aperture.policy.language.v1.LoadShedActuator.Ins
-	42,  // 48: aperture.policy.language.v1.PromQL.out_ports:type_name -> aperture.policy.language.v1.PromQL.Outs
-	52,  // 49: aperture.policy.language.v1.PromQL.evaluation_interval:type_name -> google.protobuf.Duration
-	43,  // 50: aperture.policy.language.v1.Constant.out_ports:type_name -> aperture.policy.language.v1.Constant.Outs
-	44,  // 51: aperture.policy.language.v1.Sqrt.in_ports:type_name -> aperture.policy.language.v1.Sqrt.Ins
-	45,  // 52: aperture.policy.language.v1.Sqrt.out_ports:type_name -> aperture.policy.language.v1.Sqrt.Outs
-	46,  // 53: aperture.policy.language.v1.Extrapolator.in_ports:type_name -> aperture.policy.language.v1.Extrapolator.Ins
-	47,  // 54: aperture.policy.language.v1.Extrapolator.out_ports:type_name -> aperture.policy.language.v1.Extrapolator.Outs
-	52,  // 55: aperture.policy.language.v1.Extrapolator.max_extrapolation_interval:type_name -> google.protobuf.Duration
-	48,  // 56: aperture.policy.language.v1.Max.in_ports:type_name -> aperture.policy.language.v1.Max.Ins
-	49,  // 57: aperture.policy.language.v1.Max.out_ports:type_name -> aperture.policy.language.v1.Max.Outs
-	50,  // 58: aperture.policy.language.v1.Min.in_ports:type_name -> aperture.policy.language.v1.Min.Ins
-	51,  // 59: aperture.policy.language.v1.Min.out_ports:type_name -> aperture.policy.language.v1.Min.Outs
-	2,   // 60: aperture.policy.language.v1.AllPolicies.AllPoliciesEntry.value:type_name -> aperture.policy.language.v1.Policy
-	55,  // 61: aperture.policy.language.v1.Resources.FluxMetersEntry.value:type_name -> aperture.policy.language.v1.FluxMeter
-	6,   // 62: aperture.policy.language.v1.GradientController.Ins.signal:type_name -> aperture.policy.language.v1.Port
-	6,   // 63: aperture.policy.language.v1.GradientController.Ins.setpoint:type_name -> aperture.policy.language.v1.Port
-	6,   // 64: aperture.policy.language.v1.GradientController.Ins.optimize:type_name -> aperture.policy.language.v1.Port
-	6,   // 65: aperture.policy.language.v1.GradientController.Ins.max:type_name -> aperture.policy.language.v1.Port
-	6,   // 66: aperture.policy.language.v1.GradientController.Ins.min:type_name -> aperture.policy.language.v1.Port
-	6,   // 67: aperture.policy.language.v1.GradientController.Ins.control_variable:type_name -> aperture.policy.language.v1.Port
-	6,   // 68: aperture.policy.language.v1.GradientController.Outs.output:type_name -> aperture.policy.language.v1.Port
-	6,   // 69: aperture.policy.language.v1.EMA.Ins.input:type_name -> aperture.policy.language.v1.Port
-	6,   // 70: aperture.policy.language.v1.EMA.Ins.max_envelope:type_name -> aperture.policy.language.v1.Port
-	6,   // 71: aperture.policy.language.v1.EMA.Ins.min_envelope:type_name -> aperture.policy.language.v1.Port
-	6,   // 72: aperture.policy.language.v1.EMA.Outs.output:type_name -> aperture.policy.language.v1.Port
-	6,   // 73: aperture.policy.language.v1.ArithmeticCombinator.Ins.lhs:type_name -> aperture.policy.language.v1.Port
-	6,   // 74: aperture.policy.language.v1.ArithmeticCombinator.Ins.rhs:type_name -> aperture.policy.language.v1.Port
-	6,   // 75: aperture.policy.language.v1.ArithmeticCombinator.Outs.output:type_name -> aperture.policy.language.v1.Port
-	6,   // 76: aperture.policy.language.v1.Decider.Ins.lhs:type_name -> aperture.policy.language.v1.Port
-	6,   // 77: aperture.policy.language.v1.Decider.Ins.rhs:type_name -> aperture.policy.language.v1.Port
-	6,   // 78: aperture.policy.language.v1.Decider.Outs.output:type_name -> aperture.policy.language.v1.Port
-	6,   // 79: aperture.policy.language.v1.Switcher.Ins.on_true:type_name -> aperture.policy.language.v1.Port
-	6,   // 80: aperture.policy.language.v1.Switcher.Ins.on_false:type_name -> aperture.policy.language.v1.Port
-	6,   // 81: aperture.policy.language.v1.Switcher.Ins.switch:type_name -> aperture.policy.language.v1.Port
-	6,   // 82: aperture.policy.language.v1.Switcher.Outs.output:type_name -> aperture.policy.language.v1.Port
-	36,  // 83: aperture.policy.language.v1.RateLimiter.DynamicConfig.overrides:type_name -> aperture.policy.language.v1.RateLimiter.Override
-	6,   // 84: aperture.policy.language.v1.RateLimiter.Ins.limit:type_name -> aperture.policy.language.v1.Port
-	38,  // 85: aperture.policy.language.v1.Scheduler.Workload.workload_parameters:type_name -> aperture.policy.language.v1.Scheduler.WorkloadParameters
-	56,  // 86: aperture.policy.language.v1.Scheduler.Workload.label_matcher:type_name -> aperture.common.labelmatcher.v1.LabelMatcher
-	6,   // 87: aperture.policy.language.v1.Scheduler.Outs.accepted_concurrency:type_name -> aperture.policy.language.v1.Port
-	6,   // 88: aperture.policy.language.v1.Scheduler.Outs.incoming_concurrency:type_name -> aperture.policy.language.v1.Port
-	6,   // 89: aperture.policy.language.v1.LoadShedActuator.Ins.load_shed_factor:type_name -> aperture.policy.language.v1.Port
-	6,   // 90: aperture.policy.language.v1.PromQL.Outs.output:type_name -> aperture.policy.language.v1.Port
-	6,   // 91: aperture.policy.language.v1.Constant.Outs.output:type_name -> aperture.policy.language.v1.Port
-	6,   // 92: aperture.policy.language.v1.Sqrt.Ins.input:type_name -> aperture.policy.language.v1.Port
-	6,   // 93: aperture.policy.language.v1.Sqrt.Outs.output:type_name -> aperture.policy.language.v1.Port
-	6,   // 94: aperture.policy.language.v1.Extrapolator.Ins.input:type_name -> aperture.policy.language.v1.Port
-	6,   // 95: aperture.policy.language.v1.Extrapolator.Outs.output:type_name -> aperture.policy.language.v1.Port
-	6,   // 96: aperture.policy.language.v1.Max.Ins.inputs:type_name -> aperture.policy.language.v1.Port
-	6,   // 97: aperture.policy.language.v1.Max.Outs.output:type_name -> aperture.policy.language.v1.Port
-	6,   // 98: aperture.policy.language.v1.Min.Ins.inputs:type_name -> aperture.policy.language.v1.Port
-	6,   // 99: aperture.policy.language.v1.Min.Outs.output:type_name -> aperture.policy.language.v1.Port
-	57,  // 100: aperture.policy.language.v1.PolicyService.AllPolicies:input_type -> google.protobuf.Empty
-	0,   // 101: aperture.policy.language.v1.PolicyService.AllPolicies:output_type -> aperture.policy.language.v1.AllPoliciesResponse
-	101, // [101:102] is the sub-list for method output_type
-	100, // [100:101] is the sub-list for method input_type
-	100, // [100:100] is the sub-list for extension type_name
-	100, // [100:100] is the sub-list for extension extendee
-	0,   // [0:100] is the sub-list for field type_name
+
+func (x *Scheduler_CoDel) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_aperture_policy_language_v1_policy_proto_init() }
-func file_aperture_policy_language_v1_policy_proto_init() {
-	if File_aperture_policy_language_v1_policy_proto != nil {
-		return
+func (*Scheduler_CoDel) ProtoMessage() {}
+
+func (x *Scheduler_CoDel) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	file_aperture_policy_language_v1_classifier_proto_init()
-	file_aperture_policy_language_v1_fluxmeter_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_aperture_policy_language_v1_policy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllPoliciesResponse); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scheduler_CoDel.ProtoReflect.Descriptor instead.
+func (*Scheduler_CoDel) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{20, 3}
+}
+
+func (x *Scheduler_CoDel) GetTarget() *durationpb.Duration {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Scheduler_CoDel) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Scheduler_CoDel) GetAdaptiveLifo() bool {
+	if x != nil {
+		return x.AdaptiveLifo
+	}
+	return false
+}
+
+// Input for the Load Shed Actuator component.
+type LoadShedActuator_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Load shedding factor is a fraction of [incoming
+	// concurrency](#v1-scheduler-outs) that needs to be dropped.
+	LoadShedFactor *Port `protobuf:"bytes,1,opt,name=load_shed_factor,json=loadShedFactor,proto3" json:"load_shed_factor,omitempty"`
+}
+
+func (x *LoadShedActuator_Ins) Reset() {
+	*x = LoadShedActuator_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadShedActuator_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadShedActuator_Ins) ProtoMessage() {}
+
+func (x *LoadShedActuator_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadShedActuator_Ins.ProtoReflect.Descriptor instead.
+func (*LoadShedActuator_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *LoadShedActuator_Ins) GetLoadShedFactor() *Port {
+	if x != nil {
+		return x.LoadShedFactor
+	}
+	return nil
+}
+
+// Input for the Concurrency Budget Actuator component.
+type ConcurrencyBudgetActuator_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Concurrency budget is the number of tokens per second that can be
+	// accepted across all the agents of the group, in the same units as
+	// [accepted concurrency](#v1-scheduler-outs).
+	ConcurrencyBudget *Port `protobuf:"bytes,1,opt,name=concurrency_budget,json=concurrencyBudget,proto3" json:"concurrency_budget,omitempty"`
+}
+
+func (x *ConcurrencyBudgetActuator_Ins) Reset() {
+	*x = ConcurrencyBudgetActuator_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConcurrencyBudgetActuator_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyBudgetActuator_Ins) ProtoMessage() {}
+
+func (x *ConcurrencyBudgetActuator_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConcurrencyBudgetActuator_Ins.ProtoReflect.Descriptor instead.
+func (*ConcurrencyBudgetActuator_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *ConcurrencyBudgetActuator_Ins) GetConcurrencyBudget() *Port {
+	if x != nil {
+		return x.ConcurrencyBudget
+	}
+	return nil
+}
+
+// Output for the PromQL component.
+type PromQL_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The result of the Prometheus query as an output signal.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *PromQL_Outs) Reset() {
+	*x = PromQL_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromQL_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromQL_Outs) ProtoMessage() {}
+
+func (x *PromQL_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromQL_Outs.ProtoReflect.Descriptor instead.
+func (*PromQL_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *PromQL_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Outputs for the Constant component.
+type Constant_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The constant value is emitted to the output port.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Constant_Outs) Reset() {
+	*x = Constant_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Constant_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Constant_Outs) ProtoMessage() {}
+
+func (x *Constant_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Constant_Outs.ProtoReflect.Descriptor instead.
+func (*Constant_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *Constant_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Outputs for the Variable component.
+type Variable_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The value of the variable.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Variable_Outs) Reset() {
+	*x = Variable_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Variable_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variable_Outs) ProtoMessage() {}
+
+func (x *Variable_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variable_Outs.ProtoReflect.Descriptor instead.
+func (*Variable_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *Variable_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Dynamic Configuration for the Variable.
+type Variable_DynamicConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The value to be emitted.
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Variable_DynamicConfig) Reset() {
+	*x = Variable_DynamicConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Variable_DynamicConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variable_DynamicConfig) ProtoMessage() {}
+
+func (x *Variable_DynamicConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variable_DynamicConfig.ProtoReflect.Descriptor instead.
+func (*Variable_DynamicConfig) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{25, 1}
+}
+
+func (x *Variable_DynamicConfig) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Outputs for the Schedule component.
+type Schedule_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The value of the schedule entry in effect.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Schedule_Outs) Reset() {
+	*x = Schedule_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schedule_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule_Outs) ProtoMessage() {}
+
+func (x *Schedule_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule_Outs.ProtoReflect.Descriptor instead.
+func (*Schedule_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *Schedule_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Entry of the schedule.
+type Schedule_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cron expression of the times at which the entry takes effect, eg. `30 9 * * MON-FRI`.
+	//
+	// Uses the [standard cron syntax](https://en.wikipedia.org/wiki/Cron) with 5 fields (minute, hour, day of month, month, day of week).
+	// Descriptors such as `@daily` are supported as well.
+	Cron string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty" validate:"required"` // @gotags: validate:"required"
+	// The value to be emitted.
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Duration of the linear ramp from the previous value to the value of this entry.
+	Ramp *durationpb.Duration `protobuf:"bytes,3,opt,name=ramp,proto3" json:"ramp,omitempty" default:"0s"` // @gotags: default:"0s"
+}
+
+func (x *Schedule_Entry) Reset() {
+	*x = Schedule_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schedule_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule_Entry) ProtoMessage() {}
+
+func (x *Schedule_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule_Entry.ProtoReflect.Descriptor instead.
+func (*Schedule_Entry) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{26, 1}
+}
+
+func (x *Schedule_Entry) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Schedule_Entry) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Schedule_Entry) GetRamp() *durationpb.Duration {
+	if x != nil {
+		return x.Ramp
+	}
+	return nil
+}
+
+// Inputs for the Sqrt component.
+type Sqrt_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input signal.
+	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *Sqrt_Ins) Reset() {
+	*x = Sqrt_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sqrt_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sqrt_Ins) ProtoMessage() {}
+
+func (x *Sqrt_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sqrt_Ins.ProtoReflect.Descriptor instead.
+func (*Sqrt_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *Sqrt_Ins) GetInput() *Port {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Outputs for the Sqrt component.
+type Sqrt_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output signal.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Sqrt_Outs) Reset() {
+	*x = Sqrt_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sqrt_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sqrt_Outs) ProtoMessage() {}
+
+func (x *Sqrt_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sqrt_Outs.ProtoReflect.Descriptor instead.
+func (*Sqrt_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{27, 1}
+}
+
+func (x *Sqrt_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Inputs for the Extrapolator component.
+type Extrapolator_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input signal for the Extrapolator component.
+	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *Extrapolator_Ins) Reset() {
+	*x = Extrapolator_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extrapolator_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extrapolator_Ins) ProtoMessage() {}
+
+func (x *Extrapolator_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extrapolator_Ins.ProtoReflect.Descriptor instead.
+func (*Extrapolator_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *Extrapolator_Ins) GetInput() *Port {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Outputs for the Extrapolator component.
+type Extrapolator_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Extrapolated signal.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Extrapolator_Outs) Reset() {
+	*x = Extrapolator_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extrapolator_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extrapolator_Outs) ProtoMessage() {}
+
+func (x *Extrapolator_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extrapolator_Outs.ProtoReflect.Descriptor instead.
+func (*Extrapolator_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *Extrapolator_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Inputs for the Max component.
+type Max_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Array of input signals.
+	Inputs []*Port `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+}
+
+func (x *Max_Ins) Reset() {
+	*x = Max_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Max_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Max_Ins) ProtoMessage() {}
+
+func (x *Max_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Max_Ins.ProtoReflect.Descriptor instead.
+func (*Max_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *Max_Ins) GetInputs() []*Port {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+// Output for the Max component.
+type Max_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signal with maximum value as an output signal.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Max_Outs) Reset() {
+	*x = Max_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Max_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Max_Outs) ProtoMessage() {}
+
+func (x *Max_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Max_Outs.ProtoReflect.Descriptor instead.
+func (*Max_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *Max_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Inputs for the Min component.
+type Min_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Array of input signals.
+	Inputs []*Port `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+}
+
+func (x *Min_Ins) Reset() {
+	*x = Min_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Min_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Min_Ins) ProtoMessage() {}
+
+func (x *Min_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Min_Ins.ProtoReflect.Descriptor instead.
+func (*Min_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *Min_Ins) GetInputs() []*Port {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+// Output ports for the Min component.
+type Min_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signal with minimum value as an output signal.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Min_Outs) Reset() {
+	*x = Min_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Min_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Min_Outs) ProtoMessage() {}
+
+func (x *Min_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Min_Outs.ProtoReflect.Descriptor instead.
+func (*Min_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{30, 1}
+}
+
+func (x *Min_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Inputs for the Integrator component.
+type Integrator_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input signal, integrated per second.
+	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// Resets the output to zero when non-zero.
+	Reset_ *Port `protobuf:"bytes,2,opt,name=reset,proto3" json:"reset,omitempty"`
+	// Maximum value of the output, the accumulated value is clamped to it.
+	Max *Port `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	// Minimum value of the output, the accumulated value is clamped to it.
+	Min *Port `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`
+}
+
+func (x *Integrator_Ins) Reset() {
+	*x = Integrator_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Integrator_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Integrator_Ins) ProtoMessage() {}
+
+func (x *Integrator_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Integrator_Ins.ProtoReflect.Descriptor instead.
+func (*Integrator_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *Integrator_Ins) GetInput() *Port {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *Integrator_Ins) GetReset_() *Port {
+	if x != nil {
+		return x.Reset_
+	}
+	return nil
+}
+
+func (x *Integrator_Ins) GetMax() *Port {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *Integrator_Ins) GetMin() *Port {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+// Outputs for the Integrator component.
+type Integrator_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Accumulated value of the input signal.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Integrator_Outs) Reset() {
+	*x = Integrator_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Integrator_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Integrator_Outs) ProtoMessage() {}
+
+func (x *Integrator_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Integrator_Outs.ProtoReflect.Descriptor instead.
+func (*Integrator_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{31, 1}
+}
+
+func (x *Integrator_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Inputs for the Differentiator component.
+type Differentiator_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input signal.
+	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *Differentiator_Ins) Reset() {
+	*x = Differentiator_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Differentiator_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Differentiator_Ins) ProtoMessage() {}
+
+func (x *Differentiator_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Differentiator_Ins.ProtoReflect.Descriptor instead.
+func (*Differentiator_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *Differentiator_Ins) GetInput() *Port {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Outputs for the Differentiator component.
+type Differentiator_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rate of change of the input signal, per second.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Differentiator_Outs) Reset() {
+	*x = Differentiator_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Differentiator_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Differentiator_Outs) ProtoMessage() {}
+
+func (x *Differentiator_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Differentiator_Outs.ProtoReflect.Descriptor instead.
+func (*Differentiator_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{32, 1}
+}
+
+func (x *Differentiator_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Inputs for the Delta component.
+type Delta_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input signal.
+	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *Delta_Ins) Reset() {
+	*x = Delta_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Delta_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delta_Ins) ProtoMessage() {}
+
+func (x *Delta_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delta_Ins.ProtoReflect.Descriptor instead.
+func (*Delta_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *Delta_Ins) GetInput() *Port {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Outputs for the Delta component.
+type Delta_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Difference between the current and the previous reading.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Delta_Outs) Reset() {
+	*x = Delta_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Delta_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delta_Outs) ProtoMessage() {}
+
+func (x *Delta_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delta_Outs.ProtoReflect.Descriptor instead.
+func (*Delta_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{33, 1}
+}
+
+func (x *Delta_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Inputs for the Holder component.
+type Holder_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input signal.
+	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// Releases the held value when non-zero, the input signal is emitted as is while it's non-zero.
+	Reset_ *Port `protobuf:"bytes,2,opt,name=reset,proto3" json:"reset,omitempty"`
+}
+
+func (x *Holder_Ins) Reset() {
+	*x = Holder_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Holder_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holder_Ins) ProtoMessage() {}
+
+func (x *Holder_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holder_Ins.ProtoReflect.Descriptor instead.
+func (*Holder_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *Holder_Ins) GetInput() *Port {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *Holder_Ins) GetReset_() *Port {
+	if x != nil {
+		return x.Reset_
+	}
+	return nil
+}
+
+// Outputs for the Holder component.
+type Holder_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The held value, or the input signal if nothing is held.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Holder_Outs) Reset() {
+	*x = Holder_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Holder_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holder_Outs) ProtoMessage() {}
+
+func (x *Holder_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holder_Outs.ProtoReflect.Descriptor instead.
+func (*Holder_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{34, 1}
+}
+
+func (x *Holder_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Inputs for the FirstValid component.
+type FirstValid_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Array of input signals, in the order of preference.
+	Inputs []*Port `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+}
+
+func (x *FirstValid_Ins) Reset() {
+	*x = FirstValid_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirstValid_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirstValid_Ins) ProtoMessage() {}
+
+func (x *FirstValid_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirstValid_Ins.ProtoReflect.Descriptor instead.
+func (*FirstValid_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *FirstValid_Ins) GetInputs() []*Port {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+// Outputs for the FirstValid component.
+type FirstValid_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The first valid input signal.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *FirstValid_Outs) Reset() {
+	*x = FirstValid_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirstValid_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirstValid_Outs) ProtoMessage() {}
+
+func (x *FirstValid_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirstValid_Outs.ProtoReflect.Descriptor instead.
+func (*FirstValid_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{35, 1}
+}
+
+func (x *FirstValid_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// Inputs for the Inverter component.
+type Inverter_Ins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input signal.
+	Input *Port `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *Inverter_Ins) Reset() {
+	*x = Inverter_Ins{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Inverter_Ins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inverter_Ins) ProtoMessage() {}
+
+func (x *Inverter_Ins) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inverter_Ins.ProtoReflect.Descriptor instead.
+func (*Inverter_Ins) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *Inverter_Ins) GetInput() *Port {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Outputs for the Inverter component.
+type Inverter_Outs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Negated input signal.
+	Output *Port `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Inverter_Outs) Reset() {
+	*x = Inverter_Outs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Inverter_Outs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inverter_Outs) ProtoMessage() {}
+
+func (x *Inverter_Outs) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_language_v1_policy_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inverter_Outs.ProtoReflect.Descriptor instead.
+func (*Inverter_Outs) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_language_v1_policy_proto_rawDescGZIP(), []int{36, 1}
+}
+
+func (x *Inverter_Outs) GetOutput() *Port {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+var File_aperture_policy_language_v1_policy_proto protoreflect.FileDescriptor
+
+var file_aperture_policy_language_v1_policy_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x32, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2b, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x66, 0x6c, 0x75, 0x78, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x62, 0x0a,
+	0x13, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0b, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x5c, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x1a,
+	0x63, 0x0a, 0x10, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a, 0x13, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x36, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0xed, 0x01, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3e, 0x0a, 0x07,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x52, 0x07, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x09,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x11,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x98, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x68, 0x74, 0x74, 0x70, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x82, 0x03, 0x2c, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x1a, 0x19, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x67, 0x74, 0x65, 0x3d, 0x34, 0x30, 0x30, 0x2c, 0x6c, 0x74,
+	0x65, 0x3d, 0x35, 0x39, 0x39, 0x52, 0x0e, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x55, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbb, 0x01, 0x0a,
+	0x07, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x68, 0x0a, 0x13, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x1c, 0x92, 0x41, 0x19, 0x82, 0x03, 0x16, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x06, 0x1a, 0x04, 0x30, 0x2e, 0x35, 0x73, 0x52, 0x12,
+	0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x46, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x96, 0x02, 0x0a, 0x09, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0b, 0x66, 0x6c, 0x75, 0x78,
+	0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x66, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x49, 0x0a, 0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x65, 0x0a, 0x0f,
+	0x46, 0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6c, 0x75, 0x78, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xfe, 0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x12, 0x62, 0x0a, 0x13, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x12, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x03, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x4d, 0x41, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6d, 0x61, 0x12, 0x68, 0x0a, 0x15, 0x61,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x65,
+	0x74, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x14, 0x61, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x13,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
+	0x12, 0x4d, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12,
+	0x3d, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x71, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6d, 0x51, 0x4c, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x71, 0x6c, 0x12, 0x43,
+	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x73, 0x71, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x71, 0x72, 0x74, 0x48, 0x00, 0x52, 0x04, 0x73, 0x71, 0x72, 0x74, 0x12, 0x4f, 0x0a, 0x0c,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x78, 0x48, 0x00, 0x52, 0x03,
+	0x6d, 0x61, 0x78, 0x12, 0x34, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x53, 0x0a, 0x0e, 0x70, 0x69, 0x64,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x0d, 0x70, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x50,
+	0x0a, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
+	0x12, 0x4d, 0x0a, 0x0c, 0x68, 0x6f, 0x6c, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x74, 0x57, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x48, 0x00, 0x52, 0x0b, 0x68, 0x6f, 0x6c, 0x74, 0x57, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x49, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0a,
+	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x55, 0x0a, 0x0e, 0x64, 0x69,
+	0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x0e, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x3a, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x48, 0x00, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x43, 0x0a,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x08, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x12, 0x43, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x69,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb4, 0x06,
+	0x0a, 0x12, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f,
+	0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d,
+	0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x70, 0x65,
+	0x12, 0x42, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x82, 0x03, 0x19, 0x0a, 0x0c,
+	0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xef, 0xff, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x72, 0x61, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x82,
+	0x03, 0x19, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x09, 0x11, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xef, 0x7f, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x1a, 0xf6, 0x02, 0x0a, 0x03, 0x49, 0x6e, 0x73,
+	0x12, 0x39, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x08, 0x73,
+	0x65, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x08, 0x73, 0x65, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x12, 0x33, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x33,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x12, 0x4c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0xd8, 0x05, 0x0a, 0x0d, 0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x4c, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x6b, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6b, 0x70, 0x12,
+	0x0e, 0x0a, 0x02, 0x6b, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6b, 0x69, 0x12,
+	0x0e, 0x0a, 0x02, 0x6b, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6b, 0x64, 0x12,
+	0x7c, 0x0a, 0x1f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67,
+	0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x30, 0x73, 0x52,
+	0x1c, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x69, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x62, 0x69, 0x61,
+	0x73, 0x1a, 0xa8, 0x02, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x73, 0x65, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x7a, 0x65, 0x12, 0x33, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x33, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x1a, 0x41, 0x0a, 0x04,
+	0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
+	0x8b, 0x07, 0x0a, 0x03, 0x45, 0x4d, 0x41, 0x12, 0x3f, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x4d, 0x41, 0x2e, 0x49, 0x6e, 0x73, 0x52,
+	0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x4d, 0x41, 0x2e, 0x4f, 0x75,
+	0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x0a,
+	0x65, 0x6d, 0x61, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17,
+	0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x04, 0x1a, 0x02, 0x35, 0x73, 0x52, 0x09, 0x65, 0x6d, 0x61, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x5b, 0x0a, 0x0e, 0x77, 0x61, 0x72, 0x6d, 0x5f, 0x75, 0x70, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78,
+	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x30,
+	0x73, 0x52, 0x0c, 0x77, 0x61, 0x72, 0x6d, 0x55, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x99, 0x01, 0x0a, 0x2b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78,
+	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x82, 0x03, 0x1a, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x09, 0x1a, 0x07, 0x67, 0x74, 0x65, 0x3d,
+	0x31, 0x2e, 0x30, 0x52, 0x26, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x4f, 0x6e, 0x4d, 0x69, 0x6e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x9f, 0x01, 0x0a, 0x2b,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x42, 0x92, 0x41, 0x3f, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xf0, 0x3f, 0x82, 0x03, 0x20, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x1a, 0x0d, 0x67, 0x74, 0x65, 0x3d, 0x30, 0x2c, 0x6c, 0x74,
+	0x65, 0x3d, 0x31, 0x2e, 0x30, 0x52, 0x26, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4f, 0x6e, 0x4d, 0x61, 0x78, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xca, 0x01,
+	0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x44,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0b, 0x6d,
+	0x69, 0x6e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75,
+	0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xd5, 0x07,
+	0x0a, 0x0b, 0x48, 0x6f, 0x6c, 0x74, 0x57, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a,
+	0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
+	0x6c, 0x74, 0x57, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69,
+	0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x74, 0x57, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x59, 0x0a, 0x0c, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x82, 0x03, 0x15, 0x0a, 0x0c, 0x78, 0x2d, 0x67,
+	0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x05, 0x1a, 0x03, 0x36, 0x30, 0x73,
+	0x52, 0x0b, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5a, 0x0a,
+	0x0c, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1c,
+	0x92, 0x41, 0x19, 0x82, 0x03, 0x16, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x06, 0x1a, 0x04, 0x33, 0x30, 0x30, 0x73, 0x52, 0x0b, 0x74, 0x72,
+	0x65, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5a, 0x0a, 0x0d, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17,
+	0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x04, 0x1a, 0x02, 0x30, 0x73, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x63, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x82, 0x03,
+	0x19, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x09, 0x1a, 0x07, 0x36, 0x30, 0x34, 0x38, 0x30, 0x30, 0x73, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5b, 0x0a, 0x0e, 0x77, 0x61,
+	0x72, 0x6d, 0x5f, 0x75, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92,
+	0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x30, 0x73, 0x52, 0x0c, 0x77, 0x61, 0x72, 0x6d, 0x55,
+	0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x60, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41,
+	0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x30, 0x73, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x03, 0x49, 0x6e, 0x73,
+	0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0xb9, 0x01, 0x0a, 0x04, 0x4f, 0x75,
+	0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a,
+	0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
+	0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x3d, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x66, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x73, 0x74, 0x22, 0xcf, 0x03, 0x0a, 0x14, 0x41, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x65, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x50,
+	0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x35, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x53, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x62,
+	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x5c, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0x92, 0x41, 0x3d, 0x82, 0x03, 0x3a, 0x0a,
+	0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29,
+	0x1a, 0x27, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x61, 0x64, 0x64, 0x20, 0x73, 0x75, 0x62, 0x20,
+	0x6d, 0x75, 0x6c, 0x20, 0x64, 0x69, 0x76, 0x20, 0x78, 0x6f, 0x72, 0x20, 0x6c, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x20, 0x72, 0x73, 0x68, 0x69, 0x66, 0x74, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x1a, 0x6f, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x6c, 0x68,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x03, 0x6c, 0x68, 0x73, 0x12,
+	0x33, 0x0a, 0x03, 0x72, 0x68, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
+	0x03, 0x72, 0x68, 0x73, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xc1, 0x04, 0x0a, 0x07, 0x44, 0x65, 0x63, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52,
+	0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x4f, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x82, 0x03, 0x2d, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f,
+	0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x1a, 0x1a, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x3d, 0x67, 0x74, 0x20, 0x6c, 0x74, 0x20, 0x67, 0x74, 0x65, 0x20, 0x6c, 0x74, 0x65,
+	0x20, 0x65, 0x71, 0x20, 0x6e, 0x65, 0x71, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x50, 0x0a, 0x08, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a,
+	0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x30, 0x73, 0x52, 0x07, 0x74, 0x72, 0x75, 0x65,
+	0x46, 0x6f, 0x72, 0x12, 0x52, 0x0a, 0x09, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x5f, 0x66, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x30, 0x73, 0x52, 0x08, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x46, 0x6f, 0x72, 0x1a, 0x6f, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x33,
+	0x0a, 0x03, 0x6c, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x03,
+	0x6c, 0x68, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x72, 0x68, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x03, 0x72, 0x68, 0x73, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73,
+	0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x99, 0x03, 0x0a, 0x08,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x47,
+	0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f,
+	0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0xba, 0x01, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12,
+	0x3a, 0x0a, 0x07, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x6f,
+	0x6e, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x07, 0x6f, 0x6e, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xd0, 0x07, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03,
+	0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x07, 0x69, 0x6e,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x64, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x21,
+	0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x09, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21,
+	0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x52, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x82, 0x03, 0x15,
+	0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x05,
+	0x1a, 0x03, 0x55, 0x54, 0x43, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12,
+	0x5a, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x31, 0x73, 0x52, 0x0c, 0x73,
+	0x79, 0x6e, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x60, 0x0a, 0x18, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x92,
+	0x41, 0x24, 0x82, 0x03, 0x21, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x11, 0x1a, 0x0f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x15, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x46, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x65, 0x0a,
+	0x19, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x29, 0x92, 0x41, 0x26, 0x82, 0x03, 0x23, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x13, 0x1a, 0x11, 0x58, 0x2d, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x2d, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x17, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x18, 0x92, 0x41, 0x15, 0x82, 0x03,
+	0x12, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x02, 0x20, 0x00, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x5d, 0x0a, 0x12, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x11, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x3e,
+	0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2a,
+	0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x49, 0x4e, 0x44,
+	0x4f, 0x57, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x49, 0x4e, 0x44,
+	0x4f, 0x57, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x01, 0x22, 0x9a, 0x0d, 0x0a, 0x0b, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x6a, 0x0a, 0x08, 0x69, 0x6e,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82,
+	0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x07, 0x69,
+	0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x64, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42,
+	0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x68, 0x0a, 0x14,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x82, 0x03, 0x15, 0x0a, 0x0c, 0x78,
+	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x05, 0x1a, 0x03, 0x36,
+	0x30, 0x73, 0x52, 0x12, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x50, 0x0a, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x82, 0x03,
+	0x2d, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x1a, 0x1a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x6f, 0x75, 0x74, 0x3d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x08,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x4e, 0x0a, 0x09, 0x6c, 0x61, 0x7a, 0x79,
+	0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x7a, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x08,
+	0x6c, 0x61, 0x7a, 0x79, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x57, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x39, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x18, 0x92, 0x41, 0x15, 0x82, 0x03, 0x12, 0x0a, 0x0c, 0x78, 0x2d,
+	0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x02, 0x20, 0x00, 0x52, 0x0a,
+	0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x18, 0x92, 0x41, 0x15, 0x82, 0x03,
+	0x12, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x02, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x5d, 0x0a, 0x12, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x11, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x4b, 0x65, 0x79, 0x12, 0x5f, 0x0a, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x42, 0x40, 0x92, 0x41, 0x3d, 0x82, 0x03, 0x3a, 0x0a,
+	0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29,
+	0x1a, 0x27, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x3d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x2c, 0x64, 0x69, 0x76, 0x65,
+	0x2c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x4b, 0x65, 0x79, 0x73, 0x1a, 0x94, 0x01, 0x0a, 0x08, 0x4c, 0x61, 0x7a, 0x79, 0x53, 0x79, 0x6e,
+	0x63, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x18, 0x92, 0x41, 0x15, 0x82, 0x03, 0x12, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f,
+	0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x02, 0x20, 0x00, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x54, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x79, 0x6e,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x39, 0x92, 0x41, 0x36, 0x82, 0x03, 0x19, 0x0a,
+	0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40, 0x82, 0x03, 0x17, 0x0a, 0x0d, 0x78, 0x2d, 0x67,
+	0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x06, 0x1a, 0x04, 0x67, 0x74,
+	0x3d, 0x30, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x1a, 0x60, 0x0a, 0x0d, 0x44,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4f, 0x0a, 0x09,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x8a, 0x02,
+	0x0a, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x35, 0x92, 0x41, 0x32, 0x82, 0x03, 0x2f, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x1a, 0x1c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x3d, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1f,
+	0x92, 0x41, 0x1c, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52,
+	0x10, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x57, 0x0a, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x34, 0x92, 0x41, 0x31, 0x82, 0x03, 0x2e, 0x0a,
+	0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x1a, 0x1b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x3d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0xca, 0x01, 0x0a, 0x03, 0x49,
+	0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x66,
+	0x69, 0x6c, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x86, 0x04, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x67,
+	0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03,
+	0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x09, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x12, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65, 0x64, 0x41, 0x63, 0x74, 0x75, 0x61, 0x74,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65, 0x64, 0x41, 0x63,
+	0x74, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x78, 0x0a, 0x1b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x63, 0x74, 0x75, 0x61,
+	0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x19, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x63, 0x74, 0x75, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x39, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x18, 0x92, 0x41, 0x15, 0x82, 0x03, 0x12, 0x0a, 0x0c, 0x78,
+	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x02, 0x20, 0x00, 0x52,
+	0x0a, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x11, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x61, 0x63,
+	0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x22, 0xdd, 0x0d, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x48,
+	0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08,
+	0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x64, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x4d,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x79, 0x0a,
+	0x1b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x19, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1c, 0x92,
+	0x41, 0x19, 0x82, 0x03, 0x16, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x06, 0x1a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x0a, 0x61, 0x75, 0x74,
+	0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x63, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x3c, 0x92, 0x41, 0x39, 0x82, 0x03, 0x1a, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x09, 0x1a, 0x07, 0x67, 0x74, 0x65, 0x3d, 0x30, 0x2e,
+	0x30, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x3f, 0x52, 0x0d, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x59, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1d, 0x92, 0x41,
+	0x1a, 0x82, 0x03, 0x17, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x07, 0x1a, 0x05, 0x30, 0x2e, 0x34, 0x35, 0x73, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x44, 0x65, 0x6c, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x6c, 0x1a, 0x90, 0x02, 0x0a, 0x12,
+	0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x42, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x26, 0x92, 0x41, 0x23, 0x82, 0x03, 0x20, 0x0a, 0x0d, 0x78, 0x2d,
+	0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x1a, 0x0d, 0x67,
+	0x74, 0x65, 0x3d, 0x30, 0x2c, 0x6c, 0x74, 0x65, 0x3d, 0x32, 0x35, 0x35, 0x52, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x82, 0x03, 0x19, 0x0a, 0x0c,
+	0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x4b,
+	0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d,
+	0x61, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a, 0xca,
+	0x01, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x6a, 0x0a, 0x13, 0x77,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x0c, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x1a, 0xdb, 0x02, 0x0a, 0x04,
+	0x4f, 0x75, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x13, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x54, 0x0a, 0x14, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x13, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x4a, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x5b, 0x0a, 0x18,
+	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0xd4, 0x01, 0x0a, 0x05, 0x43, 0x6f,
+	0x44, 0x65, 0x6c, 0x12, 0x51, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1e,
+	0x92, 0x41, 0x1b, 0x82, 0x03, 0x18, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x08, 0x1a, 0x06, 0x30, 0x2e, 0x30, 0x30, 0x35, 0x73, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x53, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x82, 0x03, 0x16, 0x0a, 0x0c, 0x78, 0x2d, 0x67,
+	0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x06, 0x1a, 0x04, 0x30, 0x2e, 0x31,
+	0x73, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x69, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x66, 0x6f,
+	0x22, 0xb4, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65, 0x64, 0x41, 0x63, 0x74,
+	0x75, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65, 0x64, 0x41, 0x63,
+	0x74, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x1a, 0x52, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x10, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65,
+	0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xfd, 0x02, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x75, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x55, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x63, 0x74, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x5c, 0x0a, 0x0e,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x31, 0x73, 0x52, 0x0d, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x52, 0x0a, 0x09, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14,
+	0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04,
+	0x1a, 0x02, 0x35, 0x73, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x74, 0x6c, 0x1a, 0x57,
+	0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x06, 0x50, 0x72, 0x6f, 0x6d,
+	0x51, 0x4c, 0x12, 0x45, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x51, 0x4c, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52,
+	0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x67, 0x0a, 0x13,
+	0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x82, 0x03, 0x15, 0x0a, 0x0c, 0x78, 0x2d,
+	0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x05, 0x1a, 0x03, 0x31, 0x30,
+	0x73, 0x52, 0x12, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x2e,
+	0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xea, 0x02, 0x0a, 0x08, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x4f,
+	0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x4f, 0x0a,
+	0x12, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82, 0x03,
+	0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x10, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x5a,
+	0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x44, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75,
+	0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x25, 0x0a,
+	0x0d, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xfd, 0x03, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x47, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x73,
+	0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92,
+	0x41, 0x18, 0x82, 0x03, 0x15, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x05, 0x1a, 0x03, 0x55, 0x54, 0x43, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x64, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x82, 0x03, 0x17, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f,
+	0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x06, 0x1a, 0x04, 0x64, 0x69, 0x76,
+	0x65, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a,
+	0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x1a, 0x9f, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x04,
+	0x63, 0x72, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x82,
+	0x03, 0x1b, 0x0a, 0x0d, 0x78, 0x2d, 0x67, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x0a, 0x1a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x63,
+	0x72, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x49, 0x0a, 0x04, 0x72, 0x61, 0x6d,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78, 0x2d, 0x67, 0x6f,
+	0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x30, 0x73, 0x52, 0x04,
+	0x72, 0x61, 0x6d, 0x70, 0x22, 0xc7, 0x02, 0x0a, 0x04, 0x53, 0x71, 0x72, 0x74, 0x12, 0x40, 0x0a,
+	0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71,
+	0x72, 0x74, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x43, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x71, 0x72, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x82, 0x03, 0x19, 0x0a, 0x0c, 0x78, 0x2d, 0x67,
+	0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x09, 0x11, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0xf0, 0x3f, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x1a, 0x3e, 0x0a, 0x03, 0x49,
+	0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x41, 0x0a, 0x04, 0x4f,
+	0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x9e,
+	0x03, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x48, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x73,
+	0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x6f, 0x75, 0x74,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x70, 0x6f, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x74, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x82, 0x03, 0x15, 0x0a, 0x0c, 0x78,
+	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x05, 0x1a, 0x03, 0x31,
+	0x30, 0x73, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0x3e, 0x0a, 0x03,
+	0x49, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x41, 0x0a, 0x04,
+	0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
+	0x8f, 0x02, 0x0a, 0x03, 0x4d, 0x61, 0x78, 0x12, 0x3f, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x78, 0x2e, 0x49, 0x6e, 0x73, 0x52,
+	0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x78, 0x2e, 0x4f, 0x75,
+	0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x40, 0x0a, 0x03,
+	0x49, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x1a, 0x41,
+	0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x8f, 0x02, 0x0a, 0x03, 0x4d, 0x69, 0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x69, 0x6e, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x2e, 0x49, 0x6e,
+	0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x6f, 0x75,
+	0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x2e,
+	0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x40,
+	0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0xc6, 0x03, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x46, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e,
+	0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x09, 0x6f, 0x75,
+	0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0xe1, 0x01, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x37, 0x0a,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12,
+	0x33, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
+	0x03, 0x6d, 0x61, 0x78, 0x12, 0x33, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74,
+	0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xfd, 0x02, 0x0a,
+	0x0e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x4a, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49,
+	0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x09, 0x6f,
+	0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73,
+	0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78,
+	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x35,
+	0x73, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x1a, 0x3e, 0x0a, 0x03, 0x49, 0x6e, 0x73,
+	0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74,
+	0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x93, 0x02, 0x0a,
+	0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x73,
+	0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x6f, 0x75, 0x74,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a,
+	0x3e, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x22, 0xa1, 0x03, 0x0a, 0x06, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a,
+	0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x45, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08,
+	0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x66, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x82, 0x03, 0x14, 0x0a, 0x0c, 0x78,
+	0x2d, 0x67, 0x6f, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x04, 0x1a, 0x02, 0x35,
+	0x73, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x46, 0x6f, 0x72, 0x1a, 0x77, 0x0a, 0x03, 0x49, 0x6e,
+	0x73, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xa4, 0x02, 0x0a, 0x0a, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x46, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x49, 0x0a,
+	0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x72, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52, 0x08,
+	0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x40, 0x0a, 0x03, 0x49, 0x6e, 0x73, 0x12,
+	0x39, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75,
+	0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x9c, 0x02,
+	0x0a, 0x08, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x08, 0x69, 0x6e,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x47, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x73, 0x52,
+	0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x3e, 0x0a, 0x03, 0x49, 0x6e, 0x73,
+	0x12, 0x37, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x41, 0x0a, 0x04, 0x4f, 0x75, 0x74,
+	0x73, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x32, 0x83, 0x03, 0x0a,
+	0x0d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6d,
+	0x0a, 0x0b, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12,
+	0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x84, 0x01,
+	0x0a, 0x0c, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30,
+	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
+	0x1a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x7b,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x7c, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x42, 0x94, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x55, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x66, 0x6c, 0x75, 0x78, 0x6e, 0x69, 0x6e, 0x6a, 0x61, 0x2f, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2f, 0x76,
+	0x31, 0x3b, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41,
+	0x50, 0x4c, 0xaa, 0x02, 0x1b, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x1b, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x5c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x27, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x41, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x3a, 0x3a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x3a, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_aperture_policy_language_v1_policy_proto_rawDescOnce sync.Once
+	file_aperture_policy_language_v1_policy_proto_rawDescData = file_aperture_policy_language_v1_policy_proto_rawDesc
+)
+
+func file_aperture_policy_language_v1_policy_proto_rawDescGZIP() []byte {
+	file_aperture_policy_language_v1_policy_proto_rawDescOnce.Do(func() {
+		file_aperture_policy_language_v1_policy_proto_rawDescData = protoimpl.X.CompressGZIP(file_aperture_policy_language_v1_policy_proto_rawDescData)
+	})
+	return file_aperture_policy_language_v1_policy_proto_rawDescData
+}
+
+var file_aperture_policy_language_v1_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_aperture_policy_language_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
+var file_aperture_policy_language_v1_policy_proto_goTypes = []interface{}{
+	(QuotaLimiter_Window)(0),              // 0: aperture.policy.language.v1.QuotaLimiter.Window
+	(*AllPoliciesResponse)(nil),           // 1: aperture.policy.language.v1.AllPoliciesResponse
+	(*AllPolicies)(nil),                   // 2: aperture.policy.language.v1.AllPolicies
+	(*UpsertPolicyRequest)(nil),           // 3: aperture.policy.language.v1.UpsertPolicyRequest
+	(*DeletePolicyRequest)(nil),           // 4: aperture.policy.language.v1.DeletePolicyRequest
+	(*Policy)(nil),                        // 5: aperture.policy.language.v1.Policy
+	(*RejectionResponse)(nil),             // 6: aperture.policy.language.v1.RejectionResponse
+	(*Circuit)(nil),                       // 7: aperture.policy.language.v1.Circuit
+	(*Resources)(nil),                     // 8: aperture.policy.language.v1.Resources
+	(*Component)(nil),                     // 9: aperture.policy.language.v1.Component
+	(*Port)(nil),                          // 10: aperture.policy.language.v1.Port
+	(*GradientController)(nil),            // 11: aperture.policy.language.v1.GradientController
+	(*PIDController)(nil),                 // 12: aperture.policy.language.v1.PIDController
+	(*EMA)(nil),                           // 13: aperture.policy.language.v1.EMA
+	(*HoltWinters)(nil),                   // 14: aperture.policy.language.v1.HoltWinters
+	(*ArithmeticCombinator)(nil),          // 15: aperture.policy.language.v1.ArithmeticCombinator
+	(*Decider)(nil),                       // 16: aperture.policy.language.v1.Decider
+	(*Switcher)(nil),                      // 17: aperture.policy.language.v1.Switcher
+	(*QuotaLimiter)(nil),                  // 18: aperture.policy.language.v1.QuotaLimiter
+	(*RateLimiter)(nil),                   // 19: aperture.policy.language.v1.RateLimiter
+	(*ConcurrencyLimiter)(nil),            // 20: aperture.policy.language.v1.ConcurrencyLimiter
+	(*Scheduler)(nil),                     // 21: aperture.policy.language.v1.Scheduler
+	(*LoadShedActuator)(nil),              // 22: aperture.policy.language.v1.LoadShedActuator
+	(*ConcurrencyBudgetActuator)(nil),     // 23: aperture.policy.language.v1.ConcurrencyBudgetActuator
+	(*PromQL)(nil),                        // 24: aperture.policy.language.v1.PromQL
+	(*Constant)(nil),                      // 25: aperture.policy.language.v1.Constant
+	(*Variable)(nil),                      // 26: aperture.policy.language.v1.Variable
+	(*Schedule)(nil),                      // 27: aperture.policy.language.v1.Schedule
+	(*Sqrt)(nil),                          // 28: aperture.policy.language.v1.Sqrt
+	(*Extrapolator)(nil),                  // 29: aperture.policy.language.v1.Extrapolator
+	(*Max)(nil),                           // 30: aperture.policy.language.v1.Max
+	(*Min)(nil),                           // 31: aperture.policy.language.v1.Min
+	(*Integrator)(nil),                    // 32: aperture.policy.language.v1.Integrator
+	(*Differentiator)(nil),                // 33: aperture.policy.language.v1.Differentiator
+	(*Delta)(nil),                         // 34: aperture.policy.language.v1.Delta
+	(*Holder)(nil),                        // 35: aperture.policy.language.v1.Holder
+	(*FirstValid)(nil),                    // 36: aperture.policy.language.v1.FirstValid
+	(*Inverter)(nil),                      // 37: aperture.policy.language.v1.Inverter
+	nil,                                   // 38: aperture.policy.language.v1.AllPolicies.AllPoliciesEntry
+	nil,                                   // 39: aperture.policy.language.v1.RejectionResponse.HeadersEntry
+	nil,                                   // 40: aperture.policy.language.v1.Resources.FluxMetersEntry
+	(*GradientController_Ins)(nil),        // 41: aperture.policy.language.v1.GradientController.Ins
+	(*GradientController_Outs)(nil),       // 42: aperture.policy.language.v1.GradientController.Outs
+	(*PIDController_Ins)(nil),             // 43: aperture.policy.language.v1.PIDController.Ins
+	(*PIDController_Outs)(nil),            // 44: aperture.policy.language.v1.PIDController.Outs
+	(*EMA_Ins)(nil),                       // 45: aperture.policy.language.v1.EMA.Ins
+	(*EMA_Outs)(nil),                      // 46: aperture.policy.language.v1.EMA.Outs
+	(*HoltWinters_Ins)(nil),               // 47: aperture.policy.language.v1.HoltWinters.Ins
+	(*HoltWinters_Outs)(nil),              // 48: aperture.policy.language.v1.HoltWinters.Outs
+	(*ArithmeticCombinator_Ins)(nil),      // 49: aperture.policy.language.v1.ArithmeticCombinator.Ins
+	(*ArithmeticCombinator_Outs)(nil),     // 50: aperture.policy.language.v1.ArithmeticCombinator.Outs
+	(*Decider_Ins)(nil),                   // 51: aperture.policy.language.v1.Decider.Ins
+	(*Decider_Outs)(nil),                  // 52: aperture.policy.language.v1.Decider.Outs
+	(*Switcher_Ins)(nil),                  // 53: aperture.policy.language.v1.Switcher.Ins
+	(*Switcher_Outs)(nil),                 // 54: aperture.policy.language.v1.Switcher.Outs
+	(*QuotaLimiter_Ins)(nil),              // 55: aperture.policy.language.v1.QuotaLimiter.Ins
+	(*RateLimiter_LazySync)(nil),          // 56: aperture.policy.language.v1.RateLimiter.LazySync
+	(*RateLimiter_DynamicConfig)(nil),     // 57: aperture.policy.language.v1.RateLimiter.DynamicConfig
+	(*RateLimiter_Override)(nil),          // 58: aperture.policy.language.v1.RateLimiter.Override
+	(*RateLimiter_Ins)(nil),               // 59: aperture.policy.language.v1.RateLimiter.Ins
+	(*Scheduler_WorkloadParameters)(nil),  // 60: aperture.policy.language.v1.Scheduler.WorkloadParameters
+	(*Scheduler_Workload)(nil),            // 61: aperture.policy.language.v1.Scheduler.Workload
+	(*Scheduler_Outs)(nil),                // 62: aperture.policy.language.v1.Scheduler.Outs
+	(*Scheduler_CoDel)(nil),               // 63: aperture.policy.language.v1.Scheduler.CoDel
+	(*LoadShedActuator_Ins)(nil),          // 64: aperture.policy.language.v1.LoadShedActuator.Ins
+	(*ConcurrencyBudgetActuator_Ins)(nil), // 65: aperture.policy.language.v1.ConcurrencyBudgetActuator.Ins
+	(*PromQL_Outs)(nil),                   // 66: aperture.policy.language.v1.PromQL.Outs
+	(*Constant_Outs)(nil),                 // 67: aperture.policy.language.v1.Constant.Outs
+	(*Variable_Outs)(nil),                 // 68: aperture.policy.language.v1.Variable.Outs
+	(*Variable_DynamicConfig)(nil),        // 69: aperture.policy.language.v1.Variable.DynamicConfig
+	(*Schedule_Outs)(nil),                 // 70: aperture.policy.language.v1.Schedule.Outs
+	(*Schedule_Entry)(nil),                // 71: aperture.policy.language.v1.Schedule.Entry
+	(*Sqrt_Ins)(nil),                      // 72: aperture.policy.language.v1.Sqrt.Ins
+	(*Sqrt_Outs)(nil),                     // 73: aperture.policy.language.v1.Sqrt.Outs
+	(*Extrapolator_Ins)(nil),              // 74: aperture.policy.language.v1.Extrapolator.Ins
+	(*Extrapolator_Outs)(nil),             // 75: aperture.policy.language.v1.Extrapolator.Outs
+	(*Max_Ins)(nil),                       // 76: aperture.policy.language.v1.Max.Ins
+	(*Max_Outs)(nil),                      // 77: aperture.policy.language.v1.Max.Outs
+	(*Min_Ins)(nil),                       // 78: aperture.policy.language.v1.Min.Ins
+	(*Min_Outs)(nil),                      // 79: aperture.policy.language.v1.Min.Outs
+	(*Integrator_Ins)(nil),                // 80: aperture.policy.language.v1.Integrator.Ins
+	(*Integrator_Outs)(nil),               // 81: aperture.policy.language.v1.Integrator.Outs
+	(*Differentiator_Ins)(nil),            // 82: aperture.policy.language.v1.Differentiator.Ins
+	(*Differentiator_Outs)(nil),           // 83: aperture.policy.language.v1.Differentiator.Outs
+	(*Delta_Ins)(nil),                     // 84: aperture.policy.language.v1.Delta.Ins
+	(*Delta_Outs)(nil),                    // 85: aperture.policy.language.v1.Delta.Outs
+	(*Holder_Ins)(nil),                    // 86: aperture.policy.language.v1.Holder.Ins
+	(*Holder_Outs)(nil),                   // 87: aperture.policy.language.v1.Holder.Outs
+	(*FirstValid_Ins)(nil),                // 88: aperture.policy.language.v1.FirstValid.Ins
+	(*FirstValid_Outs)(nil),               // 89: aperture.policy.language.v1.FirstValid.Outs
+	(*Inverter_Ins)(nil),                  // 90: aperture.policy.language.v1.Inverter.Ins
+	(*Inverter_Outs)(nil),                 // 91: aperture.policy.language.v1.Inverter.Outs
+	(*durationpb.Duration)(nil),           // 92: google.protobuf.Duration
+	(*Classifier)(nil),                    // 93: aperture.policy.language.v1.Classifier
+	(*v1.Selector)(nil),                   // 94: aperture.common.selector.v1.Selector
+	(*FluxMeter)(nil),                     // 95: aperture.policy.language.v1.FluxMeter
+	(*v11.LabelMatcher)(nil),              // 96: aperture.common.labelmatcher.v1.LabelMatcher
+	(*emptypb.Empty)(nil),                 // 97: google.protobuf.Empty
+}
+var file_aperture_policy_language_v1_policy_proto_depIdxs = []int32{
+	2,   // 0: aperture.policy.language.v1.AllPoliciesResponse.all_policies:type_name -> aperture.policy.language.v1.AllPolicies
+	38,  // 1: aperture.policy.language.v1.AllPolicies.all_policies:type_name -> aperture.policy.language.v1.AllPolicies.AllPoliciesEntry
+	5,   // 2: aperture.policy.language.v1.UpsertPolicyRequest.policy:type_name -> aperture.policy.language.v1.Policy
+	7,   // 3: aperture.policy.language.v1.Policy.circuit:type_name -> aperture.policy.language.v1.Circuit
+	8,   // 4: aperture.policy.language.v1.Policy.resources:type_name -> aperture.policy.language.v1.Resources
+	6,   // 5: aperture.policy.language.v1.Policy.rejection_response:type_name -> aperture.policy.language.v1.RejectionResponse
+	39,  // 6: aperture.policy.language.v1.RejectionResponse.headers:type_name -> aperture.policy.language.v1.RejectionResponse.HeadersEntry
+	92,  // 7: aperture.policy.language.v1.Circuit.evaluation_interval:type_name -> google.protobuf.Duration
+	9,   // 8: aperture.policy.language.v1.Circuit.components:type_name -> aperture.policy.language.v1.Component
+	40,  // 9: aperture.policy.language.v1.Resources.flux_meters:type_name -> aperture.policy.language.v1.Resources.FluxMetersEntry
+	93,  // 10: aperture.policy.language.v1.Resources.classifiers:type_name -> aperture.policy.language.v1.Classifier
+	11,  // 11: aperture.policy.language.v1.Component.gradient_controller:type_name -> aperture.policy.language.v1.GradientController
+	13,  // 12: aperture.policy.language.v1.Component.ema:type_name -> aperture.policy.language.v1.EMA
+	15,  // 13: aperture.policy.language.v1.Component.arithmetic_combinator:type_name -> aperture.policy.language.v1.ArithmeticCombinator
+	16,  // 14: aperture.policy.language.v1.Component.decider:type_name -> aperture.policy.language.v1.Decider
+	17,  // 15: aperture.policy.language.v1.Component.switcher:type_name -> aperture.policy.language.v1.Switcher
+	20,  // 16: aperture.policy.language.v1.Component.concurrency_limiter:type_name -> aperture.policy.language.v1.ConcurrencyLimiter
+	19,  // 17: aperture.policy.language.v1.Component.rate_limiter:type_name -> aperture.policy.language.v1.RateLimiter
+	24,  // 18: aperture.policy.language.v1.Component.promql:type_name -> aperture.policy.language.v1.PromQL
+	25,  // 19: aperture.policy.language.v1.Component.constant:type_name -> aperture.policy.language.v1.Constant
+	28,  // 20: aperture.policy.language.v1.Component.sqrt:type_name -> aperture.policy.language.v1.Sqrt
+	29,  // 21: aperture.policy.language.v1.Component.extrapolator:type_name -> aperture.policy.language.v1.Extrapolator
+	30,  // 22: aperture.policy.language.v1.Component.max:type_name -> aperture.policy.language.v1.Max
+	31,  // 23: aperture.policy.language.v1.Component.min:type_name -> aperture.policy.language.v1.Min
+	12,  // 24: aperture.policy.language.v1.Component.pid_controller:type_name -> aperture.policy.language.v1.PIDController
+	18,  // 25: aperture.policy.language.v1.Component.quota_limiter:type_name -> aperture.policy.language.v1.QuotaLimiter
+	14,  // 26: aperture.policy.language.v1.Component.holt_winters:type_name -> aperture.policy.language.v1.HoltWinters
+	32,  // 27: aperture.policy.language.v1.Component.integrator:type_name -> aperture.policy.language.v1.Integrator
+	33,  // 28: aperture.policy.language.v1.Component.differentiator:type_name -> aperture.policy.language.v1.Differentiator
+	34,  // 29: aperture.policy.language.v1.Component.delta:type_name -> aperture.policy.language.v1.Delta
+	27,  // 30: aperture.policy.language.v1.Component.schedule:type_name -> aperture.policy.language.v1.Schedule
+	26,  // 31: aperture.policy.language.v1.Component.variable:type_name -> aperture.policy.language.v1.Variable
+	35,  // 32: aperture.policy.language.v1.Component.holder:type_name -> aperture.policy.language.v1.Holder
+	36,  // 33: aperture.policy.language.v1.Component.first_valid:type_name -> aperture.policy.language.v1.FirstValid
+	37,  // 34: aperture.policy.language.v1.Component.inverter:type_name -> aperture.policy.language.v1.Inverter
+	41,  // 35: aperture.policy.language.v1.GradientController.in_ports:type_name -> aperture.policy.language.v1.GradientController.Ins
+	42,  // 36: aperture.policy.language.v1.GradientController.out_ports:type_name -> aperture.policy.language.v1.GradientController.Outs
+	43,  // 37: aperture.policy.language.v1.PIDController.in_ports:type_name -> aperture.policy.language.v1.PIDController.Ins
+	44,  // 38: aperture.policy.language.v1.PIDController.out_ports:type_name -> aperture.policy.language.v1.PIDController.Outs
+	92,  // 39: aperture.policy.language.v1.PIDController.derivative_filter_time_constant:type_name -> google.protobuf.Duration
+	45,  // 40: aperture.policy.language.v1.EMA.in_ports:type_name -> aperture.policy.language.v1.EMA.Ins
+	46,  // 41: aperture.policy.language.v1.EMA.out_ports:type_name -> aperture.policy.language.v1.EMA.Outs
+	92,  // 42: aperture.policy.language.v1.EMA.ema_window:type_name -> google.protobuf.Duration
+	92,  // 43: aperture.policy.language.v1.EMA.warm_up_window:type_name -> google.protobuf.Duration
+	47,  // 44: aperture.policy.language.v1.HoltWinters.in_ports:type_name -> aperture.policy.language.v1.HoltWinters.Ins
+	48,  // 45: aperture.policy.language.v1.HoltWinters.out_ports:type_name -> aperture.policy.language.v1.HoltWinters.Outs
+	92,  // 46: aperture.policy.language.v1.HoltWinters.level_window:type_name -> google.protobuf.Duration
+	92,  // 47: aperture.policy.language.v1.HoltWinters.trend_window:type_name -> google.protobuf.Duration
+	92,  // 48: aperture.policy.language.v1.HoltWinters.season_length:type_name -> google.protobuf.Duration
+	92,  // 49: aperture.policy.language.v1.HoltWinters.seasonal_window:type_name -> google.protobuf.Duration
+	92,  // 50: aperture.policy.language.v1.HoltWinters.warm_up_window:type_name -> google.protobuf.Duration
+	92,  // 51: aperture.policy.language.v1.HoltWinters.forecast_horizon:type_name -> google.protobuf.Duration
+	49,  // 52: aperture.policy.language.v1.ArithmeticCombinator.in_ports:type_name -> aperture.policy.language.v1.ArithmeticCombinator.Ins
+	50,  // 53: aperture.policy.language.v1.ArithmeticCombinator.out_ports:type_name -> aperture.policy.language.v1.ArithmeticCombinator.Outs
+	51,  // 54: aperture.policy.language.v1.Decider.in_ports:type_name -> aperture.policy.language.v1.Decider.Ins
+	52,  // 55: aperture.policy.language.v1.Decider.out_ports:type_name -> aperture.policy.language.v1.Decider.Outs
+	92,  // 56: aperture.policy.language.v1.Decider.true_for:type_name -> google.protobuf.Duration
+	92,  // 57: aperture.policy.language.v1.Decider.false_for:type_name -> google.protobuf.Duration
+	53,  // 58: aperture.policy.language.v1.Switcher.in_ports:type_name -> aperture.policy.language.v1.Switcher.Ins
+	54,  // 59: aperture.policy.language.v1.Switcher.out_ports:type_name -> aperture.policy.language.v1.Switcher.Outs
+	55,  // 60: aperture.policy.language.v1.QuotaLimiter.in_ports:type_name -> aperture.policy.language.v1.QuotaLimiter.Ins
+	94,  // 61: aperture.policy.language.v1.QuotaLimiter.selector:type_name -> aperture.common.selector.v1.Selector
+	0,   // 62: aperture.policy.language.v1.QuotaLimiter.window:type_name -> aperture.policy.language.v1.QuotaLimiter.Window
+	92,  // 63: aperture.policy.language.v1.QuotaLimiter.sync_interval:type_name -> google.protobuf.Duration
+	6,   // 64: aperture.policy.language.v1.QuotaLimiter.rejection_response:type_name -> aperture.policy.language.v1.RejectionResponse
+	59,  // 65: aperture.policy.language.v1.RateLimiter.in_ports:type_name -> aperture.policy.language.v1.RateLimiter.Ins
+	94,  // 66: aperture.policy.language.v1.RateLimiter.selector:type_name -> aperture.common.selector.v1.Selector
+	92,  // 67: aperture.policy.language.v1.RateLimiter.limit_reset_interval:type_name -> google.protobuf.Duration
+	56,  // 68: aperture.policy.language.v1.RateLimiter.lazy_sync:type_name -> aperture.policy.language.v1.RateLimiter.LazySync
+	57,  // 69: aperture.policy.language.v1.RateLimiter.init_config:type_name -> aperture.policy.language.v1.RateLimiter.DynamicConfig
+	6,   // 70: aperture.policy.language.v1.RateLimiter.rejection_response:type_name -> aperture.policy.language.v1.RejectionResponse
+	21,  // 71: aperture.policy.language.v1.ConcurrencyLimiter.scheduler:type_name -> aperture.policy.language.v1.Scheduler
+	22,  // 72: aperture.policy.language.v1.ConcurrencyLimiter.load_shed_actuator:type_name -> aperture.policy.language.v1.LoadShedActuator
+	23,  // 73: aperture.policy.language.v1.ConcurrencyLimiter.concurrency_budget_actuator:type_name -> aperture.policy.language.v1.ConcurrencyBudgetActuator
+	6,   // 74: aperture.policy.language.v1.ConcurrencyLimiter.rejection_response:type_name -> aperture.policy.language.v1.RejectionResponse
+	62,  // 75: aperture.policy.language.v1.Scheduler.out_ports:type_name -> aperture.policy.language.v1.Scheduler.Outs
+	94,  // 76: aperture.policy.language.v1.Scheduler.selector:type_name -> aperture.common.selector.v1.Selector
+	61,  // 77: aperture.policy.language.v1.Scheduler.workloads:type_name -> aperture.policy.language.v1.Scheduler.Workload
+	60,  // 78: aperture.policy.language.v1.Scheduler.default_workload_parameters:type_name -> aperture.policy.language.v1.Scheduler.WorkloadParameters
+	92,  // 79: aperture.policy.language.v1.Scheduler.max_timeout:type_name -> google.protobuf.Duration
+	63,  // 80: aperture.policy.language.v1.Scheduler.codel:type_name -> aperture.policy.language.v1.Scheduler.CoDel
+	64,  // 81: aperture.policy.language.v1.LoadShedActuator.in_ports:type_name -> aperture.policy.language.v1.LoadShedActuator.Ins
+	65,  // 82: aperture.policy.language.v1.ConcurrencyBudgetActuator.in_ports:type_name -> aperture.policy.language.v1.ConcurrencyBudgetActuator.Ins
+	92,  // 83: aperture.policy.language.v1.ConcurrencyBudgetActuator.lease_interval:type_name -> google.protobuf.Duration
+	92,  // 84: aperture.policy.language.v1.ConcurrencyBudgetActuator.lease_ttl:type_name -> google.protobuf.Duration
+	66,  // 85: aperture.policy.language.v1.PromQL.out_ports:type_name -> aperture.policy.language.v1.PromQL.Outs
+	92,  // 86: aperture.policy.language.v1.PromQL.evaluation_interval:type_name -> google.protobuf.Duration
+	67,  // 87: aperture.policy.language.v1.Constant.out_ports:type_name -> aperture.policy.language.v1.Constant.Outs
+	68,  // 88: aperture.policy.language.v1.Variable.out_ports:type_name -> aperture.policy.language.v1.Variable.Outs
+	69,  // 89: aperture.policy.language.v1.Variable.default_config:type_name -> aperture.policy.language.v1.Variable.DynamicConfig
+	70,  // 90: aperture.policy.language.v1.Schedule.out_ports:type_name -> aperture.policy.language.v1.Schedule.Outs
+	71,  // 91: aperture.policy.language.v1.Schedule.entries:type_name -> aperture.policy.language.v1.Schedule.Entry
+	72,  // 92: aperture.policy.language.v1.Sqrt.in_ports:type_name -> aperture.policy.language.v1.Sqrt.Ins
+	73,  // 93: aperture.policy.language.v1.Sqrt.out_ports:type_name -> aperture.policy.language.v1.Sqrt.Outs
+	74,  // 94: aperture.policy.language.v1.Extrapolator.in_ports:type_name -> aperture.policy.language.v1.Extrapolator.Ins
+	75,  // 95: aperture.policy.language.v1.Extrapolator.out_ports:type_name -> aperture.policy.language.v1.Extrapolator.Outs
+	92,  // 96: aperture.policy.language.v1.Extrapolator.max_extrapolation_interval:type_name -> google.protobuf.Duration
+	76,  // 97: aperture.policy.language.v1.Max.in_ports:type_name -> aperture.policy.language.v1.Max.Ins
+	77,  // 98: aperture.policy.language.v1.Max.out_ports:type_name -> aperture.policy.language.v1.Max.Outs
+	78,  // 99: aperture.policy.language.v1.Min.in_ports:type_name -> aperture.policy.language.v1.Min.Ins
+	79,  // 100: aperture.policy.language.v1.Min.out_ports:type_name -> aperture.policy.language.v1.Min.Outs
+	80,  // 101: aperture.policy.language.v1.Integrator.in_ports:type_name -> aperture.policy.language.v1.Integrator.Ins
+	81,  // 102: aperture.policy.language.v1.Integrator.out_ports:type_name -> aperture.policy.language.v1.Integrator.Outs
+	82,  // 103: aperture.policy.language.v1.Differentiator.in_ports:type_name -> aperture.policy.language.v1.Differentiator.Ins
+	83,  // 104: aperture.policy.language.v1.Differentiator.out_ports:type_name -> aperture.policy.language.v1.Differentiator.Outs
+	92,  // 105: aperture.policy.language.v1.Differentiator.window:type_name -> google.protobuf.Duration
+	84,  // 106: aperture.policy.language.v1.Delta.in_ports:type_name -> aperture.policy.language.v1.Delta.Ins
+	85,  // 107: aperture.policy.language.v1.Delta.out_ports:type_name -> aperture.policy.language.v1.Delta.Outs
+	86,  // 108: aperture.policy.language.v1.Holder.in_ports:type_name -> aperture.policy.language.v1.Holder.Ins
+	87,  // 109: aperture.policy.language.v1.Holder.out_ports:type_name -> aperture.policy.language.v1.Holder.Outs
+	92,  // 110: aperture.policy.language.v1.Holder.hold_for:type_name -> google.protobuf.Duration
+	88,  // 111: aperture.policy.language.v1.FirstValid.in_ports:type_name -> aperture.policy.language.v1.FirstValid.Ins
+	89,  // 112: aperture.policy.language.v1.FirstValid.out_ports:type_name -> aperture.policy.language.v1.FirstValid.Outs
+	90,  // 113: aperture.policy.language.v1.Inverter.in_ports:type_name -> aperture.policy.language.v1.Inverter.Ins
+	91,  // 114: aperture.policy.language.v1.Inverter.out_ports:type_name -> aperture.policy.language.v1.Inverter.Outs
+	5,   // 115: aperture.policy.language.v1.AllPolicies.AllPoliciesEntry.value:type_name -> aperture.policy.language.v1.Policy
+	95,  // 116: aperture.policy.language.v1.Resources.FluxMetersEntry.value:type_name -> aperture.policy.language.v1.FluxMeter
+	10,  // 117: aperture.policy.language.v1.GradientController.Ins.signal:type_name -> aperture.policy.language.v1.Port
+	10,  // 118: aperture.policy.language.v1.GradientController.Ins.setpoint:type_name -> aperture.policy.language.v1.Port
+	10,  // 119: aperture.policy.language.v1.GradientController.Ins.optimize:type_name -> aperture.policy.language.v1.Port
+	10,  // 120: aperture.policy.language.v1.GradientController.Ins.max:type_name -> aperture.policy.language.v1.Port
+	10,  // 121: aperture.policy.language.v1.GradientController.Ins.min:type_name -> aperture.policy.language.v1.Port
+	10,  // 122: aperture.policy.language.v1.GradientController.Ins.control_variable:type_name -> aperture.policy.language.v1.Port
+	10,  // 123: aperture.policy.language.v1.GradientController.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 124: aperture.policy.language.v1.PIDController.Ins.signal:type_name -> aperture.policy.language.v1.Port
+	10,  // 125: aperture.policy.language.v1.PIDController.Ins.setpoint:type_name -> aperture.policy.language.v1.Port
+	10,  // 126: aperture.policy.language.v1.PIDController.Ins.optimize:type_name -> aperture.policy.language.v1.Port
+	10,  // 127: aperture.policy.language.v1.PIDController.Ins.max:type_name -> aperture.policy.language.v1.Port
+	10,  // 128: aperture.policy.language.v1.PIDController.Ins.min:type_name -> aperture.policy.language.v1.Port
+	10,  // 129: aperture.policy.language.v1.PIDController.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 130: aperture.policy.language.v1.EMA.Ins.input:type_name -> aperture.policy.language.v1.Port
+	10,  // 131: aperture.policy.language.v1.EMA.Ins.max_envelope:type_name -> aperture.policy.language.v1.Port
+	10,  // 132: aperture.policy.language.v1.EMA.Ins.min_envelope:type_name -> aperture.policy.language.v1.Port
+	10,  // 133: aperture.policy.language.v1.EMA.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 134: aperture.policy.language.v1.HoltWinters.Ins.input:type_name -> aperture.policy.language.v1.Port
+	10,  // 135: aperture.policy.language.v1.HoltWinters.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 136: aperture.policy.language.v1.HoltWinters.Outs.trend:type_name -> aperture.policy.language.v1.Port
+	10,  // 137: aperture.policy.language.v1.HoltWinters.Outs.forecast:type_name -> aperture.policy.language.v1.Port
+	10,  // 138: aperture.policy.language.v1.ArithmeticCombinator.Ins.lhs:type_name -> aperture.policy.language.v1.Port
+	10,  // 139: aperture.policy.language.v1.ArithmeticCombinator.Ins.rhs:type_name -> aperture.policy.language.v1.Port
+	10,  // 140: aperture.policy.language.v1.ArithmeticCombinator.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 141: aperture.policy.language.v1.Decider.Ins.lhs:type_name -> aperture.policy.language.v1.Port
+	10,  // 142: aperture.policy.language.v1.Decider.Ins.rhs:type_name -> aperture.policy.language.v1.Port
+	10,  // 143: aperture.policy.language.v1.Decider.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 144: aperture.policy.language.v1.Switcher.Ins.on_true:type_name -> aperture.policy.language.v1.Port
+	10,  // 145: aperture.policy.language.v1.Switcher.Ins.on_false:type_name -> aperture.policy.language.v1.Port
+	10,  // 146: aperture.policy.language.v1.Switcher.Ins.switch:type_name -> aperture.policy.language.v1.Port
+	10,  // 147: aperture.policy.language.v1.Switcher.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 148: aperture.policy.language.v1.QuotaLimiter.Ins.limit:type_name -> aperture.policy.language.v1.Port
+	58,  // 149: aperture.policy.language.v1.RateLimiter.DynamicConfig.overrides:type_name -> aperture.policy.language.v1.RateLimiter.Override
+	10,  // 150: aperture.policy.language.v1.RateLimiter.Ins.limit:type_name -> aperture.policy.language.v1.Port
+	10,  // 151: aperture.policy.language.v1.RateLimiter.Ins.fill_rate:type_name -> aperture.policy.language.v1.Port
+	10,  // 152: aperture.policy.language.v1.RateLimiter.Ins.bucket_capacity:type_name -> aperture.policy.language.v1.Port
+	60,  // 153: aperture.policy.language.v1.Scheduler.Workload.workload_parameters:type_name -> aperture.policy.language.v1.Scheduler.WorkloadParameters
+	96,  // 154: aperture.policy.language.v1.Scheduler.Workload.label_matcher:type_name -> aperture.common.labelmatcher.v1.LabelMatcher
+	10,  // 155: aperture.policy.language.v1.Scheduler.Outs.accepted_concurrency:type_name -> aperture.policy.language.v1.Port
+	10,  // 156: aperture.policy.language.v1.Scheduler.Outs.incoming_concurrency:type_name -> aperture.policy.language.v1.Port
+	10,  // 157: aperture.policy.language.v1.Scheduler.Outs.queued_requests:type_name -> aperture.policy.language.v1.Port
+	10,  // 158: aperture.policy.language.v1.Scheduler.Outs.workload_queued_requests:type_name -> aperture.policy.language.v1.Port
+	92,  // 159: aperture.policy.language.v1.Scheduler.CoDel.target:type_name -> google.protobuf.Duration
+	92,  // 160: aperture.policy.language.v1.Scheduler.CoDel.interval:type_name -> google.protobuf.Duration
+	10,  // 161: aperture.policy.language.v1.LoadShedActuator.Ins.load_shed_factor:type_name -> aperture.policy.language.v1.Port
+	10,  // 162: aperture.policy.language.v1.ConcurrencyBudgetActuator.Ins.concurrency_budget:type_name -> aperture.policy.language.v1.Port
+	10,  // 163: aperture.policy.language.v1.PromQL.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 164: aperture.policy.language.v1.Constant.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 165: aperture.policy.language.v1.Variable.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 166: aperture.policy.language.v1.Schedule.Outs.output:type_name -> aperture.policy.language.v1.Port
+	92,  // 167: aperture.policy.language.v1.Schedule.Entry.ramp:type_name -> google.protobuf.Duration
+	10,  // 168: aperture.policy.language.v1.Sqrt.Ins.input:type_name -> aperture.policy.language.v1.Port
+	10,  // 169: aperture.policy.language.v1.Sqrt.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 170: aperture.policy.language.v1.Extrapolator.Ins.input:type_name -> aperture.policy.language.v1.Port
+	10,  // 171: aperture.policy.language.v1.Extrapolator.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 172: aperture.policy.language.v1.Max.Ins.inputs:type_name -> aperture.policy.language.v1.Port
+	10,  // 173: aperture.policy.language.v1.Max.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 174: aperture.policy.language.v1.Min.Ins.inputs:type_name -> aperture.policy.language.v1.Port
+	10,  // 175: aperture.policy.language.v1.Min.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 176: aperture.policy.language.v1.Integrator.Ins.input:type_name -> aperture.policy.language.v1.Port
+	10,  // 177: aperture.policy.language.v1.Integrator.Ins.reset:type_name -> aperture.policy.language.v1.Port
+	10,  // 178: aperture.policy.language.v1.Integrator.Ins.max:type_name -> aperture.policy.language.v1.Port
+	10,  // 179: aperture.policy.language.v1.Integrator.Ins.min:type_name -> aperture.policy.language.v1.Port
+	10,  // 180: aperture.policy.language.v1.Integrator.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 181: aperture.policy.language.v1.Differentiator.Ins.input:type_name -> aperture.policy.language.v1.Port
+	10,  // 182: aperture.policy.language.v1.Differentiator.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 183: aperture.policy.language.v1.Delta.Ins.input:type_name -> aperture.policy.language.v1.Port
+	10,  // 184: aperture.policy.language.v1.Delta.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 185: aperture.policy.language.v1.Holder.Ins.input:type_name -> aperture.policy.language.v1.Port
+	10,  // 186: aperture.policy.language.v1.Holder.Ins.reset:type_name -> aperture.policy.language.v1.Port
+	10,  // 187: aperture.policy.language.v1.Holder.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 188: aperture.policy.language.v1.FirstValid.Ins.inputs:type_name -> aperture.policy.language.v1.Port
+	10,  // 189: aperture.policy.language.v1.FirstValid.Outs.output:type_name -> aperture.policy.language.v1.Port
+	10,  // 190: aperture.policy.language.v1.Inverter.Ins.input:type_name -> aperture.policy.language.v1.Port
+	10,  // 191: aperture.policy.language.v1.Inverter.Outs.output:type_name -> aperture.policy.language.v1.Port
+	97,  // 192: aperture.policy.language.v1.PolicyService.AllPolicies:input_type -> google.protobuf.Empty
+	3,   // 193: aperture.policy.language.v1.PolicyService.UpsertPolicy:input_type -> aperture.policy.language.v1.UpsertPolicyRequest
+	4,   // 194: aperture.policy.language.v1.PolicyService.DeletePolicy:input_type -> aperture.policy.language.v1.DeletePolicyRequest
+	1,   // 195: aperture.policy.language.v1.PolicyService.AllPolicies:output_type -> aperture.policy.language.v1.AllPoliciesResponse
+	97,  // 196: aperture.policy.language.v1.PolicyService.UpsertPolicy:output_type -> google.protobuf.Empty
+	97,  // 197: aperture.policy.language.v1.PolicyService.DeletePolicy:output_type -> google.protobuf.Empty
+	195, // [195:198] is the sub-list for method output_type
+	192, // [192:195] is the sub-list for method input_type
+	192, // [192:192] is the sub-list for extension type_name
+	192, // [192:192] is the sub-list for extension extendee
+	0,   // [0:192] is the sub-list for field type_name
+}
+
+func init() { file_aperture_policy_language_v1_policy_proto_init() }
+func file_aperture_policy_language_v1_policy_proto_init() {
+	if File_aperture_policy_language_v1_policy_proto != nil {
+		return
+	}
+	file_aperture_policy_language_v1_classifier_proto_init()
+	file_aperture_policy_language_v1_fluxmeter_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllPoliciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllPolicies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Policy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Circuit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Component); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Port); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradientController); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PIDController); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EMA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoltWinters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArithmeticCombinator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Decider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Switcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaLimiter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimiter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConcurrencyLimiter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scheduler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadShedActuator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConcurrencyBudgetActuator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromQL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Constant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4384,8 +8348,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllPolicies); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sqrt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4396,8 +8360,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Policy); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Extrapolator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4408,8 +8372,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Circuit); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Max); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4420,8 +8384,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Resources); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Min); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4432,8 +8396,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Component); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Integrator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4444,8 +8408,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Port); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Differentiator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4456,8 +8420,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GradientController); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Delta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4468,8 +8432,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EMA); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Holder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4480,8 +8444,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArithmeticCombinator); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirstValid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4492,8 +8456,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Decider); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Inverter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4504,8 +8468,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Switcher); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradientController_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4516,8 +8480,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RateLimiter); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradientController_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4528,8 +8492,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConcurrencyLimiter); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PIDController_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4540,8 +8504,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Scheduler); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PIDController_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4552,8 +8516,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadShedActuator); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EMA_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4564,8 +8528,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PromQL); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EMA_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4576,8 +8540,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Constant); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoltWinters_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4588,8 +8552,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sqrt); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoltWinters_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4600,8 +8564,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Extrapolator); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArithmeticCombinator_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4612,8 +8576,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Max); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArithmeticCombinator_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4624,8 +8588,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Min); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Decider_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4636,8 +8600,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GradientController_Ins); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Decider_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4648,8 +8612,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GradientController_Outs); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Switcher_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4660,8 +8624,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EMA_Ins); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Switcher_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4672,8 +8636,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EMA_Outs); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaLimiter_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4684,8 +8648,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArithmeticCombinator_Ins); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimiter_LazySync); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4696,8 +8660,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArithmeticCombinator_Outs); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimiter_DynamicConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4708,8 +8672,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Decider_Ins); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimiter_Override); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4720,8 +8684,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Decider_Outs); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimiter_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4732,8 +8696,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Switcher_Ins); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scheduler_WorkloadParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4744,8 +8708,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Switcher_Outs); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scheduler_Workload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4756,8 +8720,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RateLimiter_LazySync); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scheduler_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4768,8 +8732,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RateLimiter_DynamicConfig); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scheduler_CoDel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4780,8 +8744,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RateLimiter_Override); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadShedActuator_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4792,8 +8756,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RateLimiter_Ins); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConcurrencyBudgetActuator_Ins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4804,8 +8768,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Scheduler_WorkloadParameters); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromQL_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4816,8 +8780,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Scheduler_Workload); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Constant_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4828,8 +8792,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Scheduler_Outs); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variable_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4840,8 +8804,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadShedActuator_Ins); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variable_DynamicConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4852,8 +8816,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PromQL_Outs); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schedule_Outs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4864,8 +8828,8 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Constant_Outs); i {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schedule_Entry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4876,7 +8840,7 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Sqrt_Ins); i {
 			case 0:
 				return &v.state
@@ -4888,7 +8852,7 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Sqrt_Outs); i {
 			case 0:
 				return &v.state
@@ -4900,7 +8864,7 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Extrapolator_Ins); i {
 			case 0:
 				return &v.state
@@ -4912,7 +8876,7 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Extrapolator_Outs); i {
 			case 0:
 				return &v.state
@@ -4924,7 +8888,7 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Max_Ins); i {
 			case 0:
 				return &v.state
@@ -4936,7 +8900,7 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Max_Outs); i {
 			case 0:
 				return &v.state
@@ -4948,7 +8912,7 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Min_Ins); i {
 			case 0:
 				return &v.state
@@ -4960,7 +8924,7 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
-		file_aperture_policy_language_v1_policy_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_aperture_policy_language_v1_policy_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Min_Outs); i {
 			case 0:
 				return &v.state
@@ -4972,8 +8936,152 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 				return nil
 			}
 		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Integrator_Ins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Integrator_Outs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Differentiator_Ins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Differentiator_Outs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Delta_Ins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Delta_Outs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Holder_Ins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Holder_Outs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirstValid_Ins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirstValid_Outs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Inverter_Ins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_language_v1_policy_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Inverter_Outs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_aperture_policy_language_v1_policy_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_aperture_policy_language_v1_policy_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*Component_GradientController)(nil),
 		(*Component_Ema)(nil),
 		(*Component_ArithmeticCombinator)(nil),
@@ -4987,22 +9095,35 @@ func file_aperture_policy_language_v1_policy_proto_init() {
 		(*Component_Extrapolator)(nil),
 		(*Component_Max)(nil),
 		(*Component_Min)(nil),
-	}
-	file_aperture_policy_language_v1_policy_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*Component_PidController)(nil),
+		(*Component_QuotaLimiter)(nil),
+		(*Component_HoltWinters)(nil),
+		(*Component_Integrator)(nil),
+		(*Component_Differentiator)(nil),
+		(*Component_Delta)(nil),
+		(*Component_Schedule)(nil),
+		(*Component_Variable)(nil),
+		(*Component_Holder)(nil),
+		(*Component_FirstValid)(nil),
+		(*Component_Inverter)(nil),
+	}
+	file_aperture_policy_language_v1_policy_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*ConcurrencyLimiter_LoadShedActuator)(nil),
+		(*ConcurrencyLimiter_ConcurrencyBudgetActuator)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_aperture_policy_language_v1_policy_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   52,
+			NumEnums:      1,
+			NumMessages:   91,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_aperture_policy_language_v1_policy_proto_goTypes,
 		DependencyIndexes: file_aperture_policy_language_v1_policy_proto_depIdxs,
+		EnumInfos:         file_aperture_policy_language_v1_policy_proto_enumTypes,
 		MessageInfos:      file_aperture_policy_language_v1_policy_proto_msgTypes,
 	}.Build()
 	File_aperture_policy_language_v1_policy_proto = out.File
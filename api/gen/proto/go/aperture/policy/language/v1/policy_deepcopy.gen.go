@@ -47,6 +47,48 @@ func (in *AllPolicies) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using UpsertPolicyRequest within kubernetes types, where deepcopy-gen is used.
+func (in *UpsertPolicyRequest) DeepCopyInto(out *UpsertPolicyRequest) {
+	p := proto.Clone(in).(*UpsertPolicyRequest)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpsertPolicyRequest. Required by controller-gen.
+func (in *UpsertPolicyRequest) DeepCopy() *UpsertPolicyRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(UpsertPolicyRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new UpsertPolicyRequest. Required by controller-gen.
+func (in *UpsertPolicyRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using DeletePolicyRequest within kubernetes types, where deepcopy-gen is used.
+func (in *DeletePolicyRequest) DeepCopyInto(out *DeletePolicyRequest) {
+	p := proto.Clone(in).(*DeletePolicyRequest)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletePolicyRequest. Required by controller-gen.
+func (in *DeletePolicyRequest) DeepCopy() *DeletePolicyRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletePolicyRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new DeletePolicyRequest. Required by controller-gen.
+func (in *DeletePolicyRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using Policy within kubernetes types, where deepcopy-gen is used.
 func (in *Policy) DeepCopyInto(out *Policy) {
 	p := proto.Clone(in).(*Policy)
@@ -68,6 +110,27 @@ func (in *Policy) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using RejectionResponse within kubernetes types, where deepcopy-gen is used.
+func (in *RejectionResponse) DeepCopyInto(out *RejectionResponse) {
+	p := proto.Clone(in).(*RejectionResponse)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RejectionResponse. Required by controller-gen.
+func (in *RejectionResponse) DeepCopy() *RejectionResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(RejectionResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new RejectionResponse. Required by controller-gen.
+func (in *RejectionResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using Circuit within kubernetes types, where deepcopy-gen is used.
 func (in *Circuit) DeepCopyInto(out *Circuit) {
 	p := proto.Clone(in).(*Circuit)
@@ -215,6 +278,69 @@ func (in *GradientController_Outs) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using PIDController within kubernetes types, where deepcopy-gen is used.
+func (in *PIDController) DeepCopyInto(out *PIDController) {
+	p := proto.Clone(in).(*PIDController)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIDController. Required by controller-gen.
+func (in *PIDController) DeepCopy() *PIDController {
+	if in == nil {
+		return nil
+	}
+	out := new(PIDController)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new PIDController. Required by controller-gen.
+func (in *PIDController) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using PIDController_Ins within kubernetes types, where deepcopy-gen is used.
+func (in *PIDController_Ins) DeepCopyInto(out *PIDController_Ins) {
+	p := proto.Clone(in).(*PIDController_Ins)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIDController_Ins. Required by controller-gen.
+func (in *PIDController_Ins) DeepCopy() *PIDController_Ins {
+	if in == nil {
+		return nil
+	}
+	out := new(PIDController_Ins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new PIDController_Ins. Required by controller-gen.
+func (in *PIDController_Ins) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using PIDController_Outs within kubernetes types, where deepcopy-gen is used.
+func (in *PIDController_Outs) DeepCopyInto(out *PIDController_Outs) {
+	p := proto.Clone(in).(*PIDController_Outs)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PIDController_Outs. Required by controller-gen.
+func (in *PIDController_Outs) DeepCopy() *PIDController_Outs {
+	if in == nil {
+		return nil
+	}
+	out := new(PIDController_Outs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new PIDController_Outs. Required by controller-gen.
+func (in *PIDController_Outs) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using EMA within kubernetes types, where deepcopy-gen is used.
 func (in *EMA) DeepCopyInto(out *EMA) {
 	p := proto.Clone(in).(*EMA)
@@ -278,6 +404,69 @@ func (in *EMA_Outs) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using HoltWinters within kubernetes types, where deepcopy-gen is used.
+func (in *HoltWinters) DeepCopyInto(out *HoltWinters) {
+	p := proto.Clone(in).(*HoltWinters)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HoltWinters. Required by controller-gen.
+func (in *HoltWinters) DeepCopy() *HoltWinters {
+	if in == nil {
+		return nil
+	}
+	out := new(HoltWinters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new HoltWinters. Required by controller-gen.
+func (in *HoltWinters) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using HoltWinters_Ins within kubernetes types, where deepcopy-gen is used.
+func (in *HoltWinters_Ins) DeepCopyInto(out *HoltWinters_Ins) {
+	p := proto.Clone(in).(*HoltWinters_Ins)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HoltWinters_Ins. Required by controller-gen.
+func (in *HoltWinters_Ins) DeepCopy() *HoltWinters_Ins {
+	if in == nil {
+		return nil
+	}
+	out := new(HoltWinters_Ins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new HoltWinters_Ins. Required by controller-gen.
+func (in *HoltWinters_Ins) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using HoltWinters_Outs within kubernetes types, where deepcopy-gen is used.
+func (in *HoltWinters_Outs) DeepCopyInto(out *HoltWinters_Outs) {
+	p := proto.Clone(in).(*HoltWinters_Outs)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HoltWinters_Outs. Required by controller-gen.
+func (in *HoltWinters_Outs) DeepCopy() *HoltWinters_Outs {
+	if in == nil {
+		return nil
+	}
+	out := new(HoltWinters_Outs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new HoltWinters_Outs. Required by controller-gen.
+func (in *HoltWinters_Outs) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using ArithmeticCombinator within kubernetes types, where deepcopy-gen is used.
 func (in *ArithmeticCombinator) DeepCopyInto(out *ArithmeticCombinator) {
 	p := proto.Clone(in).(*ArithmeticCombinator)
@@ -467,6 +656,48 @@ func (in *Switcher_Outs) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using QuotaLimiter within kubernetes types, where deepcopy-gen is used.
+func (in *QuotaLimiter) DeepCopyInto(out *QuotaLimiter) {
+	p := proto.Clone(in).(*QuotaLimiter)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimiter. Required by controller-gen.
+func (in *QuotaLimiter) DeepCopy() *QuotaLimiter {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaLimiter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimiter. Required by controller-gen.
+func (in *QuotaLimiter) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using QuotaLimiter_Ins within kubernetes types, where deepcopy-gen is used.
+func (in *QuotaLimiter_Ins) DeepCopyInto(out *QuotaLimiter_Ins) {
+	p := proto.Clone(in).(*QuotaLimiter_Ins)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimiter_Ins. Required by controller-gen.
+func (in *QuotaLimiter_Ins) DeepCopy() *QuotaLimiter_Ins {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaLimiter_Ins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimiter_Ins. Required by controller-gen.
+func (in *QuotaLimiter_Ins) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using RateLimiter within kubernetes types, where deepcopy-gen is used.
 func (in *RateLimiter) DeepCopyInto(out *RateLimiter) {
 	p := proto.Clone(in).(*RateLimiter)
@@ -677,6 +908,27 @@ func (in *Scheduler_Outs) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using Scheduler_CoDel within kubernetes types, where deepcopy-gen is used.
+func (in *Scheduler_CoDel) DeepCopyInto(out *Scheduler_CoDel) {
+	p := proto.Clone(in).(*Scheduler_CoDel)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scheduler_CoDel. Required by controller-gen.
+func (in *Scheduler_CoDel) DeepCopy() *Scheduler_CoDel {
+	if in == nil {
+		return nil
+	}
+	out := new(Scheduler_CoDel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Scheduler_CoDel. Required by controller-gen.
+func (in *Scheduler_CoDel) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using LoadShedActuator within kubernetes types, where deepcopy-gen is used.
 func (in *LoadShedActuator) DeepCopyInto(out *LoadShedActuator) {
 	p := proto.Clone(in).(*LoadShedActuator)
@@ -719,6 +971,48 @@ func (in *LoadShedActuator_Ins) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using ConcurrencyBudgetActuator within kubernetes types, where deepcopy-gen is used.
+func (in *ConcurrencyBudgetActuator) DeepCopyInto(out *ConcurrencyBudgetActuator) {
+	p := proto.Clone(in).(*ConcurrencyBudgetActuator)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyBudgetActuator. Required by controller-gen.
+func (in *ConcurrencyBudgetActuator) DeepCopy() *ConcurrencyBudgetActuator {
+	if in == nil {
+		return nil
+	}
+	out := new(ConcurrencyBudgetActuator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyBudgetActuator. Required by controller-gen.
+func (in *ConcurrencyBudgetActuator) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ConcurrencyBudgetActuator_Ins within kubernetes types, where deepcopy-gen is used.
+func (in *ConcurrencyBudgetActuator_Ins) DeepCopyInto(out *ConcurrencyBudgetActuator_Ins) {
+	p := proto.Clone(in).(*ConcurrencyBudgetActuator_Ins)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyBudgetActuator_Ins. Required by controller-gen.
+func (in *ConcurrencyBudgetActuator_Ins) DeepCopy() *ConcurrencyBudgetActuator_Ins {
+	if in == nil {
+		return nil
+	}
+	out := new(ConcurrencyBudgetActuator_Ins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyBudgetActuator_Ins. Required by controller-gen.
+func (in *ConcurrencyBudgetActuator_Ins) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using PromQL within kubernetes types, where deepcopy-gen is used.
 func (in *PromQL) DeepCopyInto(out *PromQL) {
 	p := proto.Clone(in).(*PromQL)
@@ -803,6 +1097,132 @@ func (in *Constant_Outs) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using Variable within kubernetes types, where deepcopy-gen is used.
+func (in *Variable) DeepCopyInto(out *Variable) {
+	p := proto.Clone(in).(*Variable)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Variable. Required by controller-gen.
+func (in *Variable) DeepCopy() *Variable {
+	if in == nil {
+		return nil
+	}
+	out := new(Variable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Variable. Required by controller-gen.
+func (in *Variable) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Variable_Outs within kubernetes types, where deepcopy-gen is used.
+func (in *Variable_Outs) DeepCopyInto(out *Variable_Outs) {
+	p := proto.Clone(in).(*Variable_Outs)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Variable_Outs. Required by controller-gen.
+func (in *Variable_Outs) DeepCopy() *Variable_Outs {
+	if in == nil {
+		return nil
+	}
+	out := new(Variable_Outs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Variable_Outs. Required by controller-gen.
+func (in *Variable_Outs) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Variable_DynamicConfig within kubernetes types, where deepcopy-gen is used.
+func (in *Variable_DynamicConfig) DeepCopyInto(out *Variable_DynamicConfig) {
+	p := proto.Clone(in).(*Variable_DynamicConfig)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Variable_DynamicConfig. Required by controller-gen.
+func (in *Variable_DynamicConfig) DeepCopy() *Variable_DynamicConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(Variable_DynamicConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Variable_DynamicConfig. Required by controller-gen.
+func (in *Variable_DynamicConfig) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Schedule within kubernetes types, where deepcopy-gen is used.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	p := proto.Clone(in).(*Schedule)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule. Required by controller-gen.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Schedule. Required by controller-gen.
+func (in *Schedule) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Schedule_Outs within kubernetes types, where deepcopy-gen is used.
+func (in *Schedule_Outs) DeepCopyInto(out *Schedule_Outs) {
+	p := proto.Clone(in).(*Schedule_Outs)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule_Outs. Required by controller-gen.
+func (in *Schedule_Outs) DeepCopy() *Schedule_Outs {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule_Outs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Schedule_Outs. Required by controller-gen.
+func (in *Schedule_Outs) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Schedule_Entry within kubernetes types, where deepcopy-gen is used.
+func (in *Schedule_Entry) DeepCopyInto(out *Schedule_Entry) {
+	p := proto.Clone(in).(*Schedule_Entry)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule_Entry. Required by controller-gen.
+func (in *Schedule_Entry) DeepCopy() *Schedule_Entry {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule_Entry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Schedule_Entry. Required by controller-gen.
+func (in *Schedule_Entry) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using Sqrt within kubernetes types, where deepcopy-gen is used.
 func (in *Sqrt) DeepCopyInto(out *Sqrt) {
 	p := proto.Clone(in).(*Sqrt)
@@ -1054,3 +1474,381 @@ func (in *Min_Outs) DeepCopy() *Min_Outs {
 func (in *Min_Outs) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
+
+// DeepCopyInto supports using Integrator within kubernetes types, where deepcopy-gen is used.
+func (in *Integrator) DeepCopyInto(out *Integrator) {
+	p := proto.Clone(in).(*Integrator)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Integrator. Required by controller-gen.
+func (in *Integrator) DeepCopy() *Integrator {
+	if in == nil {
+		return nil
+	}
+	out := new(Integrator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Integrator. Required by controller-gen.
+func (in *Integrator) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Integrator_Ins within kubernetes types, where deepcopy-gen is used.
+func (in *Integrator_Ins) DeepCopyInto(out *Integrator_Ins) {
+	p := proto.Clone(in).(*Integrator_Ins)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Integrator_Ins. Required by controller-gen.
+func (in *Integrator_Ins) DeepCopy() *Integrator_Ins {
+	if in == nil {
+		return nil
+	}
+	out := new(Integrator_Ins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Integrator_Ins. Required by controller-gen.
+func (in *Integrator_Ins) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Integrator_Outs within kubernetes types, where deepcopy-gen is used.
+func (in *Integrator_Outs) DeepCopyInto(out *Integrator_Outs) {
+	p := proto.Clone(in).(*Integrator_Outs)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Integrator_Outs. Required by controller-gen.
+func (in *Integrator_Outs) DeepCopy() *Integrator_Outs {
+	if in == nil {
+		return nil
+	}
+	out := new(Integrator_Outs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Integrator_Outs. Required by controller-gen.
+func (in *Integrator_Outs) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Differentiator within kubernetes types, where deepcopy-gen is used.
+func (in *Differentiator) DeepCopyInto(out *Differentiator) {
+	p := proto.Clone(in).(*Differentiator)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Differentiator. Required by controller-gen.
+func (in *Differentiator) DeepCopy() *Differentiator {
+	if in == nil {
+		return nil
+	}
+	out := new(Differentiator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Differentiator. Required by controller-gen.
+func (in *Differentiator) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Differentiator_Ins within kubernetes types, where deepcopy-gen is used.
+func (in *Differentiator_Ins) DeepCopyInto(out *Differentiator_Ins) {
+	p := proto.Clone(in).(*Differentiator_Ins)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Differentiator_Ins. Required by controller-gen.
+func (in *Differentiator_Ins) DeepCopy() *Differentiator_Ins {
+	if in == nil {
+		return nil
+	}
+	out := new(Differentiator_Ins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Differentiator_Ins. Required by controller-gen.
+func (in *Differentiator_Ins) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Differentiator_Outs within kubernetes types, where deepcopy-gen is used.
+func (in *Differentiator_Outs) DeepCopyInto(out *Differentiator_Outs) {
+	p := proto.Clone(in).(*Differentiator_Outs)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Differentiator_Outs. Required by controller-gen.
+func (in *Differentiator_Outs) DeepCopy() *Differentiator_Outs {
+	if in == nil {
+		return nil
+	}
+	out := new(Differentiator_Outs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Differentiator_Outs. Required by controller-gen.
+func (in *Differentiator_Outs) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Delta within kubernetes types, where deepcopy-gen is used.
+func (in *Delta) DeepCopyInto(out *Delta) {
+	p := proto.Clone(in).(*Delta)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Delta. Required by controller-gen.
+func (in *Delta) DeepCopy() *Delta {
+	if in == nil {
+		return nil
+	}
+	out := new(Delta)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Delta. Required by controller-gen.
+func (in *Delta) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Delta_Ins within kubernetes types, where deepcopy-gen is used.
+func (in *Delta_Ins) DeepCopyInto(out *Delta_Ins) {
+	p := proto.Clone(in).(*Delta_Ins)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Delta_Ins. Required by controller-gen.
+func (in *Delta_Ins) DeepCopy() *Delta_Ins {
+	if in == nil {
+		return nil
+	}
+	out := new(Delta_Ins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Delta_Ins. Required by controller-gen.
+func (in *Delta_Ins) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Delta_Outs within kubernetes types, where deepcopy-gen is used.
+func (in *Delta_Outs) DeepCopyInto(out *Delta_Outs) {
+	p := proto.Clone(in).(*Delta_Outs)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Delta_Outs. Required by controller-gen.
+func (in *Delta_Outs) DeepCopy() *Delta_Outs {
+	if in == nil {
+		return nil
+	}
+	out := new(Delta_Outs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Delta_Outs. Required by controller-gen.
+func (in *Delta_Outs) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Holder within kubernetes types, where deepcopy-gen is used.
+func (in *Holder) DeepCopyInto(out *Holder) {
+	p := proto.Clone(in).(*Holder)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Holder. Required by controller-gen.
+func (in *Holder) DeepCopy() *Holder {
+	if in == nil {
+		return nil
+	}
+	out := new(Holder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Holder. Required by controller-gen.
+func (in *Holder) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Holder_Ins within kubernetes types, where deepcopy-gen is used.
+func (in *Holder_Ins) DeepCopyInto(out *Holder_Ins) {
+	p := proto.Clone(in).(*Holder_Ins)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Holder_Ins. Required by controller-gen.
+func (in *Holder_Ins) DeepCopy() *Holder_Ins {
+	if in == nil {
+		return nil
+	}
+	out := new(Holder_Ins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Holder_Ins. Required by controller-gen.
+func (in *Holder_Ins) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Holder_Outs within kubernetes types, where deepcopy-gen is used.
+func (in *Holder_Outs) DeepCopyInto(out *Holder_Outs) {
+	p := proto.Clone(in).(*Holder_Outs)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Holder_Outs. Required by controller-gen.
+func (in *Holder_Outs) DeepCopy() *Holder_Outs {
+	if in == nil {
+		return nil
+	}
+	out := new(Holder_Outs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Holder_Outs. Required by controller-gen.
+func (in *Holder_Outs) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using FirstValid within kubernetes types, where deepcopy-gen is used.
+func (in *FirstValid) DeepCopyInto(out *FirstValid) {
+	p := proto.Clone(in).(*FirstValid)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirstValid. Required by controller-gen.
+func (in *FirstValid) DeepCopy() *FirstValid {
+	if in == nil {
+		return nil
+	}
+	out := new(FirstValid)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new FirstValid. Required by controller-gen.
+func (in *FirstValid) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using FirstValid_Ins within kubernetes types, where deepcopy-gen is used.
+func (in *FirstValid_Ins) DeepCopyInto(out *FirstValid_Ins) {
+	p := proto.Clone(in).(*FirstValid_Ins)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirstValid_Ins. Required by controller-gen.
+func (in *FirstValid_Ins) DeepCopy() *FirstValid_Ins {
+	if in == nil {
+		return nil
+	}
+	out := new(FirstValid_Ins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new FirstValid_Ins. Required by controller-gen.
+func (in *FirstValid_Ins) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using FirstValid_Outs within kubernetes types, where deepcopy-gen is used.
+func (in *FirstValid_Outs) DeepCopyInto(out *FirstValid_Outs) {
+	p := proto.Clone(in).(*FirstValid_Outs)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirstValid_Outs. Required by controller-gen.
+func (in *FirstValid_Outs) DeepCopy() *FirstValid_Outs {
+	if in == nil {
+		return nil
+	}
+	out := new(FirstValid_Outs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new FirstValid_Outs. Required by controller-gen.
+func (in *FirstValid_Outs) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Inverter within kubernetes types, where deepcopy-gen is used.
+func (in *Inverter) DeepCopyInto(out *Inverter) {
+	p := proto.Clone(in).(*Inverter)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Inverter. Required by controller-gen.
+func (in *Inverter) DeepCopy() *Inverter {
+	if in == nil {
+		return nil
+	}
+	out := new(Inverter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Inverter. Required by controller-gen.
+func (in *Inverter) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Inverter_Ins within kubernetes types, where deepcopy-gen is used.
+func (in *Inverter_Ins) DeepCopyInto(out *Inverter_Ins) {
+	p := proto.Clone(in).(*Inverter_Ins)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Inverter_Ins. Required by controller-gen.
+func (in *Inverter_Ins) DeepCopy() *Inverter_Ins {
+	if in == nil {
+		return nil
+	}
+	out := new(Inverter_Ins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Inverter_Ins. Required by controller-gen.
+func (in *Inverter_Ins) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using Inverter_Outs within kubernetes types, where deepcopy-gen is used.
+func (in *Inverter_Outs) DeepCopyInto(out *Inverter_Outs) {
+	p := proto.Clone(in).(*Inverter_Outs)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Inverter_Outs. Required by controller-gen.
+func (in *Inverter_Outs) DeepCopy() *Inverter_Outs {
+	if in == nil {
+		return nil
+	}
+	out := new(Inverter_Outs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Inverter_Outs. Required by controller-gen.
+func (in *Inverter_Outs) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
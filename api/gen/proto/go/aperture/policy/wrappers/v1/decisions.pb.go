@@ -78,6 +78,63 @@ func (x *LoadShedDecisionWrapper) GetLoadShedDecision() *v1.LoadShedDecision {
 	return nil
 }
 
+type ConcurrencyBudgetDecisionWrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CommonAttributes
+	CommonAttributes *CommonAttributes `protobuf:"bytes,1,opt,name=common_attributes,json=commonAttributes,proto3" json:"common_attributes,omitempty"`
+	// Concurrency Budget Decision
+	ConcurrencyBudgetDecision *v1.ConcurrencyBudgetDecision `protobuf:"bytes,2,opt,name=concurrency_budget_decision,json=concurrencyBudgetDecision,proto3" json:"concurrency_budget_decision,omitempty"`
+}
+
+func (x *ConcurrencyBudgetDecisionWrapper) Reset() {
+	*x = ConcurrencyBudgetDecisionWrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConcurrencyBudgetDecisionWrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyBudgetDecisionWrapper) ProtoMessage() {}
+
+func (x *ConcurrencyBudgetDecisionWrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConcurrencyBudgetDecisionWrapper.ProtoReflect.Descriptor instead.
+func (*ConcurrencyBudgetDecisionWrapper) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_wrappers_v1_decisions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConcurrencyBudgetDecisionWrapper) GetCommonAttributes() *CommonAttributes {
+	if x != nil {
+		return x.CommonAttributes
+	}
+	return nil
+}
+
+func (x *ConcurrencyBudgetDecisionWrapper) GetConcurrencyBudgetDecision() *v1.ConcurrencyBudgetDecision {
+	if x != nil {
+		return x.ConcurrencyBudgetDecision
+	}
+	return nil
+}
+
 type TokensDecisionWrapper struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -92,7 +149,7 @@ type TokensDecisionWrapper struct {
 func (x *TokensDecisionWrapper) Reset() {
 	*x = TokensDecisionWrapper{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[1]
+		mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -105,7 +162,7 @@ func (x *TokensDecisionWrapper) String() string {
 func (*TokensDecisionWrapper) ProtoMessage() {}
 
 func (x *TokensDecisionWrapper) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[1]
+	mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -118,7 +175,7 @@ func (x *TokensDecisionWrapper) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokensDecisionWrapper.ProtoReflect.Descriptor instead.
 func (*TokensDecisionWrapper) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_wrappers_v1_decisions_proto_rawDescGZIP(), []int{1}
+	return file_aperture_policy_wrappers_v1_decisions_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TokensDecisionWrapper) GetCommonAttributes() *CommonAttributes {
@@ -149,7 +206,7 @@ type RateLimiterDecisionWrapper struct {
 func (x *RateLimiterDecisionWrapper) Reset() {
 	*x = RateLimiterDecisionWrapper{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[2]
+		mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -162,7 +219,7 @@ func (x *RateLimiterDecisionWrapper) String() string {
 func (*RateLimiterDecisionWrapper) ProtoMessage() {}
 
 func (x *RateLimiterDecisionWrapper) ProtoReflect() protoreflect.Message {
-	mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[2]
+	mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +232,7 @@ func (x *RateLimiterDecisionWrapper) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateLimiterDecisionWrapper.ProtoReflect.Descriptor instead.
 func (*RateLimiterDecisionWrapper) Descriptor() ([]byte, []int) {
-	return file_aperture_policy_wrappers_v1_decisions_proto_rawDescGZIP(), []int{2}
+	return file_aperture_policy_wrappers_v1_decisions_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RateLimiterDecisionWrapper) GetCommonAttributes() *CommonAttributes {
@@ -192,6 +249,63 @@ func (x *RateLimiterDecisionWrapper) GetRateLimiterDecision() *v1.RateLimiterDec
 	return nil
 }
 
+type QuotaLimiterDecisionWrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CommonAttributes
+	CommonAttributes *CommonAttributes `protobuf:"bytes,1,opt,name=common_attributes,json=commonAttributes,proto3" json:"common_attributes,omitempty"`
+	// Quota Limiter Decision
+	QuotaLimiterDecision *v1.QuotaLimiterDecision `protobuf:"bytes,2,opt,name=quota_limiter_decision,json=quotaLimiterDecision,proto3" json:"quota_limiter_decision,omitempty"`
+}
+
+func (x *QuotaLimiterDecisionWrapper) Reset() {
+	*x = QuotaLimiterDecisionWrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaLimiterDecisionWrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaLimiterDecisionWrapper) ProtoMessage() {}
+
+func (x *QuotaLimiterDecisionWrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaLimiterDecisionWrapper.ProtoReflect.Descriptor instead.
+func (*QuotaLimiterDecisionWrapper) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_wrappers_v1_decisions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QuotaLimiterDecisionWrapper) GetCommonAttributes() *CommonAttributes {
+	if x != nil {
+		return x.CommonAttributes
+	}
+	return nil
+}
+
+func (x *QuotaLimiterDecisionWrapper) GetQuotaLimiterDecision() *v1.QuotaLimiterDecision {
+	if x != nil {
+		return x.QuotaLimiterDecision
+	}
+	return nil
+}
+
 var File_aperture_policy_wrappers_v1_decisions_proto protoreflect.FileDescriptor
 
 var file_aperture_policy_wrappers_v1_decisions_proto_rawDesc = []byte{
@@ -218,33 +332,63 @@ var file_aperture_policy_wrappers_v1_decisions_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
 	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65, 0x64, 0x44, 0x65,
 	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x68, 0x65, 0x64,
-	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xca, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70,
-	0x65, 0x72, 0x12, 0x5a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf7, 0x01, 0x0a, 0x20, 0x43, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x5a, 0x0a,
+	0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x77, 0x0a, 0x1b, 0x63, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f,
+	0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37,
+	0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x19, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xca, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xdf, 0x01, 0x0a, 0x1a, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x5a,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x15, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x70, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x64, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xe3, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x12, 0x5a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x68, 0x0a,
+	0x16, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x64,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
 	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
-	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x10, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x55,
-	0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x44, 0x65, 0x63,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x44, 0x65, 0x63,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xdf, 0x01, 0x0a, 0x1a, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x61,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x10,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
-	0x12, 0x65, 0x0a, 0x15, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
-	0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x31, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x13, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44,
+	0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x14, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x44,
 	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x97, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e,
 	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
 	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x44, 0x65, 0x63,
@@ -278,28 +422,36 @@ func file_aperture_policy_wrappers_v1_decisions_proto_rawDescGZIP() []byte {
 	return file_aperture_policy_wrappers_v1_decisions_proto_rawDescData
 }
 
-var file_aperture_policy_wrappers_v1_decisions_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_aperture_policy_wrappers_v1_decisions_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_aperture_policy_wrappers_v1_decisions_proto_goTypes = []interface{}{
-	(*LoadShedDecisionWrapper)(nil),    // 0: aperture.policy.wrappers.v1.LoadShedDecisionWrapper
-	(*TokensDecisionWrapper)(nil),      // 1: aperture.policy.wrappers.v1.TokensDecisionWrapper
-	(*RateLimiterDecisionWrapper)(nil), // 2: aperture.policy.wrappers.v1.RateLimiterDecisionWrapper
-	(*CommonAttributes)(nil),           // 3: aperture.policy.wrappers.v1.CommonAttributes
-	(*v1.LoadShedDecision)(nil),        // 4: aperture.policy.decisions.v1.LoadShedDecision
-	(*v1.TokensDecision)(nil),          // 5: aperture.policy.decisions.v1.TokensDecision
-	(*v1.RateLimiterDecision)(nil),     // 6: aperture.policy.decisions.v1.RateLimiterDecision
+	(*LoadShedDecisionWrapper)(nil),          // 0: aperture.policy.wrappers.v1.LoadShedDecisionWrapper
+	(*ConcurrencyBudgetDecisionWrapper)(nil), // 1: aperture.policy.wrappers.v1.ConcurrencyBudgetDecisionWrapper
+	(*TokensDecisionWrapper)(nil),            // 2: aperture.policy.wrappers.v1.TokensDecisionWrapper
+	(*RateLimiterDecisionWrapper)(nil),       // 3: aperture.policy.wrappers.v1.RateLimiterDecisionWrapper
+	(*QuotaLimiterDecisionWrapper)(nil),      // 4: aperture.policy.wrappers.v1.QuotaLimiterDecisionWrapper
+	(*CommonAttributes)(nil),                 // 5: aperture.policy.wrappers.v1.CommonAttributes
+	(*v1.LoadShedDecision)(nil),              // 6: aperture.policy.decisions.v1.LoadShedDecision
+	(*v1.ConcurrencyBudgetDecision)(nil),     // 7: aperture.policy.decisions.v1.ConcurrencyBudgetDecision
+	(*v1.TokensDecision)(nil),                // 8: aperture.policy.decisions.v1.TokensDecision
+	(*v1.RateLimiterDecision)(nil),           // 9: aperture.policy.decisions.v1.RateLimiterDecision
+	(*v1.QuotaLimiterDecision)(nil),          // 10: aperture.policy.decisions.v1.QuotaLimiterDecision
 }
 var file_aperture_policy_wrappers_v1_decisions_proto_depIdxs = []int32{
-	3, // 0: aperture.policy.wrappers.v1.LoadShedDecisionWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
-	4, // 1: aperture.policy.wrappers.v1.LoadShedDecisionWrapper.load_shed_decision:type_name -> aperture.policy.decisions.v1.LoadShedDecision
-	3, // 2: aperture.policy.wrappers.v1.TokensDecisionWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
-	5, // 3: aperture.policy.wrappers.v1.TokensDecisionWrapper.tokens_decision:type_name -> aperture.policy.decisions.v1.TokensDecision
-	3, // 4: aperture.policy.wrappers.v1.RateLimiterDecisionWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
-	6, // 5: aperture.policy.wrappers.v1.RateLimiterDecisionWrapper.rate_limiter_decision:type_name -> aperture.policy.decisions.v1.RateLimiterDecision
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	5,  // 0: aperture.policy.wrappers.v1.LoadShedDecisionWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
+	6,  // 1: aperture.policy.wrappers.v1.LoadShedDecisionWrapper.load_shed_decision:type_name -> aperture.policy.decisions.v1.LoadShedDecision
+	5,  // 2: aperture.policy.wrappers.v1.ConcurrencyBudgetDecisionWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
+	7,  // 3: aperture.policy.wrappers.v1.ConcurrencyBudgetDecisionWrapper.concurrency_budget_decision:type_name -> aperture.policy.decisions.v1.ConcurrencyBudgetDecision
+	5,  // 4: aperture.policy.wrappers.v1.TokensDecisionWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
+	8,  // 5: aperture.policy.wrappers.v1.TokensDecisionWrapper.tokens_decision:type_name -> aperture.policy.decisions.v1.TokensDecision
+	5,  // 6: aperture.policy.wrappers.v1.RateLimiterDecisionWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
+	9,  // 7: aperture.policy.wrappers.v1.RateLimiterDecisionWrapper.rate_limiter_decision:type_name -> aperture.policy.decisions.v1.RateLimiterDecision
+	5,  // 8: aperture.policy.wrappers.v1.QuotaLimiterDecisionWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
+	10, // 9: aperture.policy.wrappers.v1.QuotaLimiterDecisionWrapper.quota_limiter_decision:type_name -> aperture.policy.decisions.v1.QuotaLimiterDecision
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_aperture_policy_wrappers_v1_decisions_proto_init() }
@@ -322,7 +474,7 @@ func file_aperture_policy_wrappers_v1_decisions_proto_init() {
 			}
 		}
 		file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensDecisionWrapper); i {
+			switch v := v.(*ConcurrencyBudgetDecisionWrapper); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -334,6 +486,18 @@ func file_aperture_policy_wrappers_v1_decisions_proto_init() {
 			}
 		}
 		file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensDecisionWrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RateLimiterDecisionWrapper); i {
 			case 0:
 				return &v.state
@@ -345,6 +509,18 @@ func file_aperture_policy_wrappers_v1_decisions_proto_init() {
 				return nil
 			}
 		}
+		file_aperture_policy_wrappers_v1_decisions_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaLimiterDecisionWrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -352,7 +528,7 @@ func file_aperture_policy_wrappers_v1_decisions_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_aperture_policy_wrappers_v1_decisions_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
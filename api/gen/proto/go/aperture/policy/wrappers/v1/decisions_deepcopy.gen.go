@@ -26,6 +26,27 @@ func (in *LoadShedDecisionWrapper) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using ConcurrencyBudgetDecisionWrapper within kubernetes types, where deepcopy-gen is used.
+func (in *ConcurrencyBudgetDecisionWrapper) DeepCopyInto(out *ConcurrencyBudgetDecisionWrapper) {
+	p := proto.Clone(in).(*ConcurrencyBudgetDecisionWrapper)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyBudgetDecisionWrapper. Required by controller-gen.
+func (in *ConcurrencyBudgetDecisionWrapper) DeepCopy() *ConcurrencyBudgetDecisionWrapper {
+	if in == nil {
+		return nil
+	}
+	out := new(ConcurrencyBudgetDecisionWrapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyBudgetDecisionWrapper. Required by controller-gen.
+func (in *ConcurrencyBudgetDecisionWrapper) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using TokensDecisionWrapper within kubernetes types, where deepcopy-gen is used.
 func (in *TokensDecisionWrapper) DeepCopyInto(out *TokensDecisionWrapper) {
 	p := proto.Clone(in).(*TokensDecisionWrapper)
@@ -67,3 +88,24 @@ func (in *RateLimiterDecisionWrapper) DeepCopy() *RateLimiterDecisionWrapper {
 func (in *RateLimiterDecisionWrapper) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
+
+// DeepCopyInto supports using QuotaLimiterDecisionWrapper within kubernetes types, where deepcopy-gen is used.
+func (in *QuotaLimiterDecisionWrapper) DeepCopyInto(out *QuotaLimiterDecisionWrapper) {
+	p := proto.Clone(in).(*QuotaLimiterDecisionWrapper)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimiterDecisionWrapper. Required by controller-gen.
+func (in *QuotaLimiterDecisionWrapper) DeepCopy() *QuotaLimiterDecisionWrapper {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaLimiterDecisionWrapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimiterDecisionWrapper. Required by controller-gen.
+func (in *QuotaLimiterDecisionWrapper) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
@@ -306,6 +306,63 @@ func (x *RateLimiterWrapper) GetRateLimiter() *v1.RateLimiter {
 	return nil
 }
 
+type QuotaLimiterWrapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CommonAttributes
+	CommonAttributes *CommonAttributes `protobuf:"bytes,1,opt,name=common_attributes,json=commonAttributes,proto3" json:"common_attributes,omitempty"`
+	// Quota Limiter
+	QuotaLimiter *v1.QuotaLimiter `protobuf:"bytes,2,opt,name=quota_limiter,json=quotaLimiter,proto3" json:"quota_limiter,omitempty"`
+}
+
+func (x *QuotaLimiterWrapper) Reset() {
+	*x = QuotaLimiterWrapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aperture_policy_wrappers_v1_policy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaLimiterWrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaLimiterWrapper) ProtoMessage() {}
+
+func (x *QuotaLimiterWrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_aperture_policy_wrappers_v1_policy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaLimiterWrapper.ProtoReflect.Descriptor instead.
+func (*QuotaLimiterWrapper) Descriptor() ([]byte, []int) {
+	return file_aperture_policy_wrappers_v1_policy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QuotaLimiterWrapper) GetCommonAttributes() *CommonAttributes {
+	if x != nil {
+		return x.CommonAttributes
+	}
+	return nil
+}
+
+func (x *QuotaLimiterWrapper) GetQuotaLimiter() *v1.QuotaLimiter {
+	if x != nil {
+		return x.QuotaLimiter
+	}
+	return nil
+}
+
 var File_aperture_policy_wrappers_v1_policy_proto protoreflect.FileDescriptor
 
 var file_aperture_policy_wrappers_v1_policy_proto_rawDesc = []byte{
@@ -380,25 +437,37 @@ var file_aperture_policy_wrappers_v1_policy_proto_rawDesc = []byte{
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65,
 	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
 	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x52,
-	0x0b, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x42, 0x94, 0x02, 0x0a,
-	0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
-	0x42, 0x0b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x55, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x75, 0x78,
-	0x6e, 0x69, 0x6e, 0x6a, 0x61, 0x2f, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f,
-	0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f,
-	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x77, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x50, 0x57, 0xaa, 0x02, 0x1b, 0x41,
-	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x57,
-	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1b, 0x41, 0x70, 0x65,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5c, 0x57, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x27, 0x41, 0x70, 0x65, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x5c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5c, 0x57, 0x72, 0x61, 0x70, 0x70,
-	0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x1e, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x3a, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x3a, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0b, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x22, 0xc1, 0x01, 0x0a,
+	0x13, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x10,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x4e, 0x0a, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x72, 0x52, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
+	0x42, 0x94, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x55, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x66, 0x6c, 0x75, 0x78, 0x6e, 0x69, 0x6e, 0x6a, 0x61, 0x2f, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2f, 0x76, 0x31, 0x3b,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x50, 0x57,
+	0xaa, 0x02, 0x1b, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x1b, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5c, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x27, 0x41,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5c, 0x57,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x41, 0x70, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x3a, 0x3a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x3a, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -413,35 +482,39 @@ func file_aperture_policy_wrappers_v1_policy_proto_rawDescGZIP() []byte {
 	return file_aperture_policy_wrappers_v1_policy_proto_rawDescData
 }
 
-var file_aperture_policy_wrappers_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_aperture_policy_wrappers_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_aperture_policy_wrappers_v1_policy_proto_goTypes = []interface{}{
 	(*PolicyWrapper)(nil),             // 0: aperture.policy.wrappers.v1.PolicyWrapper
 	(*FluxMeterWrapper)(nil),          // 1: aperture.policy.wrappers.v1.FluxMeterWrapper
 	(*ClassifierWrapper)(nil),         // 2: aperture.policy.wrappers.v1.ClassifierWrapper
 	(*ConcurrencyLimiterWrapper)(nil), // 3: aperture.policy.wrappers.v1.ConcurrencyLimiterWrapper
 	(*RateLimiterWrapper)(nil),        // 4: aperture.policy.wrappers.v1.RateLimiterWrapper
-	(*CommonAttributes)(nil),          // 5: aperture.policy.wrappers.v1.CommonAttributes
-	(*v1.Policy)(nil),                 // 6: aperture.policy.language.v1.Policy
-	(*v1.FluxMeter)(nil),              // 7: aperture.policy.language.v1.FluxMeter
-	(*v1.Classifier)(nil),             // 8: aperture.policy.language.v1.Classifier
-	(*v1.ConcurrencyLimiter)(nil),     // 9: aperture.policy.language.v1.ConcurrencyLimiter
-	(*v1.RateLimiter)(nil),            // 10: aperture.policy.language.v1.RateLimiter
+	(*QuotaLimiterWrapper)(nil),       // 5: aperture.policy.wrappers.v1.QuotaLimiterWrapper
+	(*CommonAttributes)(nil),          // 6: aperture.policy.wrappers.v1.CommonAttributes
+	(*v1.Policy)(nil),                 // 7: aperture.policy.language.v1.Policy
+	(*v1.FluxMeter)(nil),              // 8: aperture.policy.language.v1.FluxMeter
+	(*v1.Classifier)(nil),             // 9: aperture.policy.language.v1.Classifier
+	(*v1.ConcurrencyLimiter)(nil),     // 10: aperture.policy.language.v1.ConcurrencyLimiter
+	(*v1.RateLimiter)(nil),            // 11: aperture.policy.language.v1.RateLimiter
+	(*v1.QuotaLimiter)(nil),           // 12: aperture.policy.language.v1.QuotaLimiter
 }
 var file_aperture_policy_wrappers_v1_policy_proto_depIdxs = []int32{
-	5,  // 0: aperture.policy.wrappers.v1.PolicyWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
-	6,  // 1: aperture.policy.wrappers.v1.PolicyWrapper.policy:type_name -> aperture.policy.language.v1.Policy
-	7,  // 2: aperture.policy.wrappers.v1.FluxMeterWrapper.flux_meter:type_name -> aperture.policy.language.v1.FluxMeter
-	5,  // 3: aperture.policy.wrappers.v1.ClassifierWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
-	8,  // 4: aperture.policy.wrappers.v1.ClassifierWrapper.classifier:type_name -> aperture.policy.language.v1.Classifier
-	5,  // 5: aperture.policy.wrappers.v1.ConcurrencyLimiterWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
-	9,  // 6: aperture.policy.wrappers.v1.ConcurrencyLimiterWrapper.concurrency_limiter:type_name -> aperture.policy.language.v1.ConcurrencyLimiter
-	5,  // 7: aperture.policy.wrappers.v1.RateLimiterWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
-	10, // 8: aperture.policy.wrappers.v1.RateLimiterWrapper.rate_limiter:type_name -> aperture.policy.language.v1.RateLimiter
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	6,  // 0: aperture.policy.wrappers.v1.PolicyWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
+	7,  // 1: aperture.policy.wrappers.v1.PolicyWrapper.policy:type_name -> aperture.policy.language.v1.Policy
+	8,  // 2: aperture.policy.wrappers.v1.FluxMeterWrapper.flux_meter:type_name -> aperture.policy.language.v1.FluxMeter
+	6,  // 3: aperture.policy.wrappers.v1.ClassifierWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
+	9,  // 4: aperture.policy.wrappers.v1.ClassifierWrapper.classifier:type_name -> aperture.policy.language.v1.Classifier
+	6,  // 5: aperture.policy.wrappers.v1.ConcurrencyLimiterWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
+	10, // 6: aperture.policy.wrappers.v1.ConcurrencyLimiterWrapper.concurrency_limiter:type_name -> aperture.policy.language.v1.ConcurrencyLimiter
+	6,  // 7: aperture.policy.wrappers.v1.RateLimiterWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
+	11, // 8: aperture.policy.wrappers.v1.RateLimiterWrapper.rate_limiter:type_name -> aperture.policy.language.v1.RateLimiter
+	6,  // 9: aperture.policy.wrappers.v1.QuotaLimiterWrapper.common_attributes:type_name -> aperture.policy.wrappers.v1.CommonAttributes
+	12, // 10: aperture.policy.wrappers.v1.QuotaLimiterWrapper.quota_limiter:type_name -> aperture.policy.language.v1.QuotaLimiter
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_aperture_policy_wrappers_v1_policy_proto_init() }
@@ -511,6 +584,18 @@ func file_aperture_policy_wrappers_v1_policy_proto_init() {
 				return nil
 			}
 		}
+		file_aperture_policy_wrappers_v1_policy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaLimiterWrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -518,7 +603,7 @@ func file_aperture_policy_wrappers_v1_policy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_aperture_policy_wrappers_v1_policy_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -109,3 +109,24 @@ func (in *RateLimiterWrapper) DeepCopy() *RateLimiterWrapper {
 func (in *RateLimiterWrapper) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
+
+// DeepCopyInto supports using QuotaLimiterWrapper within kubernetes types, where deepcopy-gen is used.
+func (in *QuotaLimiterWrapper) DeepCopyInto(out *QuotaLimiterWrapper) {
+	p := proto.Clone(in).(*QuotaLimiterWrapper)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimiterWrapper. Required by controller-gen.
+func (in *QuotaLimiterWrapper) DeepCopy() *QuotaLimiterWrapper {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaLimiterWrapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimiterWrapper. Required by controller-gen.
+func (in *QuotaLimiterWrapper) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
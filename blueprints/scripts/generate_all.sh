@@ -31,7 +31,7 @@ $FIND "$blueprints_root"/blueprints -mindepth 1 -maxdepth 1 -type d | while read
 	new_example_yaml=$(cat "$dir"/example/gen/policies/example.yaml)
 	if [[ "$old_example_yaml" != "$new_example_yaml" ]]; then
 		mkdir -p "$dir"/example/gen/graph
-		go run -mod=mod "${blueprints_root}"/../cmd/circuit-compiler/main.go \
+		go run -mod=mod "${blueprints_root}"/../cmd/circuit-compiler \
 			-policy "$dir"/example/gen/policies/example.yaml \
 			-dot "$dir"/example/gen/graph/graph.dot
 		dot -Tsvg "$dir"/example/gen/graph/graph.dot >"$dir"/example/gen/graph/graph.svg
@@ -32,6 +32,7 @@ import (
 	"github.com/fluxninja/aperture/pkg/otelcollector/rollupprocessor"
 	"github.com/fluxninja/aperture/pkg/otelcollector/tracestologsprocessor"
 	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/resources/classifier"
 )
 
 // ModuleForAgentOTEL provides fx options for AgentOTELComponent.
@@ -53,6 +54,7 @@ func AgentOTELComponents(
 	cache *entitycache.EntityCache,
 	promRegistry *prometheus.Registry,
 	engine iface.Engine,
+	classificationEngine *classifier.ClassificationEngine,
 	serverGRPC *grpc.Server,
 ) (component.Factories, error) {
 	var errs error
@@ -96,7 +98,7 @@ func AgentOTELComponents(
 		memorylimiterprocessor.NewFactory(),
 		enrichmentprocessor.NewFactory(cache),
 		rollupprocessor.NewFactory(),
-		metricsprocessor.NewFactory(promRegistry, engine),
+		metricsprocessor.NewFactory(promRegistry, engine, classificationEngine),
 		attributesprocessor.NewFactory(),
 		tracestologsprocessor.NewFactory(),
 	)
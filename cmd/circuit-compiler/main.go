@@ -21,9 +21,15 @@ func main() {
 	// flags:
 	// 1. Required: --policy - path to policy file
 	// 2. Optional: --dot - path to dot file
+	// 3. Optional: --simulate - path to CSV or JSON file with input series, enables simulate mode
+	// 4. Optional: --output - path to CSV file with simulated signal readings, defaults to stdout
+	// 5. Optional: --ticks - number of ticks to simulate, defaults to the length of the longest input series
 	fs := flag.NewFlagSet("circuit-compiler", flag.ExitOnError)
 	policy := fs.String("policy", "", "path to policy file")
 	dot := fs.String("dot", "", "path to dot file")
+	inputs := fs.String("simulate", "", "path to CSV or JSON file with input series, enables simulate mode")
+	output := fs.String("output", "", "path to CSV file with simulated signal readings, defaults to stdout")
+	ticks := fs.Int("ticks", 0, "number of ticks to simulate, defaults to the length of the longest input series")
 	// parse flags
 	err := fs.Parse(os.Args[1:])
 	if err != nil {
@@ -65,6 +71,26 @@ func main() {
 		}
 		log.Info().Msg("DOT file written")
 	}
+
+	// if --simulate flag is set, run the circuit against the input series
+	if *inputs != "" {
+		w := os.Stdout
+		if *output != "" {
+			f, err := os.Create(*output)
+			if err != nil {
+				log.Error().Err(err).Msg("error creating file")
+				os.Exit(1)
+			}
+			defer f.Close()
+			w = f
+		}
+		err = simulate(policyFile, circuit, *inputs, *ticks, w)
+		if err != nil {
+			log.Error().Err(err).Msg("error simulating circuit")
+			os.Exit(1)
+		}
+		log.Info().Msg("Simulation complete")
+	}
 }
 
 func compile(path string) (controlplane.CompiledCircuit, error) {
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane"
+)
+
+// simulate runs the circuit of the policy against the input series and writes signal readings of each tick as CSV.
+func simulate(policyFile string, circuit controlplane.CompiledCircuit, inputsFile string, ticks int, w io.Writer) error {
+	yamlFile, err := os.ReadFile(policyFile)
+	if err != nil {
+		return err
+	}
+	policy := &policylangv1.Policy{}
+	err = config.UnmarshalYAML(yamlFile, policy)
+	if err != nil {
+		return err
+	}
+
+	inputs, err := readSeries(inputsFile)
+	if err != nil {
+		return err
+	}
+	// by default, simulate as many ticks as there are values in the longest input series
+	if ticks <= 0 {
+		for _, values := range inputs {
+			if len(values) > ticks {
+				ticks = len(values)
+			}
+		}
+	}
+
+	signalNames := controlplane.SignalNames(circuit)
+	csvWriter := csv.NewWriter(w)
+	err = csvWriter.Write(append([]string{"tick", "timestamp"}, signalNames...))
+	if err != nil {
+		return err
+	}
+
+	err = controlplane.Simulate(filepath.Base(policyFile), policy, inputs, ticks, func(tick controlplane.SimulationTick) error {
+		record := make([]string, 0, len(signalNames)+2)
+		record = append(record, strconv.Itoa(tick.Tick), tick.Timestamp.Format(time.RFC3339Nano))
+		for _, signalName := range signalNames {
+			reading, ok := tick.Readings[signalName]
+			if ok && reading.Valid() {
+				record = append(record, strconv.FormatFloat(reading.Value(), 'g', -1, 64))
+			} else {
+				record = append(record, "")
+			}
+		}
+		return csvWriter.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// readSeries reads input series from a CSV or JSON file.
+//
+// CSV files have a header row with signal names followed by one row of values per tick.
+// JSON files contain an object that maps signal names to lists of values, one value per tick.
+// Empty CSV cells and JSON null values represent invalid readings.
+func readSeries(path string) (controlplane.SignalSeries, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readCSVSeries(f)
+	case ".json":
+		return readJSONSeries(f)
+	default:
+		return nil, fmt.Errorf("unsupported inputs file extension: %s", filepath.Ext(path))
+	}
+}
+
+func readCSVSeries(r io.Reader) (controlplane.SignalSeries, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing header row in inputs file")
+	}
+	header := records[0]
+	series := make(controlplane.SignalSeries, len(header))
+	for _, record := range records[1:] {
+		for column, signalName := range header {
+			value := math.NaN()
+			if cell := strings.TrimSpace(record[column]); cell != "" {
+				value, err = strconv.ParseFloat(cell, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value for signal %s: %w", signalName, err)
+				}
+			}
+			series[signalName] = append(series[signalName], value)
+		}
+	}
+	return series, nil
+}
+
+func readJSONSeries(r io.Reader) (controlplane.SignalSeries, error) {
+	var raw map[string][]*float64
+	err := json.NewDecoder(r).Decode(&raw)
+	if err != nil {
+		return nil, err
+	}
+	series := make(controlplane.SignalSeries, len(raw))
+	for signalName, values := range raw {
+		series[signalName] = make([]float64, len(values))
+		for tick, value := range values {
+			if value == nil {
+				series[signalName][tick] = math.NaN()
+			} else {
+				series[signalName][tick] = *value
+			}
+		}
+	}
+	return series, nil
+}
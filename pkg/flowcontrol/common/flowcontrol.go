@@ -11,6 +11,8 @@ import (
 	flowcontrolv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/flowcontrol/v1"
 	"github.com/fluxninja/aperture/pkg/entitycache"
 	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/otelcollector"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane"
 	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
 	"github.com/fluxninja/aperture/pkg/policies/dataplane/selectors"
 )
@@ -55,6 +57,7 @@ func (h *Handler) CheckWithValues(
 ) *flowcontrolv1.CheckResponse {
 	checkResponse := h.engine.ProcessRequest(controlPoint, serviceIDs, labels)
 	h.metrics.CheckResponse(checkResponse.DecisionType, checkResponse.GetRejectReason(), checkResponse.GetError())
+	h.metrics.ShadowDrops(checkResponse.GetLimiterDecisions())
 	return checkResponse
 }
 
@@ -88,3 +91,30 @@ func (h *Handler) Check(ctx context.Context, req *flowcontrolv1.CheckRequest) (*
 	resp.End = timestamppb.New(end)
 	return resp, nil
 }
+
+// FlowEnd is the FlowEnd method of Flow Control service which updates workload latency and flux meters
+// of a flow that was previously admitted by Check, and returns the scheduler tokens the flow did not use.
+func (h *Handler) FlowEnd(ctx context.Context, req *flowcontrolv1.FlowEndRequest) (*flowcontrolv1.FlowEndResponse, error) {
+	log.Trace().Msg("FlowControl.FlowEnd()")
+	checkResponse := req.GetCheckResponse()
+	if checkResponse == nil {
+		return &flowcontrolv1.FlowEndResponse{}, nil
+	}
+
+	latency := float64(req.GetDuration().AsDuration()) / float64(time.Millisecond)
+	dataplane.ObserveWorkloadLatency(h.engine, checkResponse, latency)
+	dataplane.ReturnUnusedTokens(h.engine, checkResponse, latency)
+
+	featureStatus := otelcollector.ApertureFeatureStatusOK
+	if req.GetStatus() == flowcontrolv1.FlowEndRequest_STATUS_ERROR {
+		featureStatus = otelcollector.ApertureFeatureStatusError
+	}
+	dataplane.ObserveFluxMeters(h.engine, checkResponse, "", featureStatus, func(attributeKey string) float64 {
+		if attributeKey == otelcollector.WorkloadDurationLabel {
+			return latency
+		}
+		return req.GetAttributes()[attributeKey]
+	})
+
+	return &flowcontrolv1.FlowEndResponse{}, nil
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/fx"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	entitycachev1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/common/entitycache/v1"
 	flowcontrolv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/flowcontrol/v1"
@@ -15,9 +18,13 @@ import (
 	"github.com/fluxninja/aperture/pkg/config"
 	"github.com/fluxninja/aperture/pkg/entitycache"
 	"github.com/fluxninja/aperture/pkg/flowcontrol/common"
+	"github.com/fluxninja/aperture/pkg/metrics"
 	grpcclient "github.com/fluxninja/aperture/pkg/net/grpc"
+	"github.com/fluxninja/aperture/pkg/otelcollector"
 	"github.com/fluxninja/aperture/pkg/platform"
 	"github.com/fluxninja/aperture/pkg/policies/dataplane"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/mocks"
 )
 
 var (
@@ -77,6 +84,150 @@ var _ = Describe("FlowControl Check", func() {
 	})
 })
 
+var _ = Describe("FlowControl FlowEnd", func() {
+	When("flow of a checked request ends", func() {
+		It("accepts flow end of the check response", func() {
+			ctx := peer.NewContext(context.Background(), newFakeRpcPeer())
+			checkResp, err := svc.Check(ctx, &flowcontrolv1.CheckRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			resp, err := svc.FlowEnd(ctx, &flowcontrolv1.FlowEndRequest{
+				CheckResponse: checkResp,
+				Status:        flowcontrolv1.FlowEndRequest_STATUS_OK,
+				Duration:      durationpb.New(10 * time.Millisecond),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).NotTo(BeNil())
+		})
+
+		It("ignores limiters and flux meters that are not registered", func() {
+			resp, err := svc.FlowEnd(context.Background(), &flowcontrolv1.FlowEndRequest{
+				CheckResponse: &flowcontrolv1.CheckResponse{
+					LimiterDecisions: []*flowcontrolv1.LimiterDecision{{
+						PolicyName: "missing",
+						Details: &flowcontrolv1.LimiterDecision_ConcurrencyLimiterInfo_{
+							ConcurrencyLimiterInfo: &flowcontrolv1.LimiterDecision_ConcurrencyLimiterInfo{WorkloadIndex: "0"},
+						},
+					}},
+					FluxMeterInfos: []*flowcontrolv1.FluxMeterInfo{{FluxMeterName: "missing"}},
+				},
+				Status: flowcontrolv1.FlowEndRequest_STATUS_ERROR,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).NotTo(BeNil())
+		})
+	})
+})
+
+var _ = Describe("FlowControl FlowEnd metrics", func() {
+	var (
+		engine            *mocks.MockEngine
+		limiter           *mocks.MockConcurrencyLimiter
+		fluxMeter         *mocks.MockFluxMeter
+		handler           *common.Handler
+		workloadLatencies []float64
+		fluxMeterValues   []float64
+		fluxMeterStatus   string
+		checkResponse     *flowcontrolv1.CheckResponse
+		expectedLimiterID iface.LimiterID
+		observedLabels    map[string]string
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		engine = mocks.NewMockEngine(ctrl)
+		limiter = mocks.NewMockConcurrencyLimiter(ctrl)
+		fluxMeter = mocks.NewMockFluxMeter(ctrl)
+		handler = common.NewHandler(entitycache.NewEntityCache(), common.NopMetrics{}, engine)
+		workloadLatencies = nil
+		fluxMeterValues = nil
+		fluxMeterStatus = ""
+		observedLabels = nil
+		expectedLimiterID = iface.LimiterID{PolicyName: "policy", PolicyHash: "hash", ComponentIndex: 1}
+
+		checkResponse = &flowcontrolv1.CheckResponse{
+			DecisionType: flowcontrolv1.CheckResponse_DECISION_TYPE_ACCEPTED,
+			LimiterDecisions: []*flowcontrolv1.LimiterDecision{{
+				PolicyName:     "policy",
+				PolicyHash:     "hash",
+				ComponentIndex: 1,
+				Details: &flowcontrolv1.LimiterDecision_ConcurrencyLimiterInfo_{
+					ConcurrencyLimiterInfo: &flowcontrolv1.LimiterDecision_ConcurrencyLimiterInfo{
+						WorkloadIndex: "0",
+						Tokens:        50,
+					},
+				},
+			}},
+			FluxMeterInfos: []*flowcontrolv1.FluxMeterInfo{{FluxMeterName: "meter"}},
+			FlowId:         "flow",
+		}
+
+		engine.EXPECT().GetConcurrencyLimiter(expectedLimiterID).Return(limiter).AnyTimes()
+		engine.EXPECT().GetFluxMeter("meter").Return(fluxMeter).AnyTimes()
+		limiter.EXPECT().GetObserver(gomock.Any()).DoAndReturn(func(labels map[string]string) prometheus.Observer {
+			observedLabels = labels
+			return prometheus.ObserverFunc(func(v float64) { workloadLatencies = append(workloadLatencies, v) })
+		}).AnyTimes()
+		fluxMeter.EXPECT().GetHistogram(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ flowcontrolv1.CheckResponse_DecisionType, _ string, featureStatus string) prometheus.Observer {
+				fluxMeterStatus = featureStatus
+				return prometheus.ObserverFunc(func(v float64) { fluxMeterValues = append(fluxMeterValues, v) })
+			}).AnyTimes()
+	})
+
+	It("observes workload latency and flux meter, and returns unused tokens", func() {
+		fluxMeter.EXPECT().GetAttributeKey().Return(otelcollector.WorkloadDurationLabel).AnyTimes()
+		engine.EXPECT().TakeIssuedTokens("flow").Return([]iface.IssuedTokens{{LimiterID: expectedLimiterID, Tokens: 50}})
+		limiter.EXPECT().ReturnTokens(uint64(40)).Times(1)
+
+		_, err := handler.FlowEnd(context.Background(), &flowcontrolv1.FlowEndRequest{
+			CheckResponse: checkResponse,
+			Status:        flowcontrolv1.FlowEndRequest_STATUS_OK,
+			Duration:      durationpb.New(10 * time.Millisecond),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workloadLatencies).To(Equal([]float64{10}))
+		Expect(observedLabels).To(HaveKeyWithValue(metrics.WorkloadIndexLabel, "0"))
+		Expect(fluxMeterValues).To(Equal([]float64{10}))
+		Expect(fluxMeterStatus).To(Equal(otelcollector.ApertureFeatureStatusOK))
+	})
+
+	It("observes flux meter at its attribute and keeps tokens of flows longer than estimated", func() {
+		fluxMeter.EXPECT().GetAttributeKey().Return("bytes").AnyTimes()
+		engine.EXPECT().TakeIssuedTokens("flow").Return([]iface.IssuedTokens{{LimiterID: expectedLimiterID, Tokens: 50}})
+		limiter.EXPECT().ReturnTokens(gomock.Any()).Times(0)
+
+		_, err := handler.FlowEnd(context.Background(), &flowcontrolv1.FlowEndRequest{
+			CheckResponse: checkResponse,
+			Status:        flowcontrolv1.FlowEndRequest_STATUS_ERROR,
+			Duration:      durationpb.New(80 * time.Millisecond),
+			Attributes:    map[string]float64{"bytes": 1024},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workloadLatencies).To(Equal([]float64{80}))
+		Expect(fluxMeterValues).To(Equal([]float64{1024}))
+		Expect(fluxMeterStatus).To(Equal(otelcollector.ApertureFeatureStatusError))
+	})
+
+	It("returns only the tokens the agent issued to the flow", func() {
+		fluxMeter.EXPECT().GetAttributeKey().Return(otelcollector.WorkloadDurationLabel).AnyTimes()
+		gomock.InOrder(
+			engine.EXPECT().TakeIssuedTokens("flow").Return([]iface.IssuedTokens{{LimiterID: expectedLimiterID, Tokens: 50}}),
+			engine.EXPECT().TakeIssuedTokens("flow").Return(nil),
+		)
+		limiter.EXPECT().ReturnTokens(uint64(40)).Times(1)
+		checkResponse.LimiterDecisions[0].GetConcurrencyLimiterInfo().Tokens = 1000
+
+		for i := 0; i < 2; i++ {
+			_, err := handler.FlowEnd(context.Background(), &flowcontrolv1.FlowEndRequest{
+				CheckResponse: checkResponse,
+				Duration:      durationpb.New(10 * time.Millisecond),
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(workloadLatencies).To(Equal([]float64{10, 10}))
+	})
+})
+
 var (
 	hardCodedIPAddress  = "1.2.3.4"
 	hardCodedEntityName = "test-entity"
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	flowcontrolv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/flowcontrol/v1"
@@ -12,6 +14,8 @@ import (
 type Metrics interface {
 	// CheckResponse collects metrics about Aperture Check call with DecisionType and Reason.
 	CheckResponse(flowcontrolv1.CheckResponse_DecisionType, flowcontrolv1.CheckResponse_RejectReason, flowcontrolv1.CheckResponse_Error)
+	// ShadowDrops collects metrics about flows that limiters in shadow mode would have dropped.
+	ShadowDrops([]*flowcontrolv1.LimiterDecision)
 }
 
 // NopMetrics is a no-op implementation of Metrics.
@@ -24,6 +28,10 @@ var _ Metrics = NopMetrics{}
 func (NopMetrics) CheckResponse(flowcontrolv1.CheckResponse_DecisionType, flowcontrolv1.CheckResponse_RejectReason, flowcontrolv1.CheckResponse_Error) {
 }
 
+// ShadowDrops is no-op method for NopMetrics.
+func (NopMetrics) ShadowDrops([]*flowcontrolv1.LimiterDecision) {
+}
+
 // PrometheusMetrics stores collected metrics.
 type PrometheusMetrics struct {
 	registry *prometheus.Registry
@@ -34,6 +42,7 @@ type PrometheusMetrics struct {
 	checkDecision      prometheus.CounterVec
 	error              prometheus.CounterVec
 	rejectReason       prometheus.CounterVec
+	shadowDrops        prometheus.CounterVec
 }
 
 // Ensure PrometheusMetrics implements Metrics interface.
@@ -45,6 +54,7 @@ func (pm *PrometheusMetrics) allMetrics() []prometheus.Collector {
 		pm.checkDecision,
 		pm.error,
 		pm.rejectReason,
+		pm.shadowDrops,
 	}
 }
 
@@ -76,6 +86,12 @@ func NewPrometheusMetrics(registry *prometheus.Registry) (*PrometheusMetrics, er
 				Help: "Number of reject reasons other than unspecified",
 			}, []string{metrics.FlowControlCheckRejectReasonLabel},
 		),
+		shadowDrops: *prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: metrics.FlowControlShadowDropsMetricName,
+				Help: "Number of flows that limiters in shadow mode would have dropped",
+			}, []string{metrics.PolicyNameLabel, metrics.PolicyHashLabel, metrics.ComponentIndexLabel},
+		),
 	}
 
 	for _, m := range pm.allMetrics() {
@@ -100,3 +116,16 @@ func (pm *PrometheusMetrics) CheckResponse(decision flowcontrolv1.CheckResponse_
 		pm.rejectReason.With(prometheus.Labels{metrics.FlowControlCheckRejectReasonLabel: rejectReason.Enum().String()}).Inc()
 	}
 }
+
+// ShadowDrops collects metrics about flows that limiters in shadow mode would have dropped.
+func (pm *PrometheusMetrics) ShadowDrops(decisions []*flowcontrolv1.LimiterDecision) {
+	for _, decision := range decisions {
+		if decision.WouldHaveDropped {
+			pm.shadowDrops.With(prometheus.Labels{
+				metrics.PolicyNameLabel:     decision.PolicyName,
+				metrics.PolicyHashLabel:     decision.PolicyHash,
+				metrics.ComponentIndexLabel: strconv.FormatInt(decision.ComponentIndex, 10),
+			}).Inc()
+		}
+	}
+}
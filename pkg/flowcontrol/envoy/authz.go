@@ -5,11 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	ext_authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/google/uuid"
 	"github.com/open-policy-agent/opa-envoy-plugin/envoyauth"
 	"github.com/open-policy-agent/opa/ast"
 	"github.com/open-policy-agent/opa/logging"
@@ -195,22 +198,46 @@ func (h *Handler) Check(ctx context.Context, req *ext_authz.CheckRequest) (*ext_
 		newFlowLabels[key] = fl
 	}
 
-	// Add new flow labels to baggage
-	newHeaders, err := h.propagator.Inject(newFlowLabels, existingHeaders)
-	if err != nil {
-		log.Sample(zerolog.Sometimes).Warn().Err(err).Msg("Failed to inject baggage into headers")
-	}
-
 	// Make the freshly created flow labels available to flowcontrol.
 	// Newly created flow labels can overwrite existing flow labels.
 	flowlabel.Merge(mergedFlowLabels, newFlowLabels)
 	flowLabels := mergedFlowLabels.ToPlainMap()
+	// Telemetry gets hashed or redacted values of flow labels configured so
+	telemetryFlowLabels := mergedFlowLabels.ToTelemetryMap()
 
 	// Ask flow control service for Ok/Deny
 	checkResponse := h.fcHandler.CheckWithValues(ctx, svcs, ctrlPt, flowLabels)
 	checkResponse.ClassifierInfos = classifierMsgs
+
+	// Flow labels set by limiters (e.g. remaining quota) are propagated like the classified ones
+	if len(checkResponse.LimiterFlowLabels) > 0 && newFlowLabels == nil {
+		newFlowLabels = make(flowlabel.FlowLabels, len(checkResponse.LimiterFlowLabels))
+	}
+	for key, value := range checkResponse.LimiterFlowLabels {
+		newFlowLabels[key] = flowlabel.FlowLabelValue{
+			Value:     sanitizeBaggageHeaderValue(value),
+			Telemetry: true,
+		}
+		flowLabels[key] = value
+		telemetryFlowLabels[key] = value
+	}
+
+	// Add new flow labels to baggage
+	newHeaders, err := h.propagator.Inject(newFlowLabels, existingHeaders)
+	if err != nil {
+		log.Sample(zerolog.Sometimes).Warn().Err(err).Msg("Failed to inject baggage into headers")
+	}
+
 	// Set telemetry_flow_labels in the CheckResponse
-	checkResponse.TelemetryFlowLabels = flowLabels
+	checkResponse.TelemetryFlowLabels = telemetryFlowLabels
+
+	// Response rules may select on request flow labels that are not sent to telemetry
+	if h.classifier.HasResponseRules(svcs, ctrlPt) {
+		if checkResponse.FlowId == "" {
+			checkResponse.FlowId = uuid.NewString()
+		}
+		h.classifier.RecordRequestLabels(checkResponse.FlowId, flowLabels)
+	}
 
 	resp := createExtAuthzResponse(checkResponse)
 
@@ -221,22 +248,29 @@ func (h *Handler) Check(ctx context.Context, req *ext_authz.CheckRequest) (*ext_
 		}
 		resp.HttpResponse = &ext_authz.CheckResponse_OkResponse{
 			OkResponse: &ext_authz.OkHttpResponse{
-				Headers: newHeaders,
+				Headers:              newHeaders,
+				ResponseHeadersToAdd: createHeaderValueOptions(checkResponse.ResponseHeaders),
 			},
 		}
 	} else {
 		resp.Status = &status.Status{
 			Code: int32(code.Code_UNAVAILABLE),
 		}
-		if checkResponse.RejectReason == flowcontrolv1.CheckResponse_REJECT_REASON_RATE_LIMITED {
+		if deniedResponse := checkResponse.GetDeniedResponse(); deniedResponse != nil && deniedResponse.HttpStatusCode != 0 {
+			resp.HttpResponse = &ext_authz.CheckResponse_DeniedResponse{
+				DeniedResponse: createDeniedHTTPResponse(deniedResponse, checkResponse.ResponseHeaders),
+			}
+		} else if checkResponse.RejectReason == flowcontrolv1.CheckResponse_REJECT_REASON_RATE_LIMITED {
 			resp.HttpResponse = &ext_authz.CheckResponse_DeniedResponse{
 				DeniedResponse: &ext_authz.DeniedHttpResponse{
 					Status: &envoy_type.HttpStatus{
 						Code: envoy_type.StatusCode_TooManyRequests,
 					},
+					Headers: createHeaderValueOptions(checkResponse.ResponseHeaders),
 				},
 			}
-		} else if checkResponse.RejectReason == flowcontrolv1.CheckResponse_REJECT_REASON_CONCURRENCY_LIMITED {
+		} else if checkResponse.RejectReason == flowcontrolv1.CheckResponse_REJECT_REASON_CONCURRENCY_LIMITED ||
+			checkResponse.RejectReason == flowcontrolv1.CheckResponse_REJECT_REASON_QUEUE_FULL {
 			resp.HttpResponse = &ext_authz.CheckResponse_DeniedResponse{
 				DeniedResponse: &ext_authz.DeniedHttpResponse{
 					Status: &envoy_type.HttpStatus{
@@ -252,6 +286,46 @@ func (h *Handler) Check(ctx context.Context, req *ext_authz.CheckRequest) (*ext_
 	return resp, nil
 }
 
+// createDeniedHTTPResponse converts the denied response configured by the rejecting limiter to the response sent by envoy.
+// Response headers set by limiters are added unless the denied response configures them already.
+func createDeniedHTTPResponse(deniedResponse *flowcontrolv1.DeniedResponse, responseHeaders map[string]string) *ext_authz.DeniedHttpResponse {
+	headers := make(map[string]string, len(deniedResponse.Headers)+len(responseHeaders))
+	for key, value := range responseHeaders {
+		headers[key] = value
+	}
+	for key, value := range deniedResponse.Headers {
+		headers[key] = value
+	}
+	return &ext_authz.DeniedHttpResponse{
+		Status: &envoy_type.HttpStatus{
+			Code: envoy_type.StatusCode(deniedResponse.HttpStatusCode),
+		},
+		Headers: createHeaderValueOptions(headers),
+		Body:    deniedResponse.Body,
+	}
+}
+
+// createHeaderValueOptions converts headers to the form expected by envoy.
+func createHeaderValueOptions(headers map[string]string) []*envoy_core.HeaderValueOption {
+	if len(headers) == 0 {
+		return nil
+	}
+	options := make([]*envoy_core.HeaderValueOption, 0, len(headers))
+	for key, value := range headers {
+		options = append(options, &envoy_core.HeaderValueOption{
+			Header: &envoy_core.HeaderValue{
+				Key:   key,
+				Value: value,
+			},
+		})
+	}
+	// Sort for a stable response, header order is not otherwise meaningful
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Header.Key < options[j].Header.Key
+	})
+	return options
+}
+
 // Functions below transform our classes/proto to structpb.Value required to be sent
 // via DynamicMetadata
 // "The External Authorization filter supports emitting dynamic metadata as an opaque google.protobuf.Struct."
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	ext_authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/genproto/googleapis/rpc/code"
@@ -57,6 +58,45 @@ func (s *AcceptingHandler) CheckWithValues(
 	return resp
 }
 
+type RejectingHandler struct {
+	common.HandlerWithValues
+}
+
+func (s *RejectingHandler) CheckWithValues(
+	context.Context,
+	[]string,
+	selectors.ControlPoint,
+	map[string]string,
+) *flowcontrolv1.CheckResponse {
+	resp := &flowcontrolv1.CheckResponse{
+		DecisionType: flowcontrolv1.CheckResponse_DECISION_TYPE_REJECTED,
+		RejectReason: flowcontrolv1.CheckResponse_REJECT_REASON_RATE_LIMITED,
+		DeniedResponse: &flowcontrolv1.DeniedResponse{
+			HttpStatusCode: 503,
+			Headers:        map[string]string{"Retry-After": "60"},
+			Body:           "slow down",
+		},
+	}
+	return resp
+}
+
+type DefaultRejectingHandler struct {
+	common.HandlerWithValues
+	rejectReason flowcontrolv1.CheckResponse_RejectReason
+}
+
+func (s *DefaultRejectingHandler) CheckWithValues(
+	context.Context,
+	[]string,
+	selectors.ControlPoint,
+	map[string]string,
+) *flowcontrolv1.CheckResponse {
+	return &flowcontrolv1.CheckResponse{
+		DecisionType: flowcontrolv1.CheckResponse_DECISION_TYPE_REJECTED,
+		RejectReason: s.rejectReason,
+	}
+}
+
 var _ = Describe("Authorization handler", func() {
 	When("it is queried with a request", func() {
 		BeforeEach(func() {
@@ -85,6 +125,43 @@ var _ = Describe("Authorization handler", func() {
 			}).Should(Succeed())
 		})
 	})
+
+	When("the flow is rejected", func() {
+		BeforeEach(func() {
+			classifier = classification.NewClassificationEngine(status.NewRegistry(log.GetGlobalLogger()))
+			handler = envoy.NewHandler(classifier, nil, &RejectingHandler{})
+		})
+		It("returns the configured denied response", func() {
+			ctxWithIp := peer.NewContext(ctx, newFakeRpcPeer())
+			ctxWithIp = metadata.NewIncomingContext(ctxWithIp, metadata.Pairs("traffic-direction", "INBOUND"))
+			resp, err := handler.Check(ctxWithIp, &ext_authz.CheckRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(code.Code(resp.GetStatus().GetCode())).To(Equal(code.Code_UNAVAILABLE))
+			deniedResponse := resp.GetDeniedResponse()
+			Expect(deniedResponse.GetStatus().GetCode()).To(Equal(envoy_type.StatusCode_ServiceUnavailable))
+			Expect(deniedResponse.GetBody()).To(Equal("slow down"))
+			Expect(deniedResponse.GetHeaders()).To(HaveLen(1))
+			Expect(deniedResponse.GetHeaders()[0].GetHeader().GetKey()).To(Equal("Retry-After"))
+			Expect(deniedResponse.GetHeaders()[0].GetHeader().GetValue()).To(Equal("60"))
+		})
+	})
+
+	DescribeTable("the flow is rejected without a configured denied response",
+		func(rejectReason flowcontrolv1.CheckResponse_RejectReason, statusCode envoy_type.StatusCode) {
+			classifier = classification.NewClassificationEngine(status.NewRegistry(log.GetGlobalLogger()))
+			handler = envoy.NewHandler(classifier, nil, &DefaultRejectingHandler{rejectReason: rejectReason})
+			ctxWithIp := peer.NewContext(ctx, newFakeRpcPeer())
+			ctxWithIp = metadata.NewIncomingContext(ctxWithIp, metadata.Pairs("traffic-direction", "INBOUND"))
+			resp, err := handler.Check(ctxWithIp, &ext_authz.CheckRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			deniedResponse := resp.GetDeniedResponse()
+			Expect(deniedResponse.GetStatus().GetCode()).To(Equal(statusCode))
+			Expect(deniedResponse.GetHeaders()).To(BeEmpty())
+		},
+		Entry("rate limited", flowcontrolv1.CheckResponse_REJECT_REASON_RATE_LIMITED, envoy_type.StatusCode_TooManyRequests),
+		Entry("concurrency limited", flowcontrolv1.CheckResponse_REJECT_REASON_CONCURRENCY_LIMITED, envoy_type.StatusCode_ServiceUnavailable),
+		Entry("queue full", flowcontrolv1.CheckResponse_REJECT_REASON_QUEUE_FULL, envoy_type.StatusCode_ServiceUnavailable),
+	)
 })
 
 var service1Selector = selectorv1.Selector{
@@ -80,6 +80,7 @@ func (p Prefixed) Inject(
 			Header: &envoy_core.HeaderValue{
 				Key: baggageKey,
 				// Note: not urlescaping the value – envoy will do it by itself.
+				// Downstream policies get the original value, telemetry transforms apply to telemetry only.
 				Value: fl.Value,
 			},
 			Append: wrapperspb.Bool(false),
@@ -134,6 +135,7 @@ func (b W3Baggage) Inject(
 		if !v.Telemetry {
 			continue
 		}
+		// Downstream policies get the original value, telemetry transforms apply to telemetry only.
 		member, err := otel_baggage.NewMember(k, v.Value)
 		if err != nil {
 			return nil, err
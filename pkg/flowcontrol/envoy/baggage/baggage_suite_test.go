@@ -74,6 +74,21 @@ var _ = Describe("Prefixed propagator", func() {
 			Append: wrapperspb.Bool(false),
 		}))
 	})
+
+	It("injects original values of flow labels transformed for telemetry", func() {
+		newHeaders, err := propagator.Inject(flowlabel.FlowLabels{
+			"user": flowlabel.FlowLabelValue{
+				Value:          "alice",
+				TelemetryValue: "redacted",
+				Telemetry:      true,
+			},
+		}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newHeaders).To(Equal([]*envoy_core.HeaderValueOption{{
+			Header: &envoy_core.HeaderValue{Key: "myprefix-user", Value: "alice"},
+			Append: wrapperspb.Bool(false),
+		}}))
+	})
 })
 
 var _ = Describe("W3 Baggage propagator", func() {
@@ -152,6 +167,21 @@ var _ = Describe("W3 Baggage propagator", func() {
 		})
 	})
 
+	It("injects original values of flow labels transformed for telemetry", func() {
+		newHeaders, err := propagator.Inject(flowlabel.FlowLabels{
+			"user": flowlabel.FlowLabelValue{
+				Value:          "alice",
+				TelemetryValue: "redacted",
+				Telemetry:      true,
+			},
+		}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newHeaders).To(Equal([]*envoy_core.HeaderValueOption{{
+			Header: &envoy_core.HeaderValue{Key: "baggage", Value: "user=alice"},
+			Append: wrapperspb.Bool(false),
+		}}))
+	})
+
 	It("ignores member properties", func() {
 		Expect(propagator.Extract(map[string]string{
 			"baggage": "foo=bar;props",
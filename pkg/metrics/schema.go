@@ -37,6 +37,8 @@ const (
 	WFQFlowsMetricName = "wfq_flows_total"
 	// WFQRequestsMetricName - weighted fair queuing number of requests gauge.
 	WFQRequestsMetricName = "wfq_requests_total"
+	// WFQWorkloadQueuedRequestsMetricName - weighted fair queuing number of queued requests per workload gauge.
+	WFQWorkloadQueuedRequestsMetricName = "wfq_workload_queued_requests"
 	// TokenBucketMetricName - a gauge that tracks the load shed factor.
 	TokenBucketMetricName = "token_bucket_lsf_ratio"
 	// TokenBucketFillRateMetricName - a gauge that tracks the fill rate of token bucket.
@@ -54,6 +56,8 @@ const (
 	FlowControlErrorReasonsMetricName = "flowcontrol_error_reasons_total"
 	// FlowControlRejectReasonsMetricName - metric for reject reason on FCS Check requests.
 	FlowControlRejectReasonsMetricName = "flowcontrol_reject_reasons_total"
+	// FlowControlShadowDropsMetricName - counter for flows that limiters in shadow mode would have dropped.
+	FlowControlShadowDropsMetricName = "flowcontrol_shadow_drops_total"
 
 	// PROMETHEUS LABELS.
 
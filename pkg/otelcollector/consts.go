@@ -46,6 +46,12 @@ const (
 	ApertureWorkloadsLabel = "aperture.workloads"
 	// ApertureDroppingWorkloadsLabel describes workloads dropping the traffic.
 	ApertureDroppingWorkloadsLabel = "aperture.dropping_workloads"
+	// ApertureShadowDroppingRateLimitersLabel describes rate limiters in shadow mode that would have dropped the traffic.
+	ApertureShadowDroppingRateLimitersLabel = "aperture.shadow_dropping_rate_limiters"
+	// ApertureShadowDroppingConcurrencyLimitersLabel describes concurrency limiters in shadow mode that would have dropped the traffic.
+	ApertureShadowDroppingConcurrencyLimitersLabel = "aperture.shadow_dropping_concurrency_limiters"
+	// ApertureShadowDroppingWorkloadsLabel describes workloads of concurrency limiters in shadow mode that would have dropped the traffic.
+	ApertureShadowDroppingWorkloadsLabel = "aperture.shadow_dropping_workloads"
 	// ApertureFluxMetersLabel describes flux meters matched to the traffic.
 	ApertureFluxMetersLabel = "aperture.flux_meters"
 	// ApertureFlowLabelKeysLabel describes keys of flow labels matched to the traffic.
@@ -63,6 +69,8 @@ const (
 	HTTPRequestContentLength = "http.request_content_length"
 	// HTTPResponseContentLength describes length of the HTTP response content in bytes.
 	HTTPResponseContentLength = "http.response_content_length"
+	// HTTPResponseHeaderPrefix is the prefix of attributes carrying response headers, followed by lowercase header name.
+	HTTPResponseHeaderPrefix = "http.response.header."
 
 	/* Labels specific to Envoy. */
 
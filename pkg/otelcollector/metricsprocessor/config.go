@@ -5,11 +5,13 @@ import (
 	"go.opentelemetry.io/collector/config"
 
 	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/resources/classifier"
 )
 
 // Config holds configuration for the metrics processor.
 type Config struct {
 	promRegistry             *prometheus.Registry
 	engine                   iface.Engine
+	classificationEngine     *classifier.ClassificationEngine
 	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 }
@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/collector/processor/processorhelper"
 
 	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/resources/classifier"
 )
 
 const (
@@ -18,20 +19,21 @@ const (
 )
 
 // NewFactory returns a new factory for the metrics processor.
-func NewFactory(promRegistry *prometheus.Registry, engine iface.Engine) component.ProcessorFactory {
+func NewFactory(promRegistry *prometheus.Registry, engine iface.Engine, classificationEngine *classifier.ClassificationEngine) component.ProcessorFactory {
 	return component.NewProcessorFactory(
 		typeStr,
-		createDefaultConfig(promRegistry, engine),
+		createDefaultConfig(promRegistry, engine, classificationEngine),
 		component.WithLogsProcessor(createLogsProcessor, component.StabilityLevelInDevelopment),
 	)
 }
 
-func createDefaultConfig(promRegistry *prometheus.Registry, engine iface.Engine) component.ProcessorCreateDefaultConfigFunc {
+func createDefaultConfig(promRegistry *prometheus.Registry, engine iface.Engine, classificationEngine *classifier.ClassificationEngine) component.ProcessorCreateDefaultConfigFunc {
 	return func() config.Processor {
 		return &Config{
-			ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
-			promRegistry:      promRegistry,
-			engine:            engine,
+			ProcessorSettings:    config.NewProcessorSettings(config.NewComponentID(typeStr)),
+			promRegistry:         promRegistry,
+			engine:               engine,
+			classificationEngine: classificationEngine,
 		}
 	}
 }
@@ -15,7 +15,9 @@ import (
 	"github.com/fluxninja/aperture/pkg/log"
 	"github.com/fluxninja/aperture/pkg/metrics"
 	"github.com/fluxninja/aperture/pkg/otelcollector"
-	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/resources/classifier"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/selectors"
 	"github.com/rs/zerolog"
 )
 
@@ -87,6 +89,9 @@ func (p *metricsProcessor) ConsumeLogs(ctx context.Context, ld plog.Logs) (plog.
 			return retErr("aperture source label not recognized")
 		}
 
+		// Classify the response before the include list drops its attributes
+		responseFlowLabels := p.classifyResponse(attributes, checkResponse)
+
 		addCheckResponseBasedLabels(attributes, checkResponse, sourceStr)
 
 		// Update metrics and enforce include list to eliminate any excess attributes
@@ -100,12 +105,87 @@ func (p *metricsProcessor) ConsumeLogs(ctx context.Context, ld plog.Logs) (plog.
 
 		// Add dynamic Flow labels
 		addFlowLabels(attributes, checkResponse)
+		for key, value := range responseFlowLabels {
+			attributes.PutString(key, value)
+		}
 
 		return nil
 	})
 	return ld, err
 }
 
+// classifyResponse creates flow labels from the response attributes according to the response rules of classifiers.
+// Flux meters matching the flow once these labels are known are added to checkResponse.
+func (p *metricsProcessor) classifyResponse(attributes pcommon.Map, checkResponse *flowcontrolv1.CheckResponse) map[string]string {
+	if p.cfg.classificationEngine == nil {
+		return nil
+	}
+
+	response := classifier.ResponseAttributes{
+		Headers: make(map[string]string),
+	}
+	attributes.Range(func(key string, value pcommon.Value) bool {
+		if key == otelcollector.HTTPStatusCodeLabel {
+			response.StatusCode = value.AsString()
+		} else if strings.HasPrefix(key, otelcollector.HTTPResponseHeaderPrefix) {
+			response.Headers[strings.ToLower(strings.TrimPrefix(key, otelcollector.HTTPResponseHeaderPrefix))] = value.AsString()
+		}
+		return true
+	})
+	if response.StatusCode == otelcollector.EnvoyMissingAttributeValue {
+		response.StatusCode = ""
+	}
+	for header, value := range response.Headers {
+		if value == otelcollector.EnvoyMissingAttributeValue || value == "" {
+			delete(response.Headers, header)
+		}
+	}
+
+	controlPointInfo := checkResponse.GetControlPointInfo()
+	controlPoint := selectors.NewControlPoint(controlPointInfo.GetType(), controlPointInfo.GetFeature())
+	// Match on all request flow labels when the agent recorded them, not only the ones sent to telemetry
+	var requestLabels map[string]string
+	if flowID := checkResponse.GetFlowId(); flowID != "" {
+		requestLabels = p.cfg.classificationEngine.TakeRequestLabels(flowID)
+	}
+	if requestLabels == nil {
+		requestLabels = checkResponse.GetTelemetryFlowLabels()
+	}
+
+	flowLabels := p.cfg.classificationEngine.ClassifyResponse(checkResponse.GetServices(), controlPoint, requestLabels, response)
+	if len(flowLabels) == 0 {
+		return nil
+	}
+
+	labels := make(map[string]string, len(requestLabels)+len(flowLabels))
+	for key, value := range requestLabels {
+		labels[key] = value
+	}
+	responseLabels := make(map[string]string, len(flowLabels))
+	for key, value := range flowLabels {
+		labels[key] = value.Value
+		responseLabels[key] = value.Value
+	}
+
+	// Flux meters with selectors using the response labels could not be matched when the request was checked
+	matchedFluxMeters := make(map[string]bool, len(checkResponse.FluxMeterInfos))
+	for _, fluxMeterInfo := range checkResponse.FluxMeterInfos {
+		matchedFluxMeters[fluxMeterInfo.GetFluxMeterName()] = true
+	}
+	for _, fluxMeter := range p.cfg.engine.GetMatchingFluxMeters(controlPoint, checkResponse.GetServices(), labels) {
+		fluxMeterName := fluxMeter.GetFluxMeterName()
+		if matchedFluxMeters[fluxMeterName] {
+			continue
+		}
+		matchedFluxMeters[fluxMeterName] = true
+		checkResponse.FluxMeterInfos = append(checkResponse.FluxMeterInfos, &flowcontrolv1.FluxMeterInfo{
+			FluxMeterName: fluxMeterName,
+		})
+	}
+
+	return responseLabels
+}
+
 func addSDKSpecificLabels(attributes pcommon.Map) {
 	// Compute durations
 	flowStart, flowStartExists := getSDKLabelTimestampValue(attributes, otelcollector.ApertureFlowStartTimestampLabel)
@@ -182,8 +262,13 @@ func getLabelValue(attributes pcommon.Map, labelKey, source string) (pcommon.Val
 // * `decision_reason`
 // * `rate_limiters`
 // * `dropping_rate_limiters`
+// * `shadow_dropping_rate_limiters`
 // * `concurrency_limiters`
 // * `dropping_concurrency_limiters`
+// * `shadow_dropping_concurrency_limiters`
+// * `workloads`
+// * `dropping_workloads`
+// * `shadow_dropping_workloads`
 // * `flux_meters`.
 // * `flow_label_keys`.
 // * `classifiers`.
@@ -206,19 +291,22 @@ func addCheckResponseBasedLabels(attributes pcommon.Map, checkResponse *flowcont
 	attributes.PutString(otelcollector.ApertureControlPointLabel, checkResponse.GetControlPointInfo().String())
 
 	labels := map[string]pcommon.Value{
-		otelcollector.ApertureRateLimitersLabel:                pcommon.NewValueSlice(),
-		otelcollector.ApertureDroppingRateLimitersLabel:        pcommon.NewValueSlice(),
-		otelcollector.ApertureConcurrencyLimitersLabel:         pcommon.NewValueSlice(),
-		otelcollector.ApertureDroppingConcurrencyLimitersLabel: pcommon.NewValueSlice(),
-		otelcollector.ApertureWorkloadsLabel:                   pcommon.NewValueSlice(),
-		otelcollector.ApertureDroppingWorkloadsLabel:           pcommon.NewValueSlice(),
-		otelcollector.ApertureFluxMetersLabel:                  pcommon.NewValueSlice(),
-		otelcollector.ApertureFlowLabelKeysLabel:               pcommon.NewValueSlice(),
-		otelcollector.ApertureClassifiersLabel:                 pcommon.NewValueSlice(),
-		otelcollector.ApertureClassifierErrorsLabel:            pcommon.NewValueSlice(),
-		otelcollector.ApertureDecisionTypeLabel:                pcommon.NewValueString(checkResponse.DecisionType.String()),
-		otelcollector.ApertureRejectReasonLabel:                pcommon.NewValueString(checkResponse.GetRejectReason().String()),
-		otelcollector.ApertureErrorLabel:                       pcommon.NewValueString(checkResponse.GetError().String()),
+		otelcollector.ApertureRateLimitersLabel:                      pcommon.NewValueSlice(),
+		otelcollector.ApertureDroppingRateLimitersLabel:              pcommon.NewValueSlice(),
+		otelcollector.ApertureConcurrencyLimitersLabel:               pcommon.NewValueSlice(),
+		otelcollector.ApertureDroppingConcurrencyLimitersLabel:       pcommon.NewValueSlice(),
+		otelcollector.ApertureWorkloadsLabel:                         pcommon.NewValueSlice(),
+		otelcollector.ApertureDroppingWorkloadsLabel:                 pcommon.NewValueSlice(),
+		otelcollector.ApertureShadowDroppingRateLimitersLabel:        pcommon.NewValueSlice(),
+		otelcollector.ApertureShadowDroppingConcurrencyLimitersLabel: pcommon.NewValueSlice(),
+		otelcollector.ApertureShadowDroppingWorkloadsLabel:           pcommon.NewValueSlice(),
+		otelcollector.ApertureFluxMetersLabel:                        pcommon.NewValueSlice(),
+		otelcollector.ApertureFlowLabelKeysLabel:                     pcommon.NewValueSlice(),
+		otelcollector.ApertureClassifiersLabel:                       pcommon.NewValueSlice(),
+		otelcollector.ApertureClassifierErrorsLabel:                  pcommon.NewValueSlice(),
+		otelcollector.ApertureDecisionTypeLabel:                      pcommon.NewValueString(checkResponse.DecisionType.String()),
+		otelcollector.ApertureRejectReasonLabel:                      pcommon.NewValueString(checkResponse.GetRejectReason().String()),
+		otelcollector.ApertureErrorLabel:                             pcommon.NewValueString(checkResponse.GetError().String()),
 	}
 	for _, decision := range checkResponse.LimiterDecisions {
 		if decision.GetRateLimiterInfo() != nil {
@@ -232,6 +320,9 @@ func addCheckResponseBasedLabels(attributes pcommon.Map, checkResponse *flowcont
 			if decision.Dropped {
 				labels[otelcollector.ApertureDroppingRateLimitersLabel].SliceVal().AppendEmpty().SetStringVal(value)
 			}
+			if decision.WouldHaveDropped {
+				labels[otelcollector.ApertureShadowDroppingRateLimitersLabel].SliceVal().AppendEmpty().SetStringVal(value)
+			}
 		}
 		if cl := decision.GetConcurrencyLimiterInfo(); cl != nil {
 			rawValue := []string{
@@ -244,6 +335,9 @@ func addCheckResponseBasedLabels(attributes pcommon.Map, checkResponse *flowcont
 			if decision.Dropped {
 				labels[otelcollector.ApertureDroppingConcurrencyLimitersLabel].SliceVal().AppendEmpty().SetStringVal(value)
 			}
+			if decision.WouldHaveDropped {
+				labels[otelcollector.ApertureShadowDroppingConcurrencyLimitersLabel].SliceVal().AppendEmpty().SetStringVal(value)
+			}
 
 			workloadsRawValue := []string{
 				fmt.Sprintf("%s:%v", metrics.PolicyNameLabel, decision.GetPolicyName()),
@@ -256,6 +350,9 @@ func addCheckResponseBasedLabels(attributes pcommon.Map, checkResponse *flowcont
 			if decision.Dropped {
 				labels[otelcollector.ApertureDroppingWorkloadsLabel].SliceVal().AppendEmpty().SetStringVal(value)
 			}
+			if decision.WouldHaveDropped {
+				labels[otelcollector.ApertureShadowDroppingWorkloadsLabel].SliceVal().AppendEmpty().SetStringVal(value)
+			}
 		}
 	}
 	for _, fluxMeter := range checkResponse.FluxMeterInfos {
@@ -307,29 +404,10 @@ func (p *metricsProcessor) updateMetrics(
 	if checkResponse == nil {
 		return
 	}
-	if len(checkResponse.LimiterDecisions) > 0 {
-		// Update workload metrics
-		latency, _ := otelcollector.GetFloat64(attributes, otelcollector.WorkloadDurationLabel, []string{})
-		for _, decision := range checkResponse.LimiterDecisions {
-			if cl := decision.GetConcurrencyLimiterInfo(); cl != nil {
-				labels := map[string]string{
-					metrics.PolicyNameLabel:     decision.PolicyName,
-					metrics.PolicyHashLabel:     decision.PolicyHash,
-					metrics.ComponentIndexLabel: fmt.Sprintf("%d", decision.ComponentIndex),
-					metrics.DecisionTypeLabel:   checkResponse.DecisionType.String(),
-					metrics.WorkloadIndexLabel:  cl.GetWorkloadIndex(),
-				}
-
-				limiterID := iface.LimiterID{
-					PolicyName:     decision.PolicyName,
-					PolicyHash:     decision.PolicyHash,
-					ComponentIndex: decision.ComponentIndex,
-				}
-
-				p.updateMetricsForWorkload(limiterID, labels, latency)
-			} // TODO: add rate limiter metrics
-		}
-	}
+	// Update workload metrics
+	latency, _ := otelcollector.GetFloat64(attributes, otelcollector.WorkloadDurationLabel, []string{})
+	dataplane.ObserveWorkloadLatency(p.cfg.engine, checkResponse, latency)
+	dataplane.ReturnUnusedTokens(p.cfg.engine, checkResponse, latency)
 
 	if len(checkResponse.FluxMeterInfos) > 0 {
 		// Update flux meter metrics
@@ -343,52 +421,11 @@ func (p *metricsProcessor) updateMetrics(
 		if exists {
 			featureStatusStr = featureStatus.StringVal()
 		}
-		for _, fluxMeter := range checkResponse.FluxMeterInfos {
-			p.updateMetricsForFluxMeters(fluxMeter, checkResponse.DecisionType, statusCodeStr, featureStatusStr, attributes, treatAsZero)
-		}
-	}
-}
-
-func (p *metricsProcessor) updateMetricsForWorkload(limiterID iface.LimiterID, labels map[string]string, latency float64) {
-	limiter := p.cfg.engine.GetConcurrencyLimiter(limiterID)
-	if limiter == nil {
-		log.Sample(zerolog.Sometimes).Warn().
-			Str(metrics.PolicyNameLabel, limiterID.PolicyName).
-			Str(metrics.PolicyHashLabel, limiterID.PolicyHash).
-			Int64(metrics.ComponentIndexLabel, limiterID.ComponentIndex).
-			Msg("ConcurrencyLimiter not found")
-		return
-	}
-	latencyHistogram := limiter.GetObserver(labels)
-	if latencyHistogram != nil {
-		latencyHistogram.Observe(latency)
-	}
-}
-
-func (p *metricsProcessor) updateMetricsForFluxMeters(
-	fluxMeterMessage *flowcontrolv1.FluxMeterInfo,
-	decisionType flowcontrolv1.CheckResponse_DecisionType,
-	statusCode string,
-	featureStatus string,
-	attributes pcommon.Map,
-	treatAsZero []string,
-) {
-	fluxMeter := p.cfg.engine.GetFluxMeter(fluxMeterMessage.FluxMeterName)
-	if fluxMeter == nil {
-		log.Sample(zerolog.Sometimes).Warn().Str(metrics.FluxMeterNameLabel, fluxMeterMessage.GetFluxMeterName()).
-			Str(metrics.DecisionTypeLabel, decisionType.String()).
-			Str(metrics.StatusCodeLabel, statusCode).
-			Str(metrics.FeatureStatusLabel, featureStatus).
-			Msg("FluxMeter not found")
-		return
-	}
-
-	// metricValue is the value at fluxMeter's AttributeKey
-	metricValue, _ := otelcollector.GetFloat64(attributes, fluxMeter.GetAttributeKey(), treatAsZero)
-
-	fluxMeterHistogram := fluxMeter.GetHistogram(decisionType, statusCode, featureStatus)
-	if fluxMeterHistogram != nil {
-		fluxMeterHistogram.Observe(metricValue)
+		dataplane.ObserveFluxMeters(p.cfg.engine, checkResponse, statusCodeStr, featureStatusStr, func(attributeKey string) float64 {
+			// metricValue is the value at fluxMeter's AttributeKey
+			metricValue, _ := otelcollector.GetFloat64(attributes, attributeKey, treatAsZero)
+			return metricValue
+		})
 	}
 }
 
@@ -410,6 +447,9 @@ var (
 		otelcollector.ApertureDroppingConcurrencyLimitersLabel,
 		otelcollector.ApertureWorkloadsLabel,
 		otelcollector.ApertureDroppingWorkloadsLabel,
+		otelcollector.ApertureShadowDroppingRateLimitersLabel,
+		otelcollector.ApertureShadowDroppingConcurrencyLimitersLabel,
+		otelcollector.ApertureShadowDroppingWorkloadsLabel,
 		otelcollector.ApertureFluxMetersLabel,
 		otelcollector.ApertureFlowLabelKeysLabel,
 		otelcollector.ApertureClassifiersLabel,
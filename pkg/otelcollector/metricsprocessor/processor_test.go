@@ -13,10 +13,18 @@ import (
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/component-base/metrics/testutil"
 
+	selectorv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/common/selector/v1"
 	flowcontrolv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/flowcontrol/v1"
+	languagev1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	wrappersv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/wrappers/v1"
+	"github.com/fluxninja/aperture/pkg/log"
 	"github.com/fluxninja/aperture/pkg/metrics"
 	"github.com/fluxninja/aperture/pkg/otelcollector"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/resources/classifier"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/selectors"
 	"github.com/fluxninja/aperture/pkg/policies/mocks"
+	"github.com/fluxninja/aperture/pkg/status"
 )
 
 var _ = Describe("Metrics Processor", func() {
@@ -50,6 +58,7 @@ var _ = Describe("Metrics Processor", func() {
 		processor, err = newProcessor(cfg)
 		Expect(err).NotTo(HaveOccurred())
 		engine.EXPECT().GetConcurrencyLimiter(gomock.Any()).Return(nil).AnyTimes()
+		engine.EXPECT().TakeIssuedTokens(gomock.Any()).Return(nil).AnyTimes()
 	})
 
 	DescribeTable("Processing logs",
@@ -188,6 +197,45 @@ var _ = Describe("Metrics Processor", func() {
 			},
 		),
 
+		Entry("record with shadow mode limiter",
+			&flowcontrolv1.CheckResponse{
+				ControlPointInfo: &flowcontrolv1.ControlPointInfo{
+					Type:    flowcontrolv1.ControlPointInfo_TYPE_FEATURE,
+					Feature: "featureX",
+				},
+				DecisionType: flowcontrolv1.CheckResponse_DECISION_TYPE_ACCEPTED,
+				LimiterDecisions: []*flowcontrolv1.LimiterDecision{
+					{
+						PolicyName:       "foo",
+						PolicyHash:       "foo-hash",
+						ComponentIndex:   1,
+						WouldHaveDropped: true,
+						Details: &flowcontrolv1.LimiterDecision_ConcurrencyLimiterInfo_{
+							ConcurrencyLimiterInfo: &flowcontrolv1.LimiterDecision_ConcurrencyLimiterInfo{
+								WorkloadIndex: "0",
+							},
+						},
+					},
+				},
+				FluxMeterInfos: []*flowcontrolv1.FluxMeterInfo{},
+				FlowLabelKeys:  []string{},
+			},
+			nil,
+			`# HELP workload_latency_ms Latency summary of workload
+			# TYPE workload_latency_ms summary
+			workload_latency_ms_sum{component_index="1",decision_type="DECISION_TYPE_ACCEPTED",policy_hash="foo-hash",policy_name="foo",workload_index="0"} 5
+			workload_latency_ms_count{component_index="1",decision_type="DECISION_TYPE_ACCEPTED",policy_hash="foo-hash",policy_name="foo",workload_index="0"} 1
+			`,
+			map[string]interface{}{
+				otelcollector.ApertureDecisionTypeLabel:                      "DECISION_TYPE_ACCEPTED",
+				otelcollector.ApertureConcurrencyLimitersLabel:               []interface{}{"policy_name:foo,component_index:1,policy_hash:foo-hash"},
+				otelcollector.ApertureDroppingConcurrencyLimitersLabel:       []interface{}{},
+				otelcollector.ApertureShadowDroppingConcurrencyLimitersLabel: []interface{}{"policy_name:foo,component_index:1,policy_hash:foo-hash"},
+				otelcollector.ApertureDroppingWorkloadsLabel:                 []interface{}{},
+				otelcollector.ApertureShadowDroppingWorkloadsLabel:           []interface{}{"policy_name:foo,component_index:1,workload_index:0,policy_hash:foo-hash"},
+			},
+		),
+
 		Entry("record with two policies",
 			&flowcontrolv1.CheckResponse{
 				ControlPointInfo: &flowcontrolv1.ControlPointInfo{
@@ -271,6 +319,139 @@ var _ = Describe("Metrics Processor", func() {
 	)
 })
 
+var _ = Describe("Metrics Processor with response rules", func() {
+	var (
+		ctrl                 *gomock.Controller
+		engine               *mocks.MockEngine
+		classificationEngine *classifier.ClassificationEngine
+		processor            *metricsProcessor
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		engine = mocks.NewMockEngine(ctrl)
+		engine.EXPECT().TakeIssuedTokens(gomock.Any()).Return(nil).AnyTimes()
+		classificationEngine = classifier.NewClassificationEngine(status.NewRegistry(log.GetGlobalLogger()))
+		_, err := classificationEngine.AddRules(context.Background(), "test", &wrappersv1.ClassifierWrapper{
+			Classifier: &languagev1.Classifier{
+				Selector: &selectorv1.Selector{
+					FlowSelector: &selectorv1.FlowSelector{
+						ControlPoint: &selectorv1.ControlPoint{
+							Controlpoint: &selectorv1.ControlPoint_Traffic{
+								Traffic: "ingress",
+							},
+						},
+					},
+				},
+				ResponseRules: map[string]*languagev1.ResponseRule{
+					"cache": {From: "response.http.headers.x-cache"},
+				},
+			},
+			CommonAttributes: &wrappersv1.CommonAttributes{
+				PolicyName: "test",
+				PolicyHash: "test",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		processor, err = newProcessor(&Config{
+			engine:               engine,
+			classificationEngine: classificationEngine,
+			promRegistry:         prometheus.NewRegistry(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("adds response flow labels and flux meters matching them", func() {
+		checkResponse := &flowcontrolv1.CheckResponse{
+			ControlPointInfo: &flowcontrolv1.ControlPointInfo{
+				Type: flowcontrolv1.ControlPointInfo_TYPE_INGRESS,
+			},
+			DecisionType: flowcontrolv1.CheckResponse_DECISION_TYPE_ACCEPTED,
+			Services:     []string{"my-service.default.svc.cluster.local"},
+			FluxMeterInfos: []*flowcontrolv1.FluxMeterInfo{
+				{
+					FluxMeterName: "bar",
+				},
+			},
+			TelemetryFlowLabels: map[string]string{"user": "alice"},
+		}
+		cacheFluxMeter := mocks.NewMockFluxMeter(ctrl)
+		cacheFluxMeter.EXPECT().GetFluxMeterName().Return("cache-meter").AnyTimes()
+		barFluxMeter := mocks.NewMockFluxMeter(ctrl)
+		barFluxMeter.EXPECT().GetFluxMeterName().Return("bar").AnyTimes()
+		engine.EXPECT().GetMatchingFluxMeters(
+			gomock.Any(),
+			checkResponse.Services,
+			map[string]string{"user": "alice", "cache": "HIT"},
+		).Return([]iface.FluxMeter{barFluxMeter, cacheFluxMeter})
+		engine.EXPECT().GetFluxMeter("cache-meter").Return(nil)
+
+		logs := someLogs(engine, checkResponse)
+		logRecord := allLogRecords(logs)[0]
+		logRecord.Attributes().InsertString(otelcollector.HTTPResponseHeaderPrefix+"x-cache", "HIT")
+
+		_, err := processor.ConsumeLogs(context.Background(), logs)
+		Expect(err).NotTo(HaveOccurred())
+
+		attributes := logRecord.Attributes().AsRaw()
+		Expect(attributes).To(HaveKeyWithValue("cache", "HIT"))
+		Expect(attributes).To(HaveKeyWithValue("user", "alice"))
+		Expect(attributes).To(HaveKeyWithValue(otelcollector.ApertureFluxMetersLabel, []interface{}{"bar", "cache-meter"}))
+		Expect(attributes).NotTo(HaveKey(otelcollector.HTTPResponseHeaderPrefix + "x-cache"))
+	})
+
+	It("matches flux meters on request flow labels not sent to telemetry", func() {
+		Expect(classificationEngine.HasResponseRules(nil, selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""))).To(BeTrue())
+		classificationEngine.RecordRequestLabels("flow", map[string]string{"user": "alice", "tier": "gold"})
+		checkResponse := &flowcontrolv1.CheckResponse{
+			ControlPointInfo: &flowcontrolv1.ControlPointInfo{
+				Type: flowcontrolv1.ControlPointInfo_TYPE_INGRESS,
+			},
+			DecisionType:        flowcontrolv1.CheckResponse_DECISION_TYPE_ACCEPTED,
+			FlowId:              "flow",
+			TelemetryFlowLabels: map[string]string{"user": "alice"},
+		}
+		tierFluxMeter := mocks.NewMockFluxMeter(ctrl)
+		tierFluxMeter.EXPECT().GetFluxMeterName().Return("tier-cache-meter").AnyTimes()
+		engine.EXPECT().GetMatchingFluxMeters(
+			gomock.Any(),
+			gomock.Any(),
+			map[string]string{"user": "alice", "tier": "gold", "cache": "MISS"},
+		).Return([]iface.FluxMeter{tierFluxMeter})
+		engine.EXPECT().GetFluxMeter("tier-cache-meter").Return(nil)
+
+		logs := someLogs(engine, checkResponse)
+		logRecord := allLogRecords(logs)[0]
+		logRecord.Attributes().InsertString(otelcollector.HTTPResponseHeaderPrefix+"x-cache", "MISS")
+
+		_, err := processor.ConsumeLogs(context.Background(), logs)
+		Expect(err).NotTo(HaveOccurred())
+
+		attributes := logRecord.Attributes().AsRaw()
+		Expect(attributes).To(HaveKeyWithValue("cache", "MISS"))
+		Expect(attributes).NotTo(HaveKey("tier"))
+		Expect(attributes).To(HaveKeyWithValue(otelcollector.ApertureFluxMetersLabel, []interface{}{"tier-cache-meter"}))
+		Expect(classificationEngine.TakeRequestLabels("flow")).To(BeNil())
+	})
+
+	It("skips missing response headers", func() {
+		checkResponse := &flowcontrolv1.CheckResponse{
+			ControlPointInfo: &flowcontrolv1.ControlPointInfo{
+				Type: flowcontrolv1.ControlPointInfo_TYPE_INGRESS,
+			},
+			DecisionType: flowcontrolv1.CheckResponse_DECISION_TYPE_ACCEPTED,
+		}
+
+		logs := someLogs(engine, checkResponse)
+		logRecord := allLogRecords(logs)[0]
+		logRecord.Attributes().InsertString(otelcollector.HTTPResponseHeaderPrefix+"x-cache", otelcollector.EnvoyMissingAttributeValue)
+
+		_, err := processor.ConsumeLogs(context.Background(), logs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(logRecord.Attributes().AsRaw()).NotTo(HaveKey("cache"))
+	})
+})
+
 // someLogs will return a plog.Logs instance with single LogRecord
 func someLogs(
 	engine *mocks.MockEngine,
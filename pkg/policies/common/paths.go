@@ -14,6 +14,8 @@ var (
 	ClassifiersPath = path.Join(ConfigPrefix, "classifiers")
 	// LoadShedDecisionsPath is decision path in etcd for load shed decisions.
 	LoadShedDecisionsPath = path.Join(DecisionsPrefix, "load_shed")
+	// ConcurrencyBudgetDecisionsPath is decision path in etcd for concurrency budget decisions.
+	ConcurrencyBudgetDecisionsPath = path.Join(DecisionsPrefix, "concurrency_budget")
 	// AutoTokenResultsPath is config path in etcd for query tokens.
 	AutoTokenResultsPath = path.Join(ConfigPrefix, "tokens")
 	// ConcurrencyLimiterConfigPath is config path in etcd for concurrency limiter.
@@ -24,8 +26,16 @@ var (
 	RateLimiterDecisionsPath = path.Join(DecisionsPrefix, "rate_limiter")
 	// RateLimiterDynamicConfigPath is config path in etcd for dynamic config of rate limiter.
 	RateLimiterDynamicConfigPath = path.Join(ConfigPrefix, "rate_limiter_dynamic_config")
+	// QuotaLimiterConfigPath is config path in etcd for quota limiter.
+	QuotaLimiterConfigPath = path.Join(ConfigPrefix, "quota_limiter")
+	// QuotaLimiterDecisionsPath is decision path in etcd for quota limiter decisions.
+	QuotaLimiterDecisionsPath = path.Join(DecisionsPrefix, "quota_limiter")
+	// QuotaLimiterCountersPath is path in etcd where agents persist the counters of quota limiters.
+	QuotaLimiterCountersPath = path.Join("/quota_limiter_counters")
 	// FluxMeterConfigPath is config path in etcd for flux meters.
 	FluxMeterConfigPath = path.Join(ConfigPrefix, "flux_meter")
+	// TelemetryHashKeyPath is path in etcd of the secret keying the hash telemetry transform of flow labels.
+	TelemetryHashKeyPath = path.Join("/telemetry_hash_key")
 )
 
 // AgentGroupPrefix returns the prefix for an agent group.
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	flowcontrolv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/flowcontrol/v1"
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+)
+
+// RetryAfterHeader is the header telling clients of rejected flows how long to back off.
+const RetryAfterHeader = "Retry-After"
+
+// NewDeniedResponse creates the response for the clients of flows rejected by a limiter.
+// The status code falls back to defaultStatusCode and the Retry-After header, unless configured, is set to retryAfter rounded up to seconds.
+// It returns nil if no rejection response is configured, so that the integrations keep their default responses.
+func NewDeniedResponse(rejectionResponse *policylangv1.RejectionResponse, defaultStatusCode uint32, retryAfter time.Duration) *flowcontrolv1.DeniedResponse {
+	if rejectionResponse == nil {
+		return nil
+	}
+	deniedResponse := &flowcontrolv1.DeniedResponse{
+		HttpStatusCode: rejectionResponse.GetHttpStatusCode(),
+		Headers:        make(map[string]string, len(rejectionResponse.GetHeaders())+1),
+		Body:           rejectionResponse.GetBody(),
+	}
+	if deniedResponse.HttpStatusCode == 0 {
+		deniedResponse.HttpStatusCode = defaultStatusCode
+	}
+
+	hasRetryAfter := false
+	for key, value := range rejectionResponse.GetHeaders() {
+		deniedResponse.Headers[key] = value
+		if strings.EqualFold(key, RetryAfterHeader) {
+			hasRetryAfter = true
+		}
+	}
+	if !hasRetryAfter && retryAfter > 0 {
+		seconds := int64(math.Ceil(retryAfter.Seconds()))
+		deniedResponse.Headers[RetryAfterHeader] = strconv.FormatInt(seconds, 10)
+	}
+	return deniedResponse
+}
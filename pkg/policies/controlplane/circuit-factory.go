@@ -217,6 +217,15 @@ func compileCircuit(
 		}
 	}
 
+	// Make sure the components can be executed in a fixed order, i.e. any remaining cycles are broken by looped signals
+	compWithPortsList := make([]runtime.CompiledComponentAndPorts, 0, len(compiledCircuit))
+	for _, compiledComp := range compiledCircuit {
+		compWithPortsList = append(compWithPortsList, compiledComp.CompiledComponentAndPorts)
+	}
+	if _, err := runtime.ExecutionOrder(compWithPortsList); err != nil {
+		return nil, fx.Options(), err
+	}
+
 	// Log compiledCircuit
 	for compIndex, compiledComp := range compiledCircuit {
 		logger.Trace().Msgf("compIndex: %d, compiledComp: %+v", compIndex, compiledComp)
@@ -7,6 +7,7 @@ import (
 
 	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
 	"github.com/fluxninja/aperture/pkg/policies/controlplane/components"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/components/actuators/quota"
 	"github.com/fluxninja/aperture/pkg/policies/controlplane/components/actuators/rate"
 	"github.com/fluxninja/aperture/pkg/policies/controlplane/components/controller"
 	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
@@ -44,6 +45,15 @@ func NewComponentAndOptions(
 			Name:          "Gradient",
 			ComponentType: runtime.ComponentTypeSignalProcessor,
 		}, nil, option, err
+	} else if pidController := componentProto.GetPidController(); pidController != nil {
+		component, option, err := controller.NewPIDControllerAndOptions(pidController, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(pidController, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "PIDController",
+			ComponentType: runtime.ComponentTypeSignalProcessor,
+		}, nil, option, err
 	} else if limiter := componentProto.GetRateLimiter(); limiter != nil {
 		component, option, err := rate.NewRateLimiterAndOptions(limiter, componentIndex, policyReadAPI)
 		mapStruct, err := encodeMapStructOnNilErr(limiter, err)
@@ -53,6 +63,15 @@ func NewComponentAndOptions(
 			Name:          "RateLimiter",
 			ComponentType: runtime.ComponentTypeSink,
 		}, nil, option, err
+	} else if limiter := componentProto.GetQuotaLimiter(); limiter != nil {
+		component, option, err := quota.NewQuotaLimiterAndOptions(limiter, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(limiter, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "QuotaLimiter",
+			ComponentType: runtime.ComponentTypeSink,
+		}, nil, option, err
 	} else if ema := componentProto.GetEma(); ema != nil {
 		component, option, err := components.NewEMAAndOptions(ema, componentIndex, policyReadAPI)
 		mapStruct, err := encodeMapStructOnNilErr(ema, err)
@@ -62,6 +81,15 @@ func NewComponentAndOptions(
 			Name:          "EMA",
 			ComponentType: runtime.ComponentTypeSignalProcessor,
 		}, nil, option, err
+	} else if holtWinters := componentProto.GetHoltWinters(); holtWinters != nil {
+		component, option, err := components.NewHoltWintersAndOptions(holtWinters, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(holtWinters, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "HoltWinters",
+			ComponentType: runtime.ComponentTypeSignalProcessor,
+		}, nil, option, err
 	} else if arithmeticCombinator := componentProto.GetArithmeticCombinator(); arithmeticCombinator != nil {
 		component, option, err := components.NewArithmeticCombinatorAndOptions(arithmeticCombinator, componentIndex, policyReadAPI)
 		mapStruct, err := encodeMapStructOnNilErr(arithmeticCombinator, err)
@@ -89,6 +117,24 @@ func NewComponentAndOptions(
 			Name:          "Constant",
 			ComponentType: runtime.ComponentTypeSource,
 		}, nil, option, err
+	} else if schedule := componentProto.GetSchedule(); schedule != nil {
+		component, option, err := components.NewScheduleAndOptions(schedule, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(schedule, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "Schedule",
+			ComponentType: runtime.ComponentTypeSource,
+		}, nil, option, err
+	} else if variable := componentProto.GetVariable(); variable != nil {
+		component, option, err := components.NewVariableAndOptions(variable, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(variable, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "Variable",
+			ComponentType: runtime.ComponentTypeSource,
+		}, nil, option, err
 	} else if decider := componentProto.GetDecider(); decider != nil {
 		component, option, err := components.NewDeciderAndOptions(decider, componentIndex, policyReadAPI)
 		mapStruct, err := encodeMapStructOnNilErr(decider, err)
@@ -143,6 +189,60 @@ func NewComponentAndOptions(
 			Name:          "Extrapolator",
 			ComponentType: runtime.ComponentTypeSignalProcessor,
 		}, nil, option, err
+	} else if integrator := componentProto.GetIntegrator(); integrator != nil {
+		component, option, err := components.NewIntegratorAndOptions(integrator, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(integrator, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "Integrator",
+			ComponentType: runtime.ComponentTypeSignalProcessor,
+		}, nil, option, err
+	} else if differentiator := componentProto.GetDifferentiator(); differentiator != nil {
+		component, option, err := components.NewDifferentiatorAndOptions(differentiator, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(differentiator, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "Differentiator",
+			ComponentType: runtime.ComponentTypeSignalProcessor,
+		}, nil, option, err
+	} else if delta := componentProto.GetDelta(); delta != nil {
+		component, option, err := components.NewDeltaAndOptions(delta, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(delta, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "Delta",
+			ComponentType: runtime.ComponentTypeSignalProcessor,
+		}, nil, option, err
+	} else if holder := componentProto.GetHolder(); holder != nil {
+		component, option, err := components.NewHolderAndOptions(holder, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(holder, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "Holder",
+			ComponentType: runtime.ComponentTypeSignalProcessor,
+		}, nil, option, err
+	} else if firstValid := componentProto.GetFirstValid(); firstValid != nil {
+		component, option, err := components.NewFirstValidAndOptions(firstValid, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(firstValid, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "FirstValid",
+			ComponentType: runtime.ComponentTypeSignalProcessor,
+		}, nil, option, err
+	} else if inverter := componentProto.GetInverter(); inverter != nil {
+		component, option, err := components.NewInverterAndOptions(inverter, componentIndex, policyReadAPI)
+		mapStruct, err := encodeMapStructOnNilErr(inverter, err)
+		return runtime.CompiledComponent{
+			Component:     component,
+			MapStruct:     mapStruct,
+			Name:          "Inverter",
+			ComponentType: runtime.ComponentTypeSignalProcessor,
+		}, nil, option, err
 	} else {
 		// Try Component Stack Factory
 		return newComponentStackAndOptions(componentProto, componentIndex, policyReadAPI)
@@ -78,6 +78,26 @@ func newComponentStackAndOptions(
 			// Append loadShedActuator options
 			options = append(options, loadShedActuatorOptions)
 		}
+		if concurrencyBudgetActuatorProto := concurrencyLimiterProto.GetConcurrencyBudgetActuator(); concurrencyBudgetActuatorProto != nil {
+			concurrencyBudgetActuator, concurrencyBudgetActuatorOptions, concurrencyBudgetActuatorErr := concurrency.NewConcurrencyBudgetActuatorAndOptions(concurrencyBudgetActuatorProto, componentStackIndex, policyReadAPI, agentGroupName)
+			if concurrencyBudgetActuatorErr != nil {
+				return runtime.CompiledComponent{}, nil, nil, concurrencyBudgetActuatorErr
+			}
+			concurrencyBudgetActuatorMapStruct, err := encodeMapStruct(concurrencyBudgetActuatorProto)
+			if err != nil {
+				return runtime.CompiledComponent{}, nil, nil, err
+			}
+			// Append concurrencyBudgetActuator as a runtime.CompiledComponent
+			compiledComponents = append(compiledComponents, runtime.CompiledComponent{
+				Component:     concurrencyBudgetActuator,
+				MapStruct:     concurrencyBudgetActuatorMapStruct,
+				Name:          "ConcurrencyBudgetActuator",
+				ComponentType: runtime.ComponentTypeSink,
+			})
+
+			// Append concurrencyBudgetActuator options
+			options = append(options, concurrencyBudgetActuatorOptions)
+		}
 
 		concurrencyLimiterMapStruct, err := encodeMapStruct(concurrencyLimiterProto)
 		if err != nil {
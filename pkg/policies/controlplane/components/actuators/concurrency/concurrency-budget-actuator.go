@@ -0,0 +1,132 @@
+package concurrency
+
+import (
+	"context"
+	"path"
+
+	"github.com/rs/zerolog"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
+
+	policydecisionsv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/decisions/v1"
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	wrappersv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/wrappers/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	etcdclient "github.com/fluxninja/aperture/pkg/etcd/client"
+	etcdwriter "github.com/fluxninja/aperture/pkg/etcd/writer"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/common"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// ConcurrencyBudgetActuator struct.
+type ConcurrencyBudgetActuator struct {
+	policyReadAPI  iface.Policy
+	decision       *policydecisionsv1.ConcurrencyBudgetDecision
+	etcdPath       string
+	writer         *etcdwriter.Writer
+	agentGroupName string
+	componentIndex int
+}
+
+// NewConcurrencyBudgetActuatorAndOptions creates concurrency budget actuator and its fx options.
+func NewConcurrencyBudgetActuatorAndOptions(
+	_ *policylangv1.ConcurrencyBudgetActuator,
+	componentIndex int,
+	policyReadAPI iface.Policy,
+	agentGroupName string,
+) (runtime.Component, fx.Option, error) {
+	etcdPath := path.Join(common.ConcurrencyBudgetDecisionsPath,
+		common.DataplaneComponentKey(agentGroupName, policyReadAPI.GetPolicyName(), int64(componentIndex)))
+	cba := &ConcurrencyBudgetActuator{
+		policyReadAPI:  policyReadAPI,
+		agentGroupName: agentGroupName,
+		componentIndex: componentIndex,
+		etcdPath:       etcdPath,
+	}
+	// Nothing is published until the first reading, so the budget starts as unknown
+	cba.decision = &policydecisionsv1.ConcurrencyBudgetDecision{
+		ConcurrencyBudget: -1,
+	}
+
+	return cba, fx.Options(
+		fx.Invoke(cba.setupWriter),
+	), nil
+}
+
+func (cba *ConcurrencyBudgetActuator) setupWriter(etcdClient *etcdclient.Client, lifecycle fx.Lifecycle) error {
+	logger := cba.policyReadAPI.GetStatusRegistry().GetLogger()
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			cba.writer = etcdwriter.NewWriter(etcdClient, true)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			_, err := etcdClient.KV.Delete(clientv3.WithRequireLeader(ctx), cba.etcdPath)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to delete concurrency budget decision config")
+				return err
+			}
+			cba.writer.Close()
+			return nil
+		},
+	})
+
+	return nil
+}
+
+// Execute implements runtime.Component.Execute.
+func (cba *ConcurrencyBudgetActuator) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	// Get the decision from the port
+	budget, ok := inPortReadings["concurrency_budget"]
+	if ok {
+		if len(budget) > 0 {
+			budgetReading := budget[0]
+			var budgetValue float64
+			if !budgetReading.Valid() {
+				// Unknown budget, the agents stop limiting
+				budgetValue = -1
+			} else {
+				if budgetReading.Value() <= 0 {
+					budgetValue = 0
+				} else {
+					budgetValue = budgetReading.Value()
+				}
+			}
+			return nil, cba.publishConcurrencyBudget(budgetValue)
+		}
+	}
+	return nil, nil
+}
+
+// DynamicConfigUpdate is a no-op for concurrency budget actuator.
+func (cba *ConcurrencyBudgetActuator) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+}
+
+func (cba *ConcurrencyBudgetActuator) publishConcurrencyBudget(concurrencyBudget float64) error {
+	logger := cba.policyReadAPI.GetStatusRegistry().GetLogger()
+	// Publish only if there's a change
+	if cba.decision.GetConcurrencyBudget() != concurrencyBudget {
+		// Save concurrency budget in decision message
+		cba.decision.ConcurrencyBudget = concurrencyBudget
+		// Publish decision
+		logger.Sample(zerolog.Often).Debug().Float64("concurrencyBudget", concurrencyBudget).Msg("Publish concurrency budget decision")
+		wrapper := &wrappersv1.ConcurrencyBudgetDecisionWrapper{
+			ConcurrencyBudgetDecision: cba.decision,
+			CommonAttributes: &wrappersv1.CommonAttributes{
+				PolicyName:     cba.policyReadAPI.GetPolicyName(),
+				PolicyHash:     cba.policyReadAPI.GetPolicyHash(),
+				ComponentIndex: int64(cba.componentIndex),
+			},
+		}
+		dat, err := proto.Marshal(wrapper)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to marshal policy decision")
+			return err
+		}
+		cba.writer.Write(cba.etcdPath, dat)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path"
 	"reflect"
+	"strconv"
 	"time"
 
 	prometheusmodel "github.com/prometheus/common/model"
@@ -41,6 +42,10 @@ type Scheduler struct {
 	acceptedQuery *components.ScalarQuery
 	// Prometheus query for incoming concurrency
 	incomingQuery *components.ScalarQuery
+	// Prometheus query for queued requests
+	queuedQuery *components.ScalarQuery
+	// Prometheus query for queued requests by workload index
+	workloadQueuedQuery *components.TaggedQuery
 	// Prometheus query for tokens based on ms latency
 	tokensQuery *components.TaggedQuery
 
@@ -50,6 +55,8 @@ type Scheduler struct {
 	agentGroupName   string
 	etcdPath         string
 	componentIndex   int
+	// number of workloads, excluding the default workload
+	workloadCount int
 }
 
 // NewSchedulerAndOptions creates scheduler and its fx options.
@@ -71,6 +78,7 @@ func NewSchedulerAndOptions(
 		componentIndex:  componentIndex,
 		tokensPromValue: nil,
 		etcdPath:        etcdPath,
+		workloadCount:   len(schedulerProto.Workloads),
 	}
 
 	// Prepare parameters for prometheus queries
@@ -111,6 +119,35 @@ func NewSchedulerAndOptions(
 	}
 	scheduler.incomingQuery = incomingQuery
 
+	queuedQuery, queuedQueryOptions, queuedQueryErr := components.NewScalarQueryAndOptions(
+		fmt.Sprintf("sum(%s{%s})",
+			metrics.WFQWorkloadQueuedRequestsMetricName,
+			policyParams),
+		concurrencyQueryInterval,
+		componentIndex,
+		policyReadAPI,
+		"QueuedRequests",
+	)
+	if queuedQueryErr != nil {
+		return nil, nil, queuedQueryErr
+	}
+	scheduler.queuedQuery = queuedQuery
+
+	workloadQueuedQuery, workloadQueuedQueryOptions, workloadQueuedQueryErr := components.NewTaggedQueryAndOptions(
+		fmt.Sprintf("sum by (%s) (%s{%s})",
+			metrics.WorkloadIndexLabel,
+			metrics.WFQWorkloadQueuedRequestsMetricName,
+			policyParams),
+		concurrencyQueryInterval,
+		componentIndex,
+		policyReadAPI,
+		"WorkloadQueuedRequests",
+	)
+	if workloadQueuedQueryErr != nil {
+		return nil, nil, workloadQueuedQueryErr
+	}
+	scheduler.workloadQueuedQuery = workloadQueuedQuery
+
 	// add decision_type filter to the params
 	autoTokensPolicyParams := policyParams + ",decision_type!=\"DECISION_TYPE_REJECTED\""
 	if schedulerProto.AutoTokens {
@@ -135,6 +172,8 @@ func NewSchedulerAndOptions(
 			fx.Options(
 				acceptedQueryOptions,
 				incomingQueryOptions,
+				queuedQueryOptions,
+				workloadQueuedQueryOptions,
 				tokensQueryOptions,
 				fx.Invoke(scheduler.setupWriter),
 			),
@@ -144,6 +183,8 @@ func NewSchedulerAndOptions(
 			fx.Options(
 				acceptedQueryOptions,
 				incomingQueryOptions,
+				queuedQueryOptions,
+				workloadQueuedQueryOptions,
 				fx.Invoke(scheduler.setupWriter),
 			),
 			nil
@@ -211,7 +252,7 @@ func (s *Scheduler) Execute(inPortReadings runtime.PortToValue, tickInfo runtime
 		}
 	}
 
-	var acceptedReading, incomingReading runtime.Reading
+	var acceptedReading, incomingReading, queuedReading runtime.Reading
 
 	outPortReadings := make(runtime.PortToValue)
 
@@ -237,9 +278,49 @@ func (s *Scheduler) Execute(inPortReadings runtime.PortToValue, tickInfo runtime
 	}
 	outPortReadings["incoming_concurrency"] = []runtime.Reading{incomingReading}
 
+	queuedValue, err := s.queuedQuery.ExecuteScalarQuery(tickInfo)
+	if err != nil {
+		queuedReading = runtime.InvalidReading()
+		if err != components.ErrNoQueriesReturned {
+			errMulti = multierr.Append(errMulti, err)
+		}
+	} else {
+		queuedReading = runtime.NewReading(queuedValue)
+	}
+	outPortReadings["queued_requests"] = []runtime.Reading{queuedReading}
+
+	workloadQueuedValue, err := s.workloadQueuedQuery.ExecutePromQuery(tickInfo)
+	if err != nil {
+		if err != components.ErrNoQueriesReturned {
+			errMulti = multierr.Append(errMulti, err)
+		}
+	} else if vector, ok := workloadQueuedValue.(prometheusmodel.Vector); ok {
+		outPortReadings["workload_queued_requests"] = s.workloadQueuedReadings(vector)
+	} else {
+		errMulti = multierr.Append(errMulti, fmt.Errorf("workload queued requests query returned a non-vector value"))
+	}
+
 	return outPortReadings, errMulti
 }
 
+// workloadQueuedReadings returns the queued requests of each workload in the order of workloads.
+// Workloads missing in the vector have no requests queued.
+func (s *Scheduler) workloadQueuedReadings(vector prometheusmodel.Vector) []runtime.Reading {
+	readings := make([]runtime.Reading, s.workloadCount)
+	for i := range readings {
+		readings[i] = runtime.NewReading(0)
+	}
+	for _, sample := range vector {
+		workloadIndex, err := strconv.Atoi(string(sample.Metric[metrics.WorkloadIndexLabel]))
+		if err != nil || workloadIndex < 0 || workloadIndex >= s.workloadCount {
+			// default workload
+			continue
+		}
+		readings[workloadIndex] = runtime.NewReading(float64(sample.Value))
+	}
+	return readings
+}
+
 // DynamicConfigUpdate is a no-op for this component.
 func (s *Scheduler) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {}
 
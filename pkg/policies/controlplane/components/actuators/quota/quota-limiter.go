@@ -0,0 +1,165 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/fx"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/proto"
+
+	policydecisionsv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/decisions/v1"
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	wrappersv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/wrappers/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	etcdclient "github.com/fluxninja/aperture/pkg/etcd/client"
+	etcdwriter "github.com/fluxninja/aperture/pkg/etcd/writer"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/common"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+type quotaLimiterSync struct {
+	policyReadAPI     iface.Policy
+	quotaLimiterProto *policylangv1.QuotaLimiter
+	decision          *policydecisionsv1.QuotaLimiterDecision
+	configEtcdPath    string
+	decisionsEtcdPath string
+	decisionWriter    *etcdwriter.Writer
+	agentGroupName    string
+	componentIndex    int
+}
+
+// NewQuotaLimiterAndOptions creates fx options for QuotaLimiter.
+func NewQuotaLimiterAndOptions(
+	quotaLimiterProto *policylangv1.QuotaLimiter,
+	componentIndex int,
+	policyReadAPI iface.Policy,
+) (runtime.Component, fx.Option, error) {
+	// Get the agent group name.
+	selectorProto := quotaLimiterProto.GetSelector()
+	if selectorProto == nil {
+		return nil, fx.Options(), errors.New("selector is nil")
+	}
+	// Catch invalid time zones before the config reaches the agents
+	if _, err := time.LoadLocation(quotaLimiterProto.GetTimeZone()); err != nil {
+		return nil, fx.Options(), fmt.Errorf("invalid time zone: %w", err)
+	}
+	agentGroupName := selectorProto.ServiceSelector.GetAgentGroup()
+	componentID := common.DataplaneComponentKey(agentGroupName, policyReadAPI.GetPolicyName(), int64(componentIndex))
+	configEtcdPath := path.Join(common.QuotaLimiterConfigPath, componentID)
+	decisionsEtcdPath := path.Join(common.QuotaLimiterDecisionsPath, componentID)
+
+	limiterSync := &quotaLimiterSync{
+		quotaLimiterProto: quotaLimiterProto,
+		decision:          &policydecisionsv1.QuotaLimiterDecision{},
+		policyReadAPI:     policyReadAPI,
+		configEtcdPath:    configEtcdPath,
+		decisionsEtcdPath: decisionsEtcdPath,
+		componentIndex:    componentIndex,
+		agentGroupName:    agentGroupName,
+	}
+	return limiterSync, fx.Options(
+		fx.Invoke(
+			limiterSync.setupSync,
+		),
+	), nil
+}
+
+func (limiterSync *quotaLimiterSync) setupSync(etcdClient *etcdclient.Client, lifecycle fx.Lifecycle) error {
+	logger := limiterSync.policyReadAPI.GetStatusRegistry().GetLogger()
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			wrapper := &wrappersv1.QuotaLimiterWrapper{
+				QuotaLimiter: limiterSync.quotaLimiterProto,
+				CommonAttributes: &wrappersv1.CommonAttributes{
+					PolicyName:     limiterSync.policyReadAPI.GetPolicyName(),
+					PolicyHash:     limiterSync.policyReadAPI.GetPolicyHash(),
+					ComponentIndex: int64(limiterSync.componentIndex),
+				},
+			}
+			dat, err := proto.Marshal(wrapper)
+			if err != nil {
+				logger.Error().Err(err).Msg("failed to marshal quota limiter config")
+				return err
+			}
+			_, err = etcdClient.KV.Put(clientv3.WithRequireLeader(ctx),
+				limiterSync.configEtcdPath, string(dat), clientv3.WithLease(etcdClient.LeaseID))
+			if err != nil {
+				logger.Error().Err(err).Msg("failed to put quota limiter config")
+				return err
+			}
+			limiterSync.decisionWriter = etcdwriter.NewWriter(etcdClient, true)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			var merr, err error
+			limiterSync.decisionWriter.Close()
+			_, err = etcdClient.KV.Delete(clientv3.WithRequireLeader(ctx), limiterSync.configEtcdPath)
+			if err != nil {
+				logger.Error().Err(err).Msg("failed to delete quota limiter config")
+				merr = multierr.Append(merr, err)
+			}
+			_, err = etcdClient.KV.Delete(clientv3.WithRequireLeader(ctx), limiterSync.decisionsEtcdPath)
+			if err != nil {
+				logger.Error().Err(err).Msg("failed to delete quota limiter decisions")
+				merr = multierr.Append(merr, err)
+			}
+			return merr
+		},
+	})
+	return nil
+}
+
+// Execute implements runtime.Component.Execute.
+func (limiterSync *quotaLimiterSync) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	readings, ok := inPortReadings["limit"]
+	if !ok || len(readings) == 0 {
+		return nil, nil
+	}
+	limitValue := -1.0 // no limit is applied
+	if reading := readings[0]; reading.Valid() {
+		limitValue = reading.Value()
+	}
+	return nil, limiterSync.publishDecision(&policydecisionsv1.QuotaLimiterDecision{
+		Limit: limitValue,
+	})
+}
+
+func (limiterSync *quotaLimiterSync) publishDecision(decision *policydecisionsv1.QuotaLimiterDecision) error {
+	logger := limiterSync.policyReadAPI.GetStatusRegistry().GetLogger()
+	// Publish only if there's a change
+	if !proto.Equal(limiterSync.decision, decision) {
+		// Save the decision
+		limiterSync.decision = decision
+		// Publish decision
+		logger.Debug().Float64("limit", decision.GetLimit()).Msg("publishing quota limiter decision")
+		wrapper := &wrappersv1.QuotaLimiterDecisionWrapper{
+			QuotaLimiterDecision: limiterSync.decision,
+			CommonAttributes: &wrappersv1.CommonAttributes{
+				PolicyName:     limiterSync.policyReadAPI.GetPolicyName(),
+				PolicyHash:     limiterSync.policyReadAPI.GetPolicyHash(),
+				ComponentIndex: int64(limiterSync.componentIndex),
+			},
+		}
+		dat, err := proto.Marshal(wrapper)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to marshal quota limiter decision")
+			return err
+		}
+		if limiterSync.decisionWriter == nil {
+			logger.Panic().Msg("decision writer is nil")
+		}
+		limiterSync.decisionWriter.Write(limiterSync.decisionsEtcdPath, dat)
+	}
+	return nil
+}
+
+// DynamicConfigUpdate is a no-op for quota limiter.
+func (limiterSync *quotaLimiterSync) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+}
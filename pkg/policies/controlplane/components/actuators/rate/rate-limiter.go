@@ -46,6 +46,14 @@ func NewRateLimiterAndOptions(
 	if selectorProto == nil {
 		return nil, fx.Options(), errors.New("selector is nil")
 	}
+	// Either the limit or the token bucket fill rate drives the ratelimiter.
+	inPorts := rateLimiterProto.GetInPorts()
+	if (inPorts.GetLimit() == nil) == (inPorts.GetFillRate() == nil) {
+		return nil, fx.Options(), errors.New("exactly one of limit and fill_rate in_ports must be set")
+	}
+	if rateLimiterProto.GetLocal() && inPorts.GetFillRate() == nil {
+		return nil, fx.Options(), errors.New("local rate limiting is supported only in token bucket mode")
+	}
 	agentGroupName := selectorProto.ServiceSelector.GetAgentGroup()
 	componentID := common.DataplaneComponentKey(agentGroupName, policyReadAPI.GetPolicyName(), int64(componentIndex))
 	configEtcdPath := path.Join(common.RateLimiterConfigPath, componentID)
@@ -123,33 +131,59 @@ func (limiterSync *rateLimiterSync) setupSync(etcdClient *etcdclient.Client, lif
 
 // Execute implements runtime.Component.Execute.
 func (limiterSync *rateLimiterSync) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
-	limit, ok := inPortReadings["limit"]
-	if !ok {
-		return nil, nil
+	if limiterSync.rateLimiterProto.GetInPorts().GetFillRate() != nil {
+		fillRate, ok := readLimitPort(inPortReadings, "fill_rate")
+		if !ok {
+			return nil, nil
+		}
+		bucketCapacity, ok := readLimitPort(inPortReadings, "bucket_capacity")
+		// bucket capacity defaults to the fill rate
+		if !ok || bucketCapacity < 0 {
+			bucketCapacity = fillRate
+		}
+		if fillRate < 0 {
+			bucketCapacity = -1.0 // no limit is applied
+		}
+		return nil, limiterSync.publishDecision(&policydecisionsv1.RateLimiterDecision{
+			FillRate:       fillRate,
+			BucketCapacity: bucketCapacity,
+		})
 	}
 
-	if len(limit) == 0 {
+	limitValue, ok := readLimitPort(inPortReadings, "limit")
+	if !ok {
 		return nil, nil
 	}
+	return nil, limiterSync.publishDecision(&policydecisionsv1.RateLimiterDecision{
+		Limit: limitValue,
+	})
+}
 
-	limitReading := limit[0]
-	var limitValue float64
-	if !limitReading.Valid() {
-		limitValue = -1.0 // no limit is applied
-	} else {
-		limitValue = limitReading.Value()
+// readLimitPort returns the reading of the port, with -1 meaning no limit for invalid readings. The second return value is false if the port has no readings.
+func readLimitPort(inPortReadings runtime.PortToValue, port string) (float64, bool) {
+	readings, ok := inPortReadings[port]
+	if !ok || len(readings) == 0 {
+		return 0, false
+	}
+	reading := readings[0]
+	if !reading.Valid() {
+		return -1.0, true // no limit is applied
 	}
-	return nil, limiterSync.publishLimit(limitValue)
+	return reading.Value(), true
 }
 
-func (limiterSync *rateLimiterSync) publishLimit(limitValue float64) error {
+func (limiterSync *rateLimiterSync) publishDecision(decision *policydecisionsv1.RateLimiterDecision) error {
 	logger := limiterSync.policyReadAPI.GetStatusRegistry().GetLogger()
 	// Publish only if there's a change
-	if limiterSync.decision.GetLimit() != limitValue {
+	if !proto.Equal(limiterSync.decision, decision) {
 		// Save the decision
-		limiterSync.decision.Limit = limitValue
+		limiterSync.decision = decision
 		// Publish decision
-		logger.Debug().Float64("limit", limitValue).Msg("publishing rate limiter decision")
+		logger.Debug().
+			Float64("limit", decision.GetLimit()).
+			Float64("fill_rate", decision.GetFillRate()).
+			Float64("bucket_capacity", decision.GetBucketCapacity()).
+			Msg("publishing rate limiter decision")
 		wrapper := &wrappersv1.RateLimiterDecisionWrapper{
 			RateLimiterDecision: limiterSync.decision,
 			CommonAttributes: &wrappersv1.CommonAttributes{
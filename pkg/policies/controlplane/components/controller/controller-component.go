@@ -77,15 +77,9 @@ func (cc *ControllerComponent) Execute(inPortReadings runtime.PortToValue, tickI
 		}
 	}
 
-	// Optimize
+	// Optimize - added on top of the computed output, controllers do not retain it in their state
 	if output.Valid() && optimize.Valid() {
-		targetOutput := runtime.NewReading(output.Value() + optimize.Value())
-		// Wind output
-		windedOutput, err := cc.controller.WindOutput(output, targetOutput, cc, tickInfo)
-		output = windedOutput
-		if err != nil {
-			return retErr(err)
-		}
+		output = runtime.NewReading(output.Value() + optimize.Value())
 	}
 
 	// Constraints
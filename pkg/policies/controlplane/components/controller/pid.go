@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"math"
+
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// PIDController describes the gains and the state of a PID controller.
+type PIDController struct {
+	kp                  float64
+	ki                  float64
+	kd                  float64
+	derivativeTimeConst float64
+	// Accumulated integral term, includes the bias and the anti-windup adjustments made by WindOutput
+	integral float64
+	// Filtered derivative of the signal
+	derivative float64
+	// Signal reading of the previous ComputeOutput
+	prevSignal runtime.Reading
+}
+
+// Make sure PIDController complies with Controller interface.
+var _ Controller = (*PIDController)(nil)
+
+// NewPIDControllerAndOptions creates a PID Controller Component and its fx options.
+func NewPIDControllerAndOptions(pidControllerProto *policylangv1.PIDController, componentIndex int, policyReadAPI iface.Policy) (runtime.Component, fx.Option, error) {
+	pid := &PIDController{
+		kp:                  pidControllerProto.Kp,
+		ki:                  pidControllerProto.Ki,
+		kd:                  pidControllerProto.Kd,
+		derivativeTimeConst: pidControllerProto.DerivativeFilterTimeConstant.AsDuration().Seconds(),
+		integral:            pidControllerProto.Bias,
+		prevSignal:          runtime.InvalidReading(),
+	}
+
+	controller := NewControllerComponent(pid, componentIndex, policyReadAPI)
+
+	return controller, fx.Options(), nil
+}
+
+// ComputeOutput based on the error between setpoint and signal, its integral and the derivative of the signal.
+func (pid *PIDController) ComputeOutput(signal, setpoint, controlVariable runtime.Reading, controllerStateReadAPI ControllerStateReadAPI, tickInfo runtime.TickInfo) (runtime.Reading, error) {
+	dt := tickInfo.Interval().Seconds()
+	if !signal.Valid() || !setpoint.Valid() || dt <= 0 {
+		return runtime.InvalidReading(), nil
+	}
+
+	errorValue := setpoint.Value() - signal.Value()
+	pid.integral += pid.ki * errorValue * dt
+
+	// Derivative on measurement avoids a spike in the output when the setpoint changes.
+	if pid.prevSignal.Valid() {
+		rawDerivative := (signal.Value() - pid.prevSignal.Value()) / dt
+		alpha := dt / (pid.derivativeTimeConst + dt)
+		pid.derivative += alpha * (rawDerivative - pid.derivative)
+	}
+	pid.prevSignal = signal
+
+	output := pid.kp*errorValue + pid.integral - pid.kd*pid.derivative
+	if math.IsNaN(output) || math.IsInf(output, 0) {
+		return runtime.InvalidReading(), nil
+	}
+	return runtime.NewReading(output), nil
+}
+
+// MaintainOutput - PID Controller takes the derivative of the signal, so a setpoint change causes no derivative kick. The proportional bump is inevitable.
+func (pid *PIDController) MaintainOutput(prevSetpoint, currentSetpoint runtime.Reading, _ ControllerStateReadAPI, tickInfo runtime.TickInfo) error {
+	return nil
+}
+
+// WindOutput adjusts the integral term by the difference between the constrained and the computed output (back-calculation anti-windup), so that the integral does not keep accumulating beyond the max/min constraints.
+func (pid *PIDController) WindOutput(currentOutput, targetOutput runtime.Reading, _ ControllerStateReadAPI, tickInfo runtime.TickInfo) (runtime.Reading, error) {
+	if currentOutput.Valid() && targetOutput.Valid() {
+		pid.integral += targetOutput.Value() - currentOutput.Value()
+	}
+	return targetOutput, nil
+}
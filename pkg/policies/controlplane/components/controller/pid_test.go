@@ -0,0 +1,108 @@
+package controller_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/components/controller"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+var _ = Describe("PIDController", func() {
+	var (
+		pidProto  *policylangv1.PIDController
+		component runtime.Component
+		tick      int
+	)
+
+	execute := func(readings map[string]float64) runtime.Reading {
+		inPortReadings := make(runtime.PortToValue)
+		for port, value := range readings {
+			inPortReadings[port] = []runtime.Reading{runtime.NewReading(value)}
+		}
+		timestamp := time.Unix(int64(tick), 0)
+		tickInfo := runtime.NewTickInfo(timestamp, timestamp.Add(time.Second), tick, time.Second)
+		tick++
+		outPortReadings, err := component.Execute(inPortReadings, tickInfo)
+		Expect(err).NotTo(HaveOccurred())
+		return outPortReadings["output"][0]
+	}
+
+	BeforeEach(func() {
+		pidProto = &policylangv1.PIDController{
+			DerivativeFilterTimeConstant: durationpb.New(0),
+		}
+		tick = 0
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		component, _, err = controller.NewPIDControllerAndOptions(pidProto, 0, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("with proportional and integral gains", func() {
+		BeforeEach(func() {
+			pidProto.Kp = 2
+			pidProto.Ki = 1
+			pidProto.Bias = 10
+		})
+
+		It("accumulates the error", func() {
+			Expect(execute(map[string]float64{"signal": 8, "setpoint": 10}).Value()).To(Equal(16.0))
+			Expect(execute(map[string]float64{"signal": 8, "setpoint": 10}).Value()).To(Equal(18.0))
+			Expect(execute(map[string]float64{"signal": 10, "setpoint": 10}).Value()).To(Equal(14.0))
+		})
+
+		It("limits the integral to the max constraint", func() {
+			for i := 0; i < 10; i++ {
+				Expect(execute(map[string]float64{"signal": 0, "setpoint": 10, "max": 30}).Value()).To(Equal(30.0))
+			}
+			// Integral was held back at 30 - kp*error, so the output recovers as soon as the error turns negative.
+			Expect(execute(map[string]float64{"signal": 11, "setpoint": 10, "max": 30}).Value()).To(Equal(7.0))
+		})
+
+		It("adds the optimize signal without accumulating it", func() {
+			for i := 0; i < 5; i++ {
+				Expect(execute(map[string]float64{"signal": 10, "setpoint": 10, "optimize": 5}).Value()).To(Equal(15.0))
+			}
+			Expect(execute(map[string]float64{"signal": 10, "setpoint": 10}).Value()).To(Equal(10.0))
+		})
+
+		It("limits the integral when optimize pushes the output over the max constraint", func() {
+			for i := 0; i < 5; i++ {
+				Expect(execute(map[string]float64{"signal": 10, "setpoint": 10, "optimize": 25, "max": 30}).Value()).To(Equal(30.0))
+			}
+			// Integral was held back at 30 - optimize.
+			Expect(execute(map[string]float64{"signal": 10, "setpoint": 10}).Value()).To(Equal(5.0))
+		})
+
+		It("emits invalid output without signal", func() {
+			Expect(execute(map[string]float64{"setpoint": 10}).Valid()).To(BeFalse())
+		})
+	})
+
+	Context("with derivative gain", func() {
+		BeforeEach(func() {
+			pidProto.Kd = 1
+		})
+
+		It("reacts to the change of the signal and not the setpoint", func() {
+			Expect(execute(map[string]float64{"signal": 10, "setpoint": 10}).Value()).To(Equal(0.0))
+			Expect(execute(map[string]float64{"signal": 10, "setpoint": 20}).Value()).To(Equal(0.0))
+			Expect(execute(map[string]float64{"signal": 14, "setpoint": 20}).Value()).To(Equal(-4.0))
+		})
+
+		It("filters the derivative", func() {
+			pidProto.DerivativeFilterTimeConstant = durationpb.New(time.Second)
+			component, _, _ = controller.NewPIDControllerAndOptions(pidProto, 0, nil)
+			execute(map[string]float64{"signal": 10, "setpoint": 10})
+			Expect(execute(map[string]float64{"signal": 14, "setpoint": 10}).Value()).To(Equal(-2.0))
+			Expect(execute(map[string]float64{"signal": 14, "setpoint": 10}).Value()).To(Equal(-1.0))
+		})
+	})
+})
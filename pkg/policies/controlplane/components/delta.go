@@ -0,0 +1,45 @@
+package components
+
+import (
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// Delta emits the difference between the current and the previous reading of the input signal.
+type Delta struct {
+	prevInput runtime.Reading
+}
+
+// Make sure Delta complies with Component interface.
+var _ runtime.Component = (*Delta)(nil)
+
+// NewDeltaAndOptions creates a new Delta Component.
+func NewDeltaAndOptions(deltaProto *policylangv1.Delta, componentIndex int, policyReadAPI iface.Policy) (runtime.Component, fx.Option, error) {
+	delta := &Delta{
+		prevInput: runtime.InvalidReading(),
+	}
+	return delta, fx.Options(), nil
+}
+
+// Execute implements runtime.Component.Execute.
+func (delta *Delta) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	input := inPortReadings.ReadSingleValuePort("input")
+	output := runtime.InvalidReading()
+
+	if input.Valid() && delta.prevInput.Valid() {
+		output = runtime.NewReading(input.Value() - delta.prevInput.Value())
+	}
+	delta.prevInput = input
+
+	return runtime.PortToValue{
+		"output": []runtime.Reading{output},
+	}, nil
+}
+
+// DynamicConfigUpdate is a no-op for Delta.
+func (delta *Delta) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {}
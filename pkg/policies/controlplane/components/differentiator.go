@@ -0,0 +1,71 @@
+package components
+
+import (
+	"time"
+
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// timedReading is a valid reading along with the timestamp of its tick.
+type timedReading struct {
+	timestamp time.Time
+	value     float64
+}
+
+// Differentiator emits the rate of change of the input signal over a window.
+type Differentiator struct {
+	// Valid readings within the window, oldest first.
+	readings []timedReading
+	window   time.Duration
+}
+
+// Make sure Differentiator complies with Component interface.
+var _ runtime.Component = (*Differentiator)(nil)
+
+// NewDifferentiatorAndOptions creates a new Differentiator Component.
+func NewDifferentiatorAndOptions(differentiatorProto *policylangv1.Differentiator, componentIndex int, policyReadAPI iface.Policy) (runtime.Component, fx.Option, error) {
+	differentiator := &Differentiator{
+		window: differentiatorProto.Window.AsDuration(),
+	}
+	return differentiator, fx.Options(), nil
+}
+
+// Execute implements runtime.Component.Execute.
+func (differentiator *Differentiator) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	input := inPortReadings.ReadSingleValuePort("input")
+	timestamp := tickInfo.Timestamp()
+	output := runtime.InvalidReading()
+
+	// Drop the readings that fell out of the window.
+	oldest := timestamp.Add(-differentiator.window)
+	drop := 0
+	for drop < len(differentiator.readings) && differentiator.readings[drop].timestamp.Before(oldest) {
+		drop++
+	}
+	differentiator.readings = differentiator.readings[drop:]
+
+	if input.Valid() {
+		differentiator.readings = append(differentiator.readings, timedReading{
+			timestamp: timestamp,
+			value:     input.Value(),
+		})
+		first := differentiator.readings[0]
+		if elapsed := timestamp.Sub(first.timestamp).Seconds(); elapsed > 0 {
+			output = runtime.NewReading((input.Value() - first.value) / elapsed)
+		}
+	}
+
+	return runtime.PortToValue{
+		"output": []runtime.Reading{output},
+	}, nil
+}
+
+// DynamicConfigUpdate is a no-op for Differentiator.
+func (differentiator *Differentiator) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+}
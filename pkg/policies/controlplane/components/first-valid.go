@@ -0,0 +1,42 @@
+package components
+
+import (
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// FirstValid takes array of signals and emits the first valid one.
+type FirstValid struct{}
+
+// Make sure FirstValid complies with Component interface.
+var _ runtime.Component = (*FirstValid)(nil)
+
+// NewFirstValidAndOptions creates a new FirstValid Component.
+func NewFirstValidAndOptions(firstValidProto *policylangv1.FirstValid, componentIndex int, policyReadAPI iface.Policy) (runtime.Component, fx.Option, error) {
+	firstValid := FirstValid{}
+	return &firstValid, fx.Options(), nil
+}
+
+// Execute implements runtime.Component.Execute.
+func (firstValid *FirstValid) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	output := runtime.InvalidReading()
+	for _, input := range inPortReadings.ReadRepeatedValuePort("inputs") {
+		if input.Valid() {
+			output = input
+			break
+		}
+	}
+
+	return runtime.PortToValue{
+		"output": []runtime.Reading{output},
+	}, nil
+}
+
+// DynamicConfigUpdate is a no-op for FirstValid.
+func (firstValid *FirstValid) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+}
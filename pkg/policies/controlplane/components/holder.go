@@ -0,0 +1,71 @@
+package components
+
+import (
+	"time"
+
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// Holder samples the input signal and holds the value for a duration.
+type Holder struct {
+	// time the held value was sampled at
+	heldSince time.Time
+	heldValue runtime.Reading
+	holdFor   time.Duration
+	holding   bool
+}
+
+// Make sure Holder complies with Component interface.
+var _ runtime.Component = (*Holder)(nil)
+
+// NewHolderAndOptions creates a new Holder Component.
+func NewHolderAndOptions(holderProto *policylangv1.Holder, componentIndex int, policyReadAPI iface.Policy) (runtime.Component, fx.Option, error) {
+	holder := &Holder{
+		holdFor:   holderProto.GetHoldFor().AsDuration(),
+		heldValue: runtime.InvalidReading(),
+	}
+	return holder, fx.Options(), nil
+}
+
+// Execute implements runtime.Component.Execute.
+func (holder *Holder) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	input := inPortReadings.ReadSingleValuePort("input")
+	reset := inPortReadings.ReadSingleValuePort("reset")
+	now := tickInfo.Timestamp()
+
+	if reset.Valid() && reset.Value() != 0 {
+		holder.release()
+	} else {
+		if holder.holding && now.Sub(holder.heldSince) >= holder.holdFor {
+			holder.release()
+		}
+		if !holder.holding && input.Valid() {
+			holder.heldValue = input
+			holder.heldSince = now
+			holder.holding = true
+		}
+	}
+
+	output := input
+	if holder.holding {
+		output = holder.heldValue
+	}
+	return runtime.PortToValue{
+		"output": []runtime.Reading{output},
+	}, nil
+}
+
+func (holder *Holder) release() {
+	holder.holding = false
+	holder.heldValue = runtime.InvalidReading()
+}
+
+// DynamicConfigUpdate is a no-op for Holder.
+func (holder *Holder) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+}
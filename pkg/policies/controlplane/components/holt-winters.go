@@ -0,0 +1,178 @@
+package components
+
+import (
+	"math"
+	"time"
+
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// HoltWinters is a triple exponential smoothing filter tracking level, trend and seasonality of a signal.
+type HoltWinters struct {
+	lastGoodOutput   runtime.Reading
+	lastGoodTrend    runtime.Reading
+	lastGoodForecast runtime.Reading
+	// Seasonal components, one per tick of a season, nil if seasonality is disabled.
+	seasonal []float64
+	// Smoothing factors of level, trend and seasonal components.
+	alpha float64
+	beta  float64
+	gamma float64
+	level float64
+	// Trend of the level per tick.
+	trend float64
+	sum   float64
+	count float64
+	// Duration of a tick.
+	evaluationInterval time.Duration
+	currentStage       stage
+	// The initial level is the average of the first warm_up_window number of observations.
+	warmUpWindow uint32
+	levelWindow  uint32
+	warmupCount  uint32
+	invalidCount uint32
+	// Number of ticks the forecast looks ahead.
+	forecastHorizon int
+}
+
+// Make sure HoltWinters complies with Component interface.
+var _ runtime.Component = (*HoltWinters)(nil)
+
+// NewHoltWintersAndOptions returns a new Holt-Winters filter and its Fx options.
+func NewHoltWintersAndOptions(holtWintersProto *policylangv1.HoltWinters,
+	_ int,
+	policyReadAPI iface.Policy,
+) (*HoltWinters, fx.Option, error) {
+	evaluationInterval := policyReadAPI.GetEvaluationInterval()
+	toTicks := func(duration time.Duration) float64 {
+		return math.Ceil(float64(duration) / float64(evaluationInterval))
+	}
+
+	levelWindow := toTicks(holtWintersProto.LevelWindow.AsDuration())
+	trendWindow := toTicks(holtWintersProto.TrendWindow.AsDuration())
+	seasonLength := toTicks(holtWintersProto.SeasonLength.AsDuration())
+
+	holtWinters := &HoltWinters{
+		alpha:              2.0 / (levelWindow + 1),
+		beta:               2.0 / (trendWindow + 1),
+		evaluationInterval: evaluationInterval,
+		warmUpWindow:       uint32(toTicks(holtWintersProto.WarmUpWindow.AsDuration())),
+		levelWindow:        uint32(levelWindow),
+		forecastHorizon:    int(toTicks(holtWintersProto.ForecastHorizon.AsDuration())),
+	}
+	if seasonLength > 0 {
+		// number of seasons in seasonal_window
+		seasonalWindow := math.Ceil(float64(holtWintersProto.SeasonalWindow.AsDuration()) / float64(holtWintersProto.SeasonLength.AsDuration()))
+		holtWinters.gamma = 2.0 / (seasonalWindow + 1)
+		holtWinters.seasonal = make([]float64, int(seasonLength))
+	}
+	holtWinters.resetStages()
+	return holtWinters, fx.Options(), nil
+}
+
+func (hw *HoltWinters) resetStages() {
+	hw.currentStage = warmUpStage
+	hw.warmupCount = 0
+	hw.invalidCount = 0
+	hw.sum = 0
+	hw.count = 0
+	hw.level = 0
+	hw.trend = 0
+	for i := range hw.seasonal {
+		hw.seasonal[i] = 0
+	}
+	hw.lastGoodOutput = runtime.InvalidReading()
+	hw.lastGoodTrend = runtime.InvalidReading()
+	hw.lastGoodForecast = runtime.InvalidReading()
+}
+
+func (hw *HoltWinters) lastGoodOutputs() runtime.PortToValue {
+	return runtime.PortToValue{
+		"output":   []runtime.Reading{hw.lastGoodOutput},
+		"trend":    []runtime.Reading{hw.lastGoodTrend},
+		"forecast": []runtime.Reading{hw.lastGoodForecast},
+	}
+}
+
+// Execute implements runtime.Component.Execute.
+func (hw *HoltWinters) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	input := inPortReadings.ReadSingleValuePort("input")
+
+	switch hw.currentStage {
+	case warmUpStage:
+		hw.warmupCount++
+		if !input.Valid() {
+			// Immediately reset on any missing values during warmup.
+			hw.resetStages()
+			return hw.lastGoodOutputs(), nil
+		}
+		hw.sum += input.Value()
+		hw.count++
+		hw.level = hw.sum / hw.count
+		// Decide to switch to normal stage
+		if hw.warmupCount >= hw.warmUpWindow {
+			hw.currentStage = emaStage
+		}
+	case emaStage: // smoothing stage, shared with EMA
+		if !input.Valid() {
+			hw.invalidCount++
+			// emit last good outputs
+			outputs := hw.lastGoodOutputs()
+			// If invalid readings persist for the level window, reset the stages.
+			if hw.invalidCount >= hw.levelWindow {
+				hw.resetStages()
+			}
+			return outputs, nil
+		}
+		hw.invalidCount = 0
+		hw.update(input.Value(), tickInfo.Tick())
+	}
+
+	output := hw.level + hw.seasonalAt(tickInfo.Tick())
+	forecast := hw.level + float64(hw.forecastHorizon)*hw.trend + hw.seasonalAt(tickInfo.Tick()+hw.forecastHorizon)
+	trendPerSecond := hw.trend / hw.evaluationInterval.Seconds()
+	if math.IsNaN(forecast) || math.IsInf(forecast, 0) || math.IsInf(trendPerSecond, 0) {
+		return hw.lastGoodOutputs(), nil
+	}
+
+	hw.lastGoodOutput = runtime.NewReading(output)
+	hw.lastGoodTrend = runtime.NewReading(trendPerSecond)
+	hw.lastGoodForecast = runtime.NewReading(forecast)
+	return hw.lastGoodOutputs(), nil
+}
+
+// update applies the reading to level, trend and seasonal component of the tick.
+func (hw *HoltWinters) update(value float64, tick int) {
+	seasonal := hw.seasonalAt(tick)
+	prevLevel := hw.level
+	hw.level = hw.alpha*(value-seasonal) + (1-hw.alpha)*(prevLevel+hw.trend)
+	hw.trend = hw.beta*(hw.level-prevLevel) + (1-hw.beta)*hw.trend
+	if hw.seasonal != nil {
+		hw.seasonal[hw.seasonIndex(tick)] = hw.gamma*(value-hw.level) + (1-hw.gamma)*seasonal
+	}
+}
+
+// seasonalAt returns the seasonal component of the tick, zero if seasonality is disabled.
+func (hw *HoltWinters) seasonalAt(tick int) float64 {
+	if hw.seasonal == nil {
+		return 0
+	}
+	return hw.seasonal[hw.seasonIndex(tick)]
+}
+
+func (hw *HoltWinters) seasonIndex(tick int) int {
+	index := tick % len(hw.seasonal)
+	if index < 0 {
+		index += len(hw.seasonal)
+	}
+	return index
+}
+
+// DynamicConfigUpdate is a no-op for HoltWinters.
+func (hw *HoltWinters) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {}
@@ -0,0 +1,117 @@
+package components_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/components"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+	"github.com/fluxninja/aperture/pkg/status"
+)
+
+// testPolicy is a policy evaluated every second.
+type testPolicy struct{}
+
+func (testPolicy) GetPolicyName() string                { return "test" }
+func (testPolicy) GetPolicyHash() string                { return "test" }
+func (testPolicy) GetEvaluationInterval() time.Duration { return time.Second }
+func (testPolicy) GetStatusRegistry() status.Registry {
+	return status.NewRegistry(log.GetGlobalLogger())
+}
+
+var _ = Describe("HoltWinters filter", func() {
+	var (
+		holtWintersProto *policylangv1.HoltWinters
+		holtWinters      *components.HoltWinters
+		tick             int
+	)
+
+	execute := func(input runtime.Reading) runtime.PortToValue {
+		timestamp := time.Unix(int64(tick), 0)
+		tickInfo := runtime.NewTickInfo(timestamp, timestamp.Add(time.Second), tick, time.Second)
+		tick++
+		outPortReadings, err := holtWinters.Execute(runtime.PortToValue{"input": []runtime.Reading{input}}, tickInfo)
+		Expect(err).NotTo(HaveOccurred())
+		return outPortReadings
+	}
+
+	BeforeEach(func() {
+		holtWintersProto = &policylangv1.HoltWinters{
+			LevelWindow:     durationpb.New(3 * time.Second),
+			TrendWindow:     durationpb.New(3 * time.Second),
+			SeasonLength:    durationpb.New(0),
+			SeasonalWindow:  durationpb.New(0),
+			WarmUpWindow:    durationpb.New(2 * time.Second),
+			ForecastHorizon: durationpb.New(10 * time.Second),
+		}
+		tick = 0
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		holtWinters, _, err = components.NewHoltWintersAndOptions(holtWintersProto, 0, testPolicy{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("emits the average during warm up", func() {
+		outputs := execute(runtime.NewReading(10))
+		Expect(outputs["output"][0].Value()).To(Equal(10.0))
+		outputs = execute(runtime.NewReading(20))
+		Expect(outputs["output"][0].Value()).To(Equal(15.0))
+		Expect(outputs["trend"][0].Value()).To(Equal(0.0))
+		Expect(outputs["forecast"][0].Value()).To(Equal(15.0))
+	})
+
+	It("tracks the trend of a linear signal", func() {
+		var outputs runtime.PortToValue
+		for i := 0; i < 100; i++ {
+			outputs = execute(runtime.NewReading(float64(2 * i)))
+		}
+		Expect(outputs["trend"][0].Value()).To(BeNumerically("~", 2.0, 0.01))
+		Expect(outputs["output"][0].Value()).To(BeNumerically("~", 198.0, 0.1))
+		Expect(outputs["forecast"][0].Value()).To(BeNumerically("~", 218.0, 0.2))
+	})
+
+	It("emits the last good outputs on invalid readings and resets after level window", func() {
+		execute(runtime.NewReading(10))
+		execute(runtime.NewReading(10))
+		good := execute(runtime.NewReading(10))
+		Expect(good["output"][0].Value()).To(Equal(10.0))
+		Expect(execute(runtime.InvalidReading())).To(Equal(good))
+		Expect(execute(runtime.InvalidReading())).To(Equal(good))
+		Expect(execute(runtime.InvalidReading())).To(Equal(good))
+		// reset to warm up
+		Expect(execute(runtime.InvalidReading())["output"][0].Valid()).To(BeFalse())
+		Expect(execute(runtime.NewReading(30))["output"][0].Value()).To(Equal(30.0))
+	})
+
+	Context("with seasonality", func() {
+		BeforeEach(func() {
+			holtWintersProto.LevelWindow = durationpb.New(9 * time.Second)
+			holtWintersProto.TrendWindow = durationpb.New(99 * time.Second)
+			holtWintersProto.SeasonLength = durationpb.New(4 * time.Second)
+			holtWintersProto.SeasonalWindow = durationpb.New(4 * time.Second)
+			holtWintersProto.WarmUpWindow = durationpb.New(4 * time.Second)
+			holtWintersProto.ForecastHorizon = durationpb.New(2 * time.Second)
+		})
+
+		It("forecasts the seasonal pattern", func() {
+			pattern := []float64{10, 20, 30, 20}
+			var outputs runtime.PortToValue
+			for i := 0; i < 400; i++ {
+				outputs = execute(runtime.NewReading(pattern[i%len(pattern)]))
+			}
+			// last tick was at the last position of the season, 2 ticks ahead is the second position
+			Expect(outputs["output"][0].Value()).To(BeNumerically("~", 20.0, 0.5))
+			Expect(outputs["forecast"][0].Value()).To(BeNumerically("~", 20.0, 0.5))
+			outputs = execute(runtime.NewReading(pattern[0]))
+			Expect(outputs["output"][0].Value()).To(BeNumerically("~", 10.0, 0.5))
+			Expect(outputs["forecast"][0].Value()).To(BeNumerically("~", 30.0, 0.5))
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package components
+
+import (
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/constraints"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// Integrator accumulates the input signal multiplied by the tick interval.
+type Integrator struct {
+	sum float64
+}
+
+// Make sure Integrator complies with Component interface.
+var _ runtime.Component = (*Integrator)(nil)
+
+// NewIntegratorAndOptions creates a new Integrator Component.
+func NewIntegratorAndOptions(integratorProto *policylangv1.Integrator, componentIndex int, policyReadAPI iface.Policy) (runtime.Component, fx.Option, error) {
+	integrator := &Integrator{}
+	return integrator, fx.Options(), nil
+}
+
+// Execute implements runtime.Component.Execute.
+func (integrator *Integrator) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	input := inPortReadings.ReadSingleValuePort("input")
+	reset := inPortReadings.ReadSingleValuePort("reset")
+	maxValue := inPortReadings.ReadSingleValuePort("max")
+	minValue := inPortReadings.ReadSingleValuePort("min")
+
+	if reset.Valid() && reset.Value() != 0 {
+		integrator.sum = 0
+	} else if input.Valid() {
+		integrator.sum += input.Value() * tickInfo.Interval().Seconds()
+	}
+
+	minMaxConstraints := constraints.NewMinMaxConstraints()
+	if maxValue.Valid() {
+		if err := minMaxConstraints.SetMax(maxValue.Value()); err != nil {
+			return runtime.PortToValue{
+				"output": []runtime.Reading{runtime.InvalidReading()},
+			}, err
+		}
+	}
+	if minValue.Valid() {
+		if err := minMaxConstraints.SetMin(minValue.Value()); err != nil {
+			return runtime.PortToValue{
+				"output": []runtime.Reading{runtime.InvalidReading()},
+			}, err
+		}
+	}
+	// Clamping the accumulated value itself, so that it doesn't wind up beyond the range.
+	integrator.sum, _ = minMaxConstraints.Constrain(integrator.sum)
+
+	return runtime.PortToValue{
+		"output": []runtime.Reading{runtime.NewReading(integrator.sum)},
+	}, nil
+}
+
+// DynamicConfigUpdate is a no-op for Integrator.
+func (integrator *Integrator) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+}
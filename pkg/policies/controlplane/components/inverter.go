@@ -0,0 +1,44 @@
+package components
+
+import (
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// Inverter emits the logical negation of the input signal.
+type Inverter struct{}
+
+// Make sure Inverter complies with Component interface.
+var _ runtime.Component = (*Inverter)(nil)
+
+// NewInverterAndOptions creates a new Inverter Component.
+func NewInverterAndOptions(inverterProto *policylangv1.Inverter, componentIndex int, policyReadAPI iface.Policy) (runtime.Component, fx.Option, error) {
+	inverter := Inverter{}
+	return &inverter, fx.Options(), nil
+}
+
+// Execute implements runtime.Component.Execute.
+func (inverter *Inverter) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	input := inPortReadings.ReadSingleValuePort("input")
+	output := runtime.InvalidReading()
+	if input.Valid() {
+		if input.Value() == 0 {
+			output = runtime.NewReading(1)
+		} else {
+			output = runtime.NewReading(0)
+		}
+	}
+
+	return runtime.PortToValue{
+		"output": []runtime.Reading{output},
+	}, nil
+}
+
+// DynamicConfigUpdate is a no-op for Inverter.
+func (inverter *Inverter) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+}
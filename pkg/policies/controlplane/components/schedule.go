@@ -0,0 +1,137 @@
+package components
+
+import (
+	"fmt"
+	"time"
+
+	// Embedded time zone database, so that time zones work without one installed on the host.
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// scheduleLookback is how far back the entries fired before the first tick are looked for.
+const scheduleLookback = 32 * 24 * time.Hour
+
+// scheduleEntry is a compiled entry of the schedule.
+type scheduleEntry struct {
+	// time of the next firing of the entry
+	next     time.Time
+	schedule cron.Schedule
+	value    float64
+	ramp     time.Duration
+}
+
+// Schedule emits values on a cron schedule.
+type Schedule struct {
+	// time the entry in effect fired at
+	activeSince time.Time
+	entries     []*scheduleEntry
+	// entry in effect, nil before any of the entries fired
+	active *scheduleEntry
+	// value in effect before the active entry fired, the ramp starts from it
+	rampFrom     float64
+	defaultValue float64
+	initialized  bool
+}
+
+// Make sure Schedule complies with Component interface.
+var _ runtime.Component = (*Schedule)(nil)
+
+// NewScheduleAndOptions creates a new Schedule Component.
+func NewScheduleAndOptions(scheduleProto *policylangv1.Schedule, componentIndex int, policyReadAPI iface.Policy) (runtime.Component, fx.Option, error) {
+	location := time.UTC
+	if timeZone := scheduleProto.GetTimeZone(); timeZone != "" {
+		var err error
+		location, err = time.LoadLocation(timeZone)
+		if err != nil {
+			return nil, fx.Options(), fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+		}
+	}
+
+	schedule := &Schedule{
+		defaultValue: scheduleProto.GetDefaultValue(),
+	}
+	for _, entryProto := range scheduleProto.GetEntries() {
+		cronSchedule, err := cron.ParseStandard(entryProto.GetCron())
+		if err != nil {
+			return nil, fx.Options(), fmt.Errorf("invalid cron expression %q: %w", entryProto.GetCron(), err)
+		}
+		if specSchedule, ok := cronSchedule.(*cron.SpecSchedule); ok {
+			specSchedule.Location = location
+		}
+		schedule.entries = append(schedule.entries, &scheduleEntry{
+			schedule: cronSchedule,
+			value:    entryProto.GetValue(),
+			ramp:     entryProto.GetRamp().AsDuration(),
+		})
+	}
+	return schedule, fx.Options(), nil
+}
+
+// Execute implements runtime.Component.Execute.
+func (schedule *Schedule) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	now := tickInfo.Timestamp()
+	if !schedule.initialized {
+		// Replay the entries fired recently, so that the right entry is in effect from the first tick.
+		start := now.Add(-scheduleLookback)
+		for _, entry := range schedule.entries {
+			entry.next = entry.schedule.Next(start)
+		}
+		schedule.initialized = true
+	}
+	schedule.fireUntil(now)
+
+	return runtime.PortToValue{
+		"output": []runtime.Reading{runtime.NewReading(schedule.valueAt(now))},
+	}, nil
+}
+
+// fireUntil makes the entries which fired until now take effect, in the order of firing.
+func (schedule *Schedule) fireUntil(now time.Time) {
+	for {
+		var firing *scheduleEntry
+		for _, entry := range schedule.entries {
+			if entry.next.IsZero() || entry.next.After(now) {
+				continue
+			}
+			if firing == nil || entry.next.Before(firing.next) {
+				firing = entry
+			}
+		}
+		if firing == nil {
+			return
+		}
+		firedAt := firing.next
+		schedule.rampFrom = schedule.valueAt(firedAt)
+		schedule.active = firing
+		schedule.activeSince = firedAt
+		// zero time if the entry never fires again
+		firing.next = firing.schedule.Next(firedAt)
+	}
+}
+
+// valueAt returns the value of the schedule at the given time, which should not be before the firing of the active entry.
+func (schedule *Schedule) valueAt(t time.Time) float64 {
+	active := schedule.active
+	if active == nil {
+		return schedule.defaultValue
+	}
+	elapsed := t.Sub(schedule.activeSince)
+	if active.ramp <= 0 || elapsed >= active.ramp {
+		return active.value
+	}
+	progress := float64(elapsed) / float64(active.ramp)
+	return schedule.rampFrom + (active.value-schedule.rampFrom)*progress
+}
+
+// DynamicConfigUpdate is a no-op for Schedule.
+func (schedule *Schedule) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+}
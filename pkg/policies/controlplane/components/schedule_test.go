@@ -0,0 +1,91 @@
+package components_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/components"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+var _ = Describe("Schedule", func() {
+	var (
+		scheduleProto *policylangv1.Schedule
+		schedule      runtime.Component
+	)
+
+	valueAt := func(timestamp time.Time) float64 {
+		tickInfo := runtime.NewTickInfo(timestamp, timestamp.Add(time.Second), 0, time.Second)
+		outPortReadings, err := schedule.Execute(runtime.PortToValue{}, tickInfo)
+		Expect(err).NotTo(HaveOccurred())
+		return outPortReadings["output"][0].Value()
+	}
+
+	BeforeEach(func() {
+		scheduleProto = &policylangv1.Schedule{
+			TimeZone:     "Europe/Warsaw",
+			DefaultValue: 50,
+			Entries: []*policylangv1.Schedule_Entry{
+				{Cron: "0 22 * * *", Value: 20, Ramp: durationpb.New(10 * time.Minute)},
+				{Cron: "0 6 * * *", Value: 100, Ramp: durationpb.New(0)},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		schedule, _, err = components.NewScheduleAndOptions(scheduleProto, 0, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	warsaw := func(hour, min int) time.Time {
+		location, err := time.LoadLocation("Europe/Warsaw")
+		Expect(err).NotTo(HaveOccurred())
+		return time.Date(2022, time.November, 15, hour, min, 0, 0, location)
+	}
+
+	It("emits the value of the entry in effect from the first tick", func() {
+		Expect(valueAt(warsaw(12, 0))).To(Equal(100.0))
+	})
+
+	It("follows the schedule in the time zone", func() {
+		Expect(valueAt(warsaw(21, 59))).To(Equal(100.0))
+		Expect(valueAt(warsaw(22, 0))).To(Equal(100.0))
+		Expect(valueAt(warsaw(22, 5))).To(Equal(60.0))
+		Expect(valueAt(warsaw(22, 10))).To(Equal(20.0))
+		Expect(valueAt(warsaw(23, 0))).To(Equal(20.0))
+		Expect(valueAt(warsaw(23, 0).Add(7 * time.Hour))).To(Equal(100.0))
+	})
+
+	It("starts in the middle of a ramp", func() {
+		Expect(valueAt(warsaw(22, 5))).To(Equal(60.0))
+	})
+
+	Context("without entries fired yet", func() {
+		BeforeEach(func() {
+			scheduleProto.Entries = []*policylangv1.Schedule_Entry{
+				{Cron: "0 0 29 2 *", Value: 1},
+			}
+		})
+
+		It("emits the default value", func() {
+			Expect(valueAt(warsaw(12, 0))).To(Equal(50.0))
+		})
+	})
+
+	It("rejects invalid cron expressions", func() {
+		scheduleProto.Entries[0].Cron = "0 25 * * *"
+		_, _, err := components.NewScheduleAndOptions(scheduleProto, 0, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects unknown time zones", func() {
+		scheduleProto.TimeZone = "Mars/Olympus_Mons"
+		_, _, err := components.NewScheduleAndOptions(scheduleProto, 0, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
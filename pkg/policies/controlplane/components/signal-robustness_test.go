@@ -0,0 +1,103 @@
+package components_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/components"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+var _ = Describe("Holder", func() {
+	var holder runtime.Component
+
+	BeforeEach(func() {
+		var err error
+		holder, _, err = components.NewHolderAndOptions(&policylangv1.Holder{
+			HoldFor: durationpb.New(3 * time.Second),
+		}, 0, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("holds the sampled value for the duration", func() {
+		Expect(executeTick(holder, 0, map[string]runtime.Reading{"input": runtime.NewReading(1)}).Value()).To(Equal(1.0))
+		Expect(executeTick(holder, 1, map[string]runtime.Reading{"input": runtime.NewReading(2)}).Value()).To(Equal(1.0))
+		Expect(executeTick(holder, 2, map[string]runtime.Reading{"input": runtime.InvalidReading()}).Value()).To(Equal(1.0))
+		Expect(executeTick(holder, 3, map[string]runtime.Reading{"input": runtime.NewReading(4)}).Value()).To(Equal(4.0))
+		Expect(executeTick(holder, 4, map[string]runtime.Reading{"input": runtime.NewReading(5)}).Value()).To(Equal(4.0))
+	})
+
+	It("passes through invalid readings while nothing is held", func() {
+		Expect(executeTick(holder, 0, map[string]runtime.Reading{"input": runtime.InvalidReading()}).Valid()).To(BeFalse())
+		Expect(executeTick(holder, 1, map[string]runtime.Reading{"input": runtime.NewReading(2)}).Value()).To(Equal(2.0))
+	})
+
+	It("releases the held value on reset", func() {
+		executeTick(holder, 0, map[string]runtime.Reading{"input": runtime.NewReading(1)})
+		Expect(executeTick(holder, 1, map[string]runtime.Reading{
+			"input": runtime.NewReading(2),
+			"reset": runtime.NewReading(1),
+		}).Value()).To(Equal(2.0))
+		Expect(executeTick(holder, 2, map[string]runtime.Reading{
+			"input": runtime.NewReading(3),
+			"reset": runtime.NewReading(1),
+		}).Value()).To(Equal(3.0))
+		Expect(executeTick(holder, 3, map[string]runtime.Reading{
+			"input": runtime.NewReading(4),
+			"reset": runtime.NewReading(0),
+		}).Value()).To(Equal(4.0))
+		Expect(executeTick(holder, 4, map[string]runtime.Reading{"input": runtime.NewReading(5)}).Value()).To(Equal(4.0))
+	})
+})
+
+var _ = Describe("FirstValid", func() {
+	var firstValid runtime.Component
+
+	BeforeEach(func() {
+		var err error
+		firstValid, _, err = components.NewFirstValidAndOptions(&policylangv1.FirstValid{}, 0, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	execute := func(inputs ...runtime.Reading) runtime.Reading {
+		timestamp := time.Unix(0, 0)
+		tickInfo := runtime.NewTickInfo(timestamp, timestamp.Add(time.Second), 0, time.Second)
+		outPortReadings, err := firstValid.Execute(runtime.PortToValue{"inputs": inputs}, tickInfo)
+		Expect(err).NotTo(HaveOccurred())
+		return outPortReadings["output"][0]
+	}
+
+	It("emits the first valid input", func() {
+		Expect(execute(runtime.NewReading(1), runtime.NewReading(2)).Value()).To(Equal(1.0))
+		Expect(execute(runtime.InvalidReading(), runtime.NewReading(2), runtime.NewReading(3)).Value()).To(Equal(2.0))
+	})
+
+	It("emits invalid reading if no input is valid", func() {
+		Expect(execute(runtime.InvalidReading(), runtime.InvalidReading()).Valid()).To(BeFalse())
+		Expect(execute().Valid()).To(BeFalse())
+	})
+})
+
+var _ = Describe("Inverter", func() {
+	var inverter runtime.Component
+
+	BeforeEach(func() {
+		var err error
+		inverter, _, err = components.NewInverterAndOptions(&policylangv1.Inverter{}, 0, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("negates the input", func() {
+		Expect(executeTick(inverter, 0, map[string]runtime.Reading{"input": runtime.NewReading(0)}).Value()).To(Equal(1.0))
+		Expect(executeTick(inverter, 1, map[string]runtime.Reading{"input": runtime.NewReading(1)}).Value()).To(Equal(0.0))
+		Expect(executeTick(inverter, 2, map[string]runtime.Reading{"input": runtime.NewReading(-2.5)}).Value()).To(Equal(0.0))
+	})
+
+	It("emits invalid reading for invalid input", func() {
+		Expect(executeTick(inverter, 0, map[string]runtime.Reading{"input": runtime.InvalidReading()}).Valid()).To(BeFalse())
+	})
+})
@@ -0,0 +1,113 @@
+package components_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/components"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// executeTick executes the component at the given tick of a circuit evaluated every second.
+func executeTick(component runtime.Component, tick int, inPortReadings map[string]runtime.Reading) runtime.Reading {
+	readings := make(runtime.PortToValue, len(inPortReadings))
+	for port, reading := range inPortReadings {
+		readings[port] = []runtime.Reading{reading}
+	}
+	timestamp := time.Unix(int64(tick), 0)
+	tickInfo := runtime.NewTickInfo(timestamp, timestamp.Add(time.Second), tick, time.Second)
+	outPortReadings, err := component.Execute(readings, tickInfo)
+	Expect(err).NotTo(HaveOccurred())
+	return outPortReadings["output"][0]
+}
+
+var _ = Describe("Integrator", func() {
+	var integrator runtime.Component
+
+	BeforeEach(func() {
+		var err error
+		integrator, _, err = components.NewIntegratorAndOptions(&policylangv1.Integrator{}, 0, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("accumulates the input and skips invalid readings", func() {
+		Expect(executeTick(integrator, 0, map[string]runtime.Reading{"input": runtime.NewReading(2)}).Value()).To(Equal(2.0))
+		Expect(executeTick(integrator, 1, map[string]runtime.Reading{"input": runtime.InvalidReading()}).Value()).To(Equal(2.0))
+		Expect(executeTick(integrator, 2, map[string]runtime.Reading{"input": runtime.NewReading(3)}).Value()).To(Equal(5.0))
+	})
+
+	It("resets the output", func() {
+		executeTick(integrator, 0, map[string]runtime.Reading{"input": runtime.NewReading(2)})
+		Expect(executeTick(integrator, 1, map[string]runtime.Reading{
+			"input": runtime.NewReading(2),
+			"reset": runtime.NewReading(1),
+		}).Value()).To(Equal(0.0))
+		Expect(executeTick(integrator, 2, map[string]runtime.Reading{
+			"input": runtime.NewReading(2),
+			"reset": runtime.NewReading(0),
+		}).Value()).To(Equal(2.0))
+	})
+
+	It("clamps the accumulated value", func() {
+		for tick := 0; tick < 10; tick++ {
+			Expect(executeTick(integrator, tick, map[string]runtime.Reading{
+				"input": runtime.NewReading(1),
+				"max":   runtime.NewReading(3),
+			}).Value()).To(BeNumerically("<=", 3.0))
+		}
+		// no windup beyond max
+		Expect(executeTick(integrator, 10, map[string]runtime.Reading{
+			"input": runtime.NewReading(-1),
+			"max":   runtime.NewReading(3),
+		}).Value()).To(Equal(2.0))
+	})
+})
+
+var _ = Describe("Differentiator", func() {
+	var differentiator runtime.Component
+
+	BeforeEach(func() {
+		var err error
+		differentiator, _, err = components.NewDifferentiatorAndOptions(&policylangv1.Differentiator{
+			Window: durationpb.New(2 * time.Second),
+		}, 0, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("emits the rate of change over the window", func() {
+		Expect(executeTick(differentiator, 0, map[string]runtime.Reading{"input": runtime.NewReading(0)}).Valid()).To(BeFalse())
+		Expect(executeTick(differentiator, 1, map[string]runtime.Reading{"input": runtime.NewReading(1)}).Value()).To(Equal(1.0))
+		Expect(executeTick(differentiator, 2, map[string]runtime.Reading{"input": runtime.NewReading(4)}).Value()).To(Equal(2.0))
+		Expect(executeTick(differentiator, 3, map[string]runtime.Reading{"input": runtime.NewReading(5)}).Value()).To(Equal(2.0))
+	})
+
+	It("shortens the window on invalid readings", func() {
+		executeTick(differentiator, 0, map[string]runtime.Reading{"input": runtime.NewReading(0)})
+		Expect(executeTick(differentiator, 1, map[string]runtime.Reading{"input": runtime.InvalidReading()}).Valid()).To(BeFalse())
+		Expect(executeTick(differentiator, 2, map[string]runtime.Reading{"input": runtime.NewReading(4)}).Value()).To(Equal(2.0))
+		Expect(executeTick(differentiator, 3, map[string]runtime.Reading{"input": runtime.NewReading(6)}).Value()).To(Equal(2.0))
+		Expect(executeTick(differentiator, 6, map[string]runtime.Reading{"input": runtime.NewReading(6)}).Valid()).To(BeFalse())
+	})
+})
+
+var _ = Describe("Delta", func() {
+	var delta runtime.Component
+
+	BeforeEach(func() {
+		var err error
+		delta, _, err = components.NewDeltaAndOptions(&policylangv1.Delta{}, 0, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("emits the difference of consecutive readings", func() {
+		Expect(executeTick(delta, 0, map[string]runtime.Reading{"input": runtime.NewReading(3)}).Valid()).To(BeFalse())
+		Expect(executeTick(delta, 1, map[string]runtime.Reading{"input": runtime.NewReading(5)}).Value()).To(Equal(2.0))
+		Expect(executeTick(delta, 2, map[string]runtime.Reading{"input": runtime.InvalidReading()}).Valid()).To(BeFalse())
+		Expect(executeTick(delta, 3, map[string]runtime.Reading{"input": runtime.NewReading(1)}).Valid()).To(BeFalse())
+		Expect(executeTick(delta, 4, map[string]runtime.Reading{"input": runtime.NewReading(0)}).Value()).To(Equal(-1.0))
+	})
+})
@@ -0,0 +1,58 @@
+package components
+
+import (
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+// Variable emits a value read from the dynamic config of the policy.
+type Variable struct {
+	policyReadAPI    iface.Policy
+	dynamicConfigKey string
+	// The value emitted while the dynamic config doesn't set the key.
+	defaultValue float64
+	value        float64
+}
+
+// Make sure Variable complies with Component interface.
+var _ runtime.Component = (*Variable)(nil)
+
+// NewVariableAndOptions creates a new Variable Component.
+func NewVariableAndOptions(variableProto *policylangv1.Variable, componentIndex int, policyReadAPI iface.Policy) (runtime.Component, fx.Option, error) {
+	defaultValue := variableProto.GetDefaultConfig().GetValue()
+	variable := &Variable{
+		policyReadAPI:    policyReadAPI,
+		dynamicConfigKey: variableProto.GetDynamicConfigKey(),
+		defaultValue:     defaultValue,
+		value:            defaultValue,
+	}
+	return variable, fx.Options(), nil
+}
+
+// Execute implements runtime.Component.Execute.
+func (variable *Variable) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	return runtime.PortToValue{
+		"output": []runtime.Reading{runtime.NewReading(variable.value)},
+	}, nil
+}
+
+// DynamicConfigUpdate sets the value from the dynamic config, or back to the default one if the key is not set.
+func (variable *Variable) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+	logger := variable.policyReadAPI.GetStatusRegistry().GetLogger()
+	if !unmarshaller.IsSet(variable.dynamicConfigKey) {
+		variable.value = variable.defaultValue
+		return
+	}
+	dynamicConfig := &policylangv1.Variable_DynamicConfig{}
+	if err := unmarshaller.UnmarshalKey(variable.dynamicConfigKey, dynamicConfig); err != nil {
+		logger.Error().Err(err).Str("key", variable.dynamicConfigKey).Msg("failed to unmarshal dynamic config")
+		return
+	}
+	variable.value = dynamicConfig.GetValue()
+	logger.Info().Str("key", variable.dynamicConfigKey).Float64("value", variable.value).Msg("variable dynamic config updated")
+}
@@ -0,0 +1,62 @@
+package components_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/components"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+var _ = Describe("Variable", func() {
+	var variable runtime.Component
+
+	value := func() float64 {
+		timestamp := time.Unix(0, 0)
+		tickInfo := runtime.NewTickInfo(timestamp, timestamp.Add(time.Second), 0, time.Second)
+		outPortReadings, err := variable.Execute(runtime.PortToValue{}, tickInfo)
+		Expect(err).NotTo(HaveOccurred())
+		return outPortReadings["output"][0].Value()
+	}
+
+	updateDynamicConfig := func(dynamicConfig string) {
+		unmarshaller, err := config.KoanfUnmarshallerConstructor{}.NewKoanfUnmarshaller([]byte(dynamicConfig))
+		Expect(err).NotTo(HaveOccurred())
+		variable.DynamicConfigUpdate(notifiers.Event{Type: notifiers.Write}, unmarshaller)
+	}
+
+	BeforeEach(func() {
+		var err error
+		variable, _, err = components.NewVariableAndOptions(&policylangv1.Variable{
+			DynamicConfigKey: "load_shed_floor",
+			DefaultConfig:    &policylangv1.Variable_DynamicConfig{Value: 0.2},
+		}, 0, testPolicy{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("emits the default value", func() {
+		Expect(value()).To(Equal(0.2))
+	})
+
+	It("emits the value from the dynamic config", func() {
+		updateDynamicConfig("load_shed_floor:\n  value: 0.5\n")
+		Expect(value()).To(Equal(0.5))
+	})
+
+	It("reverts to the default value when the key is removed", func() {
+		updateDynamicConfig("load_shed_floor:\n  value: 0.5\n")
+		updateDynamicConfig("other_key:\n  value: 0.7\n")
+		Expect(value()).To(Equal(0.2))
+	})
+
+	It("keeps the value on invalid dynamic config", func() {
+		updateDynamicConfig("load_shed_floor:\n  value: 0.5\n")
+		updateDynamicConfig("load_shed_floor:\n  value: [1, 2]\n")
+		Expect(value()).To(Equal(0.5))
+	})
+})
@@ -43,16 +43,18 @@ type policyFactory struct {
 	etcdClient           *etcdclient.Client
 	registry             status.Registry
 	dynamicConfigWatcher notifiers.Watcher
+	signalHistory        *SignalHistory
 }
 
 // Main fx app.
 func setupPolicyFxDriver(
-	etcdWatcher notifiers.Watcher,
-	dynamicConfigWatcher notifiers.Watcher,
+	policyTrackers notifiers.Trackers,
+	dynamicConfigTrackers notifiers.Trackers,
 	fxOptionsFuncs []notifiers.FxOptionsFunc,
 	etcdClient *etcdclient.Client,
 	lifecycle fx.Lifecycle,
 	registry status.Registry,
+	signalHistory *SignalHistory,
 ) error {
 	wrapPolicy := func(key notifiers.Key, bytes []byte, etype notifiers.EventType) (notifiers.Key, []byte, error) {
 		var dat []byte
@@ -99,7 +101,8 @@ func setupPolicyFxDriver(
 		registry:             policiesStatusRegistry,
 		circuitJobGroup:      circuitJobGroup,
 		etcdClient:           etcdClient,
-		dynamicConfigWatcher: dynamicConfigWatcher,
+		dynamicConfigWatcher: dynamicConfigTrackers,
+		signalHistory:        signalHistory,
 	}
 
 	optionsFunc := []notifiers.FxOptionsFunc{factory.provideControllerPolicyFxOptions}
@@ -136,7 +139,7 @@ func setupPolicyFxDriver(
 		},
 	})
 
-	notifiers.NotifierLifecycle(lifecycle, etcdWatcher, fxDriver)
+	notifiers.NotifierLifecycle(lifecycle, policyTrackers, fxDriver)
 	return nil
 }
 
@@ -171,6 +174,7 @@ func (factory *policyFactory) provideControllerPolicyFxOptions(
 			fx.Annotate(factory.dynamicConfigWatcher, fx.As(new(notifiers.Watcher))),
 			factory.circuitJobGroup,
 			factory.etcdClient,
+			factory.signalHistory,
 		),
 		policyFxOptions,
 	), nil
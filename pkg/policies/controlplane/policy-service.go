@@ -0,0 +1,157 @@
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"sigs.k8s.io/yaml"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/filesystem"
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/net/grpcgateway"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+)
+
+// policyServiceModule registers PolicyService handlers with grpc and grpc-gateway.
+func policyServiceModule() fx.Option {
+	return fx.Options(
+		grpcgateway.RegisterHandler{Handler: policylangv1.RegisterPolicyServiceHandlerFromEndpoint}.Annotate(),
+		fx.Invoke(
+			fx.Annotate(
+				RegisterPolicyService,
+				fx.ParamTags(``, ``, config.NameTag(policiesFxTag)),
+			),
+		),
+	)
+}
+
+// PolicyService is the implementation of policylangv1.PolicyServiceServer interface.
+type PolicyService struct {
+	policylangv1.UnimplementedPolicyServiceServer
+	policies map[string]*policylangv1.Policy
+	source   *filesystemSource
+	lock     sync.RWMutex
+}
+
+// RegisterPolicyService registers a service for policy.
+func RegisterPolicyService(
+	server *grpc.Server,
+	lifecycle fx.Lifecycle,
+	policyTrackers notifiers.Trackers,
+	source *filesystemSource,
+) {
+	svc := &PolicyService{
+		policies: make(map[string]*policylangv1.Policy),
+		source:   source,
+	}
+	notifiers.NotifierLifecycle(lifecycle, policyTrackers, &notifiers.BasicPrefixNotifier{NotifyFunc: svc.notify})
+	policylangv1.RegisterPolicyServiceServer(server, svc)
+}
+
+// notify keeps track of the policies loaded from all the sources.
+func (svc *PolicyService) notify(event notifiers.Event) {
+	svc.lock.Lock()
+	defer svc.lock.Unlock()
+
+	name := event.Key.String()
+	switch event.Type {
+	case notifiers.Write:
+		policy := &policylangv1.Policy{}
+		if err := config.UnmarshalYAML(event.Value, policy); err != nil {
+			log.Warn().Err(err).Str("policy", name).Msg("Failed to unmarshal policy")
+			delete(svc.policies, name)
+			return
+		}
+		svc.policies[name] = policy
+	case notifiers.Remove:
+		delete(svc.policies, name)
+	}
+}
+
+// AllPolicies returns all the policies loaded by the controller.
+func (svc *PolicyService) AllPolicies(ctx context.Context, _ *emptypb.Empty) (*policylangv1.AllPoliciesResponse, error) {
+	svc.lock.RLock()
+	defer svc.lock.RUnlock()
+
+	policies := make(map[string]*policylangv1.Policy, len(svc.policies))
+	for name, policy := range svc.policies {
+		policies[name] = policy
+	}
+	return &policylangv1.AllPoliciesResponse{
+		AllPolicies: &policylangv1.AllPolicies{
+			AllPolicies: policies,
+		},
+	}, nil
+}
+
+// UpsertPolicy validates the policy and writes it to the policies directory of the filesystem source.
+func (svc *PolicyService) UpsertPolicy(ctx context.Context, req *policylangv1.UpsertPolicyRequest) (*emptypb.Empty, error) {
+	fileInfo, err := svc.getPolicyFileInfo(req.PolicyName)
+	if err != nil {
+		return nil, err
+	}
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	jsonDat, err := json.Marshal(req.Policy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to marshal policy: %v", err)
+	}
+	dat, err := yaml.JSONToYAML(jsonDat)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to marshal policy: %v", err)
+	}
+
+	_, valid, msg, err := ValidateAndCompile(ctx, req.PolicyName, dat)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to validate policy: %v", err)
+	}
+	if !valid {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid policy: %s", msg)
+	}
+
+	if err := fileInfo.WriteByteBufferToFile(dat); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write policy: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeletePolicy removes the policy from the policies directory of the filesystem source.
+func (svc *PolicyService) DeletePolicy(ctx context.Context, req *policylangv1.DeletePolicyRequest) (*emptypb.Empty, error) {
+	fileInfo, err := svc.getPolicyFileInfo(req.PolicyName)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := fileInfo.ExistsFile()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to stat policy: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "policy %q not found in %s", req.PolicyName, svc.source.policiesPath)
+	}
+
+	if err := fileInfo.RemoveFile(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove policy: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (svc *PolicyService) getPolicyFileInfo(name string) (*filesystem.FileInfo, error) {
+	if !svc.source.enabled {
+		return nil, status.Error(codes.FailedPrecondition, "filesystem policy source is disabled")
+	}
+	if name == "" || name != filesystem.ParseFilePath(name).GetFileName() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid policy name %q", name)
+	}
+	return filesystem.NewFileInfo(svc.source.policiesPath, name, config.DefaultConfigFileExt), nil
+}
@@ -0,0 +1,175 @@
+package controlplane
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"go.uber.org/fx"
+	"k8s.io/client-go/rest"
+
+	"github.com/fluxninja/aperture/pkg/config"
+	fswatcher "github.com/fluxninja/aperture/pkg/filesystem/watcher"
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/crwatcher"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+)
+
+const (
+	policiesKubernetesFxTag              = "PoliciesKubernetes"
+	policiesKubernetesDynamicConfigFxTag = "PoliciesKubernetesDynamicConfig"
+	policiesFilesystemConfigKey          = iface.PoliciesRoot + ".filesystem"
+)
+
+var (
+	// defaultPoliciesPath is the default directory that is watched for policy files.
+	defaultPoliciesPath = path.Join(config.DefaultAssetsDirectory, "policies")
+	// defaultDynamicConfigPath is the default directory that is watched for dynamic config files of policies.
+	defaultDynamicConfigPath = path.Join(config.DefaultAssetsDirectory, "dynamic-configs")
+)
+
+// PoliciesFilesystemConfig holds configuration for the filesystem policy source.
+// swagger:model
+type PoliciesFilesystemConfig struct {
+	// Directory that is watched for policy files. Each `<policy_name>.yaml` file contains a single policy. "default" path is `/etc/aperture/aperture-controller/policies`.
+	PoliciesPath string `json:"policies_path" default:"default"`
+	// Directory that is watched for dynamic config files of policies. Each `<policy_name>.yaml` file contains the dynamic config of the policy with the same name. "default" path is `/etc/aperture/aperture-controller/dynamic-configs`.
+	DynamicConfigPath string `json:"dynamic_config_path" default:"default"`
+	// Disables the filesystem policy source.
+	Disable bool `json:"disable" default:"false"`
+}
+
+// filesystemSource is a policy source backed by a directory of policy files.
+type filesystemSource struct {
+	policiesPath string
+	enabled      bool
+}
+
+// policySourcesModule merges policies from all the sources into policiesFxTag and policiesDynamicConfigFxTag trackers.
+func policySourcesModule() fx.Option {
+	options := []fx.Option{
+		notifiers.TrackersConstructor{Name: policiesFxTag}.Annotate(),
+		notifiers.TrackersConstructor{Name: policiesDynamicConfigFxTag}.Annotate(),
+		fx.Provide(
+			fx.Annotate(
+				provideFilesystemSource,
+				fx.ParamTags(
+					``,
+					``,
+					config.NameTag(policiesFxTag),
+					config.NameTag(policiesDynamicConfigFxTag),
+				),
+			),
+		),
+	}
+
+	_, err := rest.InClusterConfig()
+	if err == rest.ErrNotInCluster {
+		log.Info().Msg("K8s environment not detected. Policy custom resources will not be watched.")
+	} else if err != nil {
+		log.Warn().Err(err).Msg("Error when discovering k8s environment. Policy custom resources will not be watched.")
+	} else {
+		options = append(options,
+			crwatcher.Constructor{Name: policiesKubernetesFxTag, DynamicConfigName: policiesKubernetesDynamicConfigFxTag}.Annotate(),
+			fx.Invoke(
+				fx.Annotate(
+					forwardPolicySource,
+					fx.ParamTags(
+						``,
+						config.NameTag(policiesKubernetesFxTag),
+						config.NameTag(policiesKubernetesDynamicConfigFxTag),
+						config.NameTag(policiesFxTag),
+						config.NameTag(policiesDynamicConfigFxTag),
+					),
+				),
+			),
+		)
+	}
+
+	return fx.Options(options...)
+}
+
+// provideFilesystemSource watches the policy and dynamic config directories and forwards the files to policy trackers.
+func provideFilesystemSource(
+	unmarshaller config.Unmarshaller,
+	lifecycle fx.Lifecycle,
+	policyTrackers notifiers.Trackers,
+	dynamicConfigTrackers notifiers.Trackers,
+) (*filesystemSource, error) {
+	var fsConfig PoliciesFilesystemConfig
+	if err := unmarshaller.UnmarshalKey(policiesFilesystemConfigKey, &fsConfig); err != nil {
+		log.Error().Err(err).Msg("Unable to deserialize policies filesystem configuration!")
+		return nil, err
+	}
+	if fsConfig.PoliciesPath == "default" {
+		fsConfig.PoliciesPath = defaultPoliciesPath
+	}
+	if fsConfig.DynamicConfigPath == "default" {
+		fsConfig.DynamicConfigPath = defaultDynamicConfigPath
+	}
+
+	source := &filesystemSource{
+		policiesPath: fsConfig.PoliciesPath,
+	}
+	if fsConfig.Disable {
+		return source, nil
+	}
+
+	for _, directory := range []string{fsConfig.PoliciesPath, fsConfig.DynamicConfigPath} {
+		if err := os.MkdirAll(directory, os.ModePerm); err != nil {
+			log.Warn().Err(err).Str("directory", directory).Msg("Unable to create directory. Filesystem policy source is disabled.")
+			return source, nil
+		}
+	}
+
+	policyWatcher, err := fswatcher.NewWatcher(fsConfig.PoliciesPath, config.DefaultConfigFileExt)
+	if err != nil {
+		return nil, err
+	}
+	dynamicConfigWatcher, err := fswatcher.NewWatcher(fsConfig.DynamicConfigPath, config.DefaultConfigFileExt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range []notifiers.Watcher{policyWatcher, dynamicConfigWatcher} {
+		watcher := w
+		lifecycle.Append(fx.Hook{
+			OnStart: func(context.Context) error {
+				return watcher.Start()
+			},
+			OnStop: func(context.Context) error {
+				return watcher.Stop()
+			},
+		})
+	}
+
+	forwardPolicySource(lifecycle, policyWatcher, dynamicConfigWatcher, policyTrackers, dynamicConfigTrackers)
+	source.enabled = true
+	return source, nil
+}
+
+// forwardPolicySource forwards events from policy source watchers to policy trackers.
+func forwardPolicySource(
+	lifecycle fx.Lifecycle,
+	policyWatcher notifiers.Watcher,
+	dynamicConfigWatcher notifiers.Watcher,
+	policyTrackers notifiers.Trackers,
+	dynamicConfigTrackers notifiers.Trackers,
+) {
+	notifiers.NotifierLifecycle(lifecycle, policyWatcher, newForwardingNotifier(policyTrackers))
+	notifiers.NotifierLifecycle(lifecycle, dynamicConfigWatcher, newForwardingNotifier(dynamicConfigTrackers))
+}
+
+func newForwardingNotifier(trackers notifiers.Trackers) notifiers.PrefixNotifier {
+	return &notifiers.BasicPrefixNotifier{
+		NotifyFunc: func(event notifiers.Event) {
+			switch event.Type {
+			case notifiers.Write:
+				trackers.WriteEvent(event.Key, event.Value)
+			case notifiers.Remove:
+				trackers.RemoveEvent(event.Key)
+			}
+		},
+	}
+}
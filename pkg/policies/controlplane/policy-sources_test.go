@@ -0,0 +1,254 @@
+package controlplane
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+)
+
+const testPolicy = `
+circuit:
+  evaluation_interval: "0.5s"
+  components:
+    - constant:
+        value: "250.0"
+        out_ports:
+          output:
+            signal_name: "RATE_LIMIT"
+    - rate_limiter:
+        in_ports:
+          limit:
+            signal_name: "RATE_LIMIT"
+        selector:
+          service_selector:
+            service: "service1-demo-app.demoapp.svc.cluster.local"
+          flow_selector:
+            control_point:
+              traffic: "ingress"
+        label_key: "http.request.header.user_type"
+        limit_reset_interval: "1s"
+`
+
+// invalidTestPolicy uses a signal that no component emits.
+const invalidTestPolicy = `
+circuit:
+  evaluation_interval: "0.5s"
+  components:
+    - rate_limiter:
+        in_ports:
+          limit:
+            signal_name: "RATE_LIMIT"
+        selector:
+          service_selector:
+            service: "service1-demo-app.demoapp.svc.cluster.local"
+          flow_selector:
+            control_point:
+              traffic: "ingress"
+        label_key: "http.request.header.user_type"
+        limit_reset_interval: "1s"
+`
+
+func unmarshalTestPolicy(src string) *policylangv1.Policy {
+	policy := &policylangv1.Policy{}
+	Expect(config.UnmarshalYAML([]byte(src), policy)).To(Succeed())
+	return policy
+}
+
+var _ = Describe("Filesystem policy source", func() {
+	var (
+		lifecycle             *fxtest.Lifecycle
+		policyTrackers        *notifiers.DefaultTrackers
+		dynamicConfigTrackers *notifiers.DefaultTrackers
+		policiesPath          string
+		dynamicConfigPath     string
+	)
+
+	BeforeEach(func() {
+		policiesPath = filepath.Join(GinkgoT().TempDir(), "policies")
+		dynamicConfigPath = filepath.Join(GinkgoT().TempDir(), "dynamic-configs")
+		unmarshaller, err := config.KoanfUnmarshallerConstructor{
+			MergeConfig: map[string]interface{}{
+				"policies": map[string]interface{}{
+					"filesystem": map[string]interface{}{
+						"policies_path":       policiesPath,
+						"dynamic_config_path": dynamicConfigPath,
+					},
+				},
+			},
+		}.NewKoanfUnmarshaller([]byte{})
+		Expect(err).NotTo(HaveOccurred())
+
+		lifecycle = fxtest.NewLifecycle(GinkgoT())
+		policyTrackers = notifiers.NewDefaultTrackers()
+		dynamicConfigTrackers = notifiers.NewDefaultTrackers()
+		for _, t := range []*notifiers.DefaultTrackers{policyTrackers, dynamicConfigTrackers} {
+			trackers := t
+			lifecycle.Append(fx.Hook{
+				OnStart: func(context.Context) error { return trackers.Start() },
+				OnStop:  func(context.Context) error { return trackers.Stop() },
+			})
+		}
+
+		source, err := provideFilesystemSource(unmarshaller, lifecycle, policyTrackers, dynamicConfigTrackers)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(source.enabled).To(BeTrue())
+		Expect(source.policiesPath).To(Equal(policiesPath))
+		lifecycle.RequireStart()
+	})
+
+	AfterEach(func() {
+		lifecycle.RequireStop()
+	})
+
+	It("forwards policy files to the trackers", func() {
+		Expect(os.WriteFile(filepath.Join(policiesPath, "limits.yaml"), []byte(testPolicy), 0o600)).To(Succeed())
+		Eventually(func() []byte {
+			return policyTrackers.GetCurrentValue(notifiers.Key("limits"))
+		}).Should(Equal([]byte(testPolicy)))
+
+		Expect(os.Remove(filepath.Join(policiesPath, "limits.yaml"))).To(Succeed())
+		Eventually(func() []byte {
+			return policyTrackers.GetCurrentValue(notifiers.Key("limits"))
+		}).Should(BeNil())
+	})
+
+	It("forwards dynamic config files to the trackers", func() {
+		dynamicConfig := []byte("overrides: []\n")
+		Expect(os.WriteFile(filepath.Join(dynamicConfigPath, "limits.yaml"), dynamicConfig, 0o600)).To(Succeed())
+		Eventually(func() []byte {
+			return dynamicConfigTrackers.GetCurrentValue(notifiers.Key("limits"))
+		}).Should(Equal(dynamicConfig))
+	})
+})
+
+var _ = Describe("Policy service", func() {
+	var (
+		svc          *PolicyService
+		policiesPath string
+	)
+
+	BeforeEach(func() {
+		root := GinkgoT().TempDir()
+		policiesPath = filepath.Join(root, "policies")
+		Expect(os.MkdirAll(policiesPath, os.ModePerm)).To(Succeed())
+		svc = &PolicyService{
+			policies: make(map[string]*policylangv1.Policy),
+			source: &filesystemSource{
+				policiesPath: policiesPath,
+				enabled:      true,
+			},
+		}
+	})
+
+	expectCode := func(err error, code codes.Code) {
+		Expect(err).To(HaveOccurred())
+		Expect(status.Code(err)).To(Equal(code))
+	}
+
+	policyFiles := func() []string {
+		entries, err := os.ReadDir(filepath.Dir(policiesPath))
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		policies, err := os.ReadDir(policiesPath)
+		Expect(err).NotTo(HaveOccurred())
+		for _, entry := range policies {
+			names = append(names, filepath.Join("policies", entry.Name()))
+		}
+		return names
+	}
+
+	It("writes valid policies to the policies directory", func() {
+		_, err := svc.UpsertPolicy(context.Background(), &policylangv1.UpsertPolicyRequest{
+			PolicyName: "limits",
+			Policy:     unmarshalTestPolicy(testPolicy),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		dat, err := os.ReadFile(filepath.Join(policiesPath, "limits.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+		_, valid, msg, err := ValidateAndCompile(context.Background(), "limits", dat)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valid).To(BeTrue(), msg)
+	})
+
+	It("rejects invalid policies", func() {
+		_, err := svc.UpsertPolicy(context.Background(), &policylangv1.UpsertPolicyRequest{
+			PolicyName: "limits",
+			Policy:     unmarshalTestPolicy(invalidTestPolicy),
+		})
+		expectCode(err, codes.InvalidArgument)
+
+		_, err = svc.UpsertPolicy(context.Background(), &policylangv1.UpsertPolicyRequest{
+			PolicyName: "limits",
+		})
+		expectCode(err, codes.InvalidArgument)
+		Expect(policyFiles()).To(Equal([]string{"policies"}))
+	})
+
+	DescribeTable("rejects policy names escaping the policies directory",
+		func(name string) {
+			_, err := svc.UpsertPolicy(context.Background(), &policylangv1.UpsertPolicyRequest{
+				PolicyName: name,
+				Policy:     unmarshalTestPolicy(testPolicy),
+			})
+			expectCode(err, codes.InvalidArgument)
+			_, err = svc.DeletePolicy(context.Background(), &policylangv1.DeletePolicyRequest{
+				PolicyName: name,
+			})
+			expectCode(err, codes.InvalidArgument)
+			Expect(policyFiles()).To(Equal([]string{"policies"}))
+		},
+		Entry("empty", ""),
+		Entry("parent directory", ".."),
+		Entry("current directory", "."),
+		Entry("relative path", "../limits"),
+		Entry("nested path", "nested/limits"),
+		Entry("absolute path", "/tmp/limits"),
+		Entry("path resolving to a plain name", "nested/../limits"),
+		Entry("name with extension", "limits.yaml"),
+		Entry("hidden file", ".limits"),
+	)
+
+	It("deletes policies", func() {
+		Expect(os.WriteFile(filepath.Join(policiesPath, "limits.yaml"), []byte(testPolicy), 0o600)).To(Succeed())
+		_, err := svc.DeletePolicy(context.Background(), &policylangv1.DeletePolicyRequest{
+			PolicyName: "limits",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policyFiles()).To(Equal([]string{"policies"}))
+	})
+
+	It("reports deletion of missing policies", func() {
+		_, err := svc.DeletePolicy(context.Background(), &policylangv1.DeletePolicyRequest{
+			PolicyName: "limits",
+		})
+		expectCode(err, codes.NotFound)
+	})
+
+	It("rejects changes when the filesystem source is disabled", func() {
+		svc.source.enabled = false
+		_, err := svc.UpsertPolicy(context.Background(), &policylangv1.UpsertPolicyRequest{
+			PolicyName: "limits",
+			Policy:     unmarshalTestPolicy(testPolicy),
+		})
+		expectCode(err, codes.FailedPrecondition)
+		_, err = svc.DeletePolicy(context.Background(), &policylangv1.DeletePolicyRequest{
+			PolicyName: "limits",
+		})
+		expectCode(err, codes.FailedPrecondition)
+	})
+})
@@ -79,12 +79,18 @@ func newPolicyOptions(
 	}
 
 	// Create circuit
-	circuit, circuitOption := runtime.NewCircuitAndOptions(compWithPortsList, policy)
+	circuit, circuitOption, err := runtime.NewCircuitAndOptions(compWithPortsList, policy)
+	if err != nil {
+		return nil, err
+	}
 	policyOptions = append(policyOptions, circuitOption)
 
 	policyOptions = append(policyOptions, componentFactoryModuleForPolicyApp(circuit))
 
 	policyOptions = append(policyOptions, fx.Supply(fx.Annotate(circuit, fx.As(new(runtime.CircuitAPI)))))
+	policyOptions = append(policyOptions, fx.Invoke(func(signalHistory *SignalHistory, lifecycle fx.Lifecycle) {
+		signalHistory.setupPolicySignalHistory(circuit, lifecycle)
+	}))
 	policy.circuit = circuit
 
 	return fx.Options(policyOptions...), nil
@@ -147,6 +153,8 @@ func compilePolicyWrapper(wrapperMessage *wrappersv1.PolicyWrapper, registry sta
 		// Read evaluation interval
 		policy.evaluationInterval = policyProto.GetCircuit().GetEvaluationInterval().AsDuration()
 
+		applyPolicyRejectionResponse(policyProto)
+
 		compiledCircuit, partialCircuitOption, err = compileCircuit(policyProto.GetCircuit().Components, policy)
 		if err != nil {
 			return nil, nil, nil, err
@@ -285,3 +293,22 @@ func hashAndPolicyWrap(policyMessage *policylangv1.Policy, policyName string) (*
 		},
 	}, nil
 }
+
+// applyPolicyRejectionResponse sets the rejection response of the policy on the limiters that do not have their own.
+func applyPolicyRejectionResponse(policyProto *policylangv1.Policy) {
+	rejectionResponse := policyProto.GetRejectionResponse()
+	if rejectionResponse == nil {
+		return
+	}
+	for _, componentProto := range policyProto.GetCircuit().GetComponents() {
+		if rateLimiter := componentProto.GetRateLimiter(); rateLimiter != nil && rateLimiter.RejectionResponse == nil {
+			rateLimiter.RejectionResponse = proto.Clone(rejectionResponse).(*policylangv1.RejectionResponse)
+		}
+		if quotaLimiter := componentProto.GetQuotaLimiter(); quotaLimiter != nil && quotaLimiter.RejectionResponse == nil {
+			quotaLimiter.RejectionResponse = proto.Clone(rejectionResponse).(*policylangv1.RejectionResponse)
+		}
+		if concurrencyLimiter := componentProto.GetConcurrencyLimiter(); concurrencyLimiter != nil && concurrencyLimiter.RejectionResponse == nil {
+			concurrencyLimiter.RejectionResponse = proto.Clone(rejectionResponse).(*policylangv1.RejectionResponse)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package controlplane
 
 import (
-	"github.com/fluxninja/aperture/pkg/policies/controlplane/crwatcher"
 	"go.uber.org/fx"
 )
 
@@ -18,13 +17,25 @@ const (
 //   in: body
 //   schema:
 //     "$ref": "#/definitions/JobGroupConfig"
+// - name: filesystem
+//   in: body
+//   schema:
+//     "$ref": "#/definitions/PoliciesFilesystemConfig"
+// - name: signal_history
+//   in: body
+//   schema:
+//     "$ref": "#/definitions/SignalHistoryConfig"
 
 // Module - Controller can be initialized by passing options from Module() to fx app.
 func Module() fx.Option {
 	return fx.Options(
 		fx.Provide(providePolicyValidator),
-		// Syncing policies config to etcd
-		crwatcher.Constructor{Name: policiesFxTag, DynamicConfigName: policiesDynamicConfigFxTag}.Annotate(), // Create a new watcher
+		// Policies from Kubernetes custom resources and filesystem
+		policySourcesModule(),
+		// Policy service for listing and managing policies
+		policyServiceModule(),
+		// Signal history of policies and the service for reading and streaming it
+		signalServiceModule(),
 		// Policy factory
 		policyFactoryModule(),
 	)
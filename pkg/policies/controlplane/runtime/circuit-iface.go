@@ -13,6 +13,7 @@ type CircuitAPI interface {
 	iface.Policy
 	RegisterTickEndCallback(ec TickEndCallback)
 	RegisterTickStartCallback(sc TickStartCallback)
+	GetSignalReadings() map[string]Reading
 	LockExecution()
 	UnlockExecution()
 }
@@ -105,6 +105,8 @@ type Circuit struct {
 	executionLock sync.Mutex
 	// Looped signals persistence across ticks
 	loopedSignals signalToReading
+	// Signal readings of the tick being executed
+	signalReadings signalToReading
 	// Components
 	components []CompiledComponentAndPorts
 	// Tick end callbacks
@@ -117,43 +119,39 @@ type Circuit struct {
 var _ CircuitAPI = &Circuit{}
 
 // NewCircuitAndOptions create a new Circuit struct along with fx options.
+// The execution order of components is computed once here, see ExecutionOrder.
 func NewCircuitAndOptions(compWithPortsList []CompiledComponentAndPorts,
 	policyReadAPI iface.Policy,
-) (*Circuit, fx.Option) {
+) (*Circuit, fx.Option, error) {
+	order, err := ExecutionOrder(compWithPortsList)
+	if err != nil {
+		return nil, fx.Options(), err
+	}
+
 	circuit := &Circuit{
 		Policy:        policyReadAPI,
 		loopedSignals: make(signalToReading),
-		components:    make([]CompiledComponentAndPorts, 0),
+		components:    make([]CompiledComponentAndPorts, 0, len(compWithPortsList)),
 	}
 
-	// setComponents sets the Components for a Circuit
-	setComponents := func(components []CompiledComponentAndPorts) error {
-		if len(circuit.components) > 0 {
-			return errors.New("circuit already has components")
-		}
-		circuit.components = components
-		// Populate loopedSignals
-		for _, component := range components {
-			for _, outPort := range component.OutPortToSignalsMap {
-				for _, signal := range outPort {
-					if signal.Looped {
-						circuit.loopedSignals[signal] = InvalidReading()
-					}
+	// Set components in circuit in execution order
+	for _, cmpIdx := range order {
+		circuit.components = append(circuit.components, compWithPortsList[cmpIdx])
+	}
+	// Populate loopedSignals
+	for _, component := range circuit.components {
+		for _, outPort := range component.OutPortToSignalsMap {
+			for _, signal := range outPort {
+				if signal.Looped {
+					circuit.loopedSignals[signal] = InvalidReading()
 				}
 			}
 		}
-		return nil
-	}
-
-	// Set components in circuit
-	err := setComponents(compWithPortsList)
-	if err != nil {
-		return nil, fx.Options()
 	}
 
 	return circuit, fx.Options(
 		fx.Invoke(circuit.setup),
-	)
+	), nil
 }
 
 // Setup handle lifecycle of the inner metrics of Circuit.
@@ -214,6 +212,7 @@ func (circuit *Circuit) Execute(tickInfo TickInfo) error {
 	}
 	// Signals for this tick
 	circuitSignalReadings := make(signalToReading)
+	circuit.signalReadings = circuitSignalReadings
 	defer func() {
 		// log all circuitSignalReadings
 		for signal, reading := range circuitSignalReadings {
@@ -237,116 +236,75 @@ func (circuit *Circuit) Execute(tickInfo TickInfo) error {
 	}
 	// Clear looped signals for next tick
 	circuit.loopedSignals = signalToReading{}
-	// Map of executed components for this tick
-	executedComponents := make(map[int]bool)
-	// Number of components executed
-	var numExecutedBefore, numExecutedAfter int
-	// Loop rounds until no components are executed
-	for len(executedComponents) < len(circuit.components) {
-		numExecutedBefore = len(executedComponents)
-
-	OUTER:
-		// Check readiness by component and execute if ready
-		for cmpIdx, cmp := range circuit.components {
-			componentInPortReadings := make(PortToValue)
-			// Skip if already executed
-			if executedComponents[cmpIdx] {
-				continue
-			}
-			// Check readiness of cmp by checking in_ports
-			for port, sigs := range cmp.InPortToSignalsMap {
-				// Reading list for this port
-				readingList := make([]Reading, len(sigs))
-				// Check if all the sig(s) in sigs are ready
-				for index, sig := range sigs {
-					if sigReading, ok := circuitSignalReadings[sig]; ok {
-						// Set sigReading in readingList at index
-						readingList[index] = sigReading
-					} else {
-						// not ready yet
-						continue OUTER
-					}
+	// Execute components in the precomputed order, all the in_port signals of a component are ready by the time it runs
+	for _, cmp := range circuit.components {
+		componentInPortReadings := make(PortToValue, len(cmp.InPortToSignalsMap))
+		for port, sigs := range cmp.InPortToSignalsMap {
+			// Reading list for this port
+			readingList := make([]Reading, len(sigs))
+			for index, sig := range sigs {
+				sigReading, ok := circuitSignalReadings[sig]
+				if !ok {
+					// Create error message
+					errMsg := fmt.Sprintf("unexpected state: signal %s at port %s of component %s is not ready. abort circuit execution", sig.Name, port, cmp.CompiledComponent.Name)
+					// Log error
+					logger.Error().Msg(errMsg)
+					return errors.New(errMsg)
 				}
-				// All the sig(s) in sigs are ready, set readingList in componentInPortReadings
-				componentInPortReadings[port] = readingList
+				readingList[index] = sigReading
 			}
-			// log the component being executed
-			logger.Trace().Str("component", cmp.CompiledComponent.Name).
-				Int("tick", tickInfo.Tick()).
-				Interface("in_ports", componentInPortReadings).
-				Interface("InPortToSignalsMap", cmp.InPortToSignalsMap).
-				Msg("Executing component")
-			// If control reaches this point, the component is ready to execute
-			componentOutPortReadings, err := cmp.CompiledComponent.Component.Execute(
-				/* pass signal */
-				componentInPortReadings,
-				/* pass tick info */
-				tickInfo,
-			)
-			if componentOutPortReadings == nil {
-				componentOutPortReadings = make(PortToValue)
-			}
-			// Update executedComponents
-			executedComponents[cmpIdx] = true
+			componentInPortReadings[port] = readingList
+		}
+		// log the component being executed
+		logger.Trace().Str("component", cmp.CompiledComponent.Name).
+			Int("tick", tickInfo.Tick()).
+			Interface("in_ports", componentInPortReadings).
+			Interface("InPortToSignalsMap", cmp.InPortToSignalsMap).
+			Msg("Executing component")
+		componentOutPortReadings, err := cmp.CompiledComponent.Component.Execute(
+			/* pass signal */
+			componentInPortReadings,
+			/* pass tick info */
+			tickInfo,
+		)
+		if componentOutPortReadings == nil {
+			componentOutPortReadings = make(PortToValue)
+		}
 
-			if err != nil {
-				// Append err to errMulti
-				errMulti = multierr.Append(errMulti, err)
-			}
-			// Fill any missing values from cmp.outPortsMapping in componentOutPortReadings with invalid readings
-			for port, signals := range cmp.OutPortToSignalsMap {
-				if _, ok := componentOutPortReadings[port]; !ok {
-					// Fill with invalid readings
-					componentOutPortReadings[port] = make([]Reading, len(signals))
-					for index := range signals {
-						componentOutPortReadings[port][index] = InvalidReading()
-					}
-				} else if len(componentOutPortReadings[port]) < len(signals) {
-					// The reading list has fewer readings compared to portOutsSpec
-					// Fill with invalid readings
-					for index := len(componentOutPortReadings[port]); index < len(signals); index++ {
-						componentOutPortReadings[port][index] = InvalidReading()
-					}
+		if err != nil {
+			// Append err to errMulti
+			errMulti = multierr.Append(errMulti, err)
+		}
+		// Fill any missing values from cmp.outPortsMapping in componentOutPortReadings with invalid readings
+		for port, signals := range cmp.OutPortToSignalsMap {
+			if _, ok := componentOutPortReadings[port]; !ok {
+				// Fill with invalid readings
+				componentOutPortReadings[port] = make([]Reading, len(signals))
+				for index := range signals {
+					componentOutPortReadings[port][index] = InvalidReading()
+				}
+			} else if len(componentOutPortReadings[port]) < len(signals) {
+				// The reading list has fewer readings compared to portOutsSpec
+				// Fill with invalid readings
+				for index := len(componentOutPortReadings[port]); index < len(signals); index++ {
+					componentOutPortReadings[port] = append(componentOutPortReadings[port], InvalidReading())
 				}
 			}
-			// Update circuitSignalReadings with componentOutPortReadings while iterating through outPortsMapping
-			for port, signals := range cmp.OutPortToSignalsMap {
-				for index, sig := range signals {
-					readings, ok := componentOutPortReadings[port]
-					if !ok {
-						// Create error message
-						errMsg := fmt.Sprintf("unexpected state: port %s is not defined in componentOutPortReadings. abort circuit execution", port)
-						// Log error
-						logger.Error().Msg(errMsg)
-						return errors.New(errMsg)
-					}
-					// check presence of index in readings
-					if index >= len(readings) {
-						// Create error message
-						errMsg := fmt.Sprintf("unexpected state: index %d is out of range in port %s. abort circuit execution", index, port)
-						// Log error
-						logger.Error().Msg(errMsg)
-						return errors.New(errMsg)
-					}
-					if sig.Looped {
-						// Looped signals are stored in circuit.loopedSignals for the next round
-						circuit.loopedSignals[sig] = readings[index]
-						// Store the reading in circuitSignalReadings under the same signal name without the looped flag
-						circuitSignalReadings[Signal{Name: sig.Name, Looped: false}] = readings[index]
-					} else {
-						// Store the reading in circuitSignalReadings
-						circuitSignalReadings[sig] = readings[index]
-					}
+		}
+		// Update circuitSignalReadings with componentOutPortReadings while iterating through outPortsMapping
+		for port, signals := range cmp.OutPortToSignalsMap {
+			readings := componentOutPortReadings[port]
+			for index, sig := range signals {
+				if sig.Looped {
+					// Looped signals are stored in circuit.loopedSignals for the next round
+					circuit.loopedSignals[sig] = readings[index]
+					// Store the reading in circuitSignalReadings under the same signal name without the looped flag
+					circuitSignalReadings[Signal{Name: sig.Name, Looped: false}] = readings[index]
+				} else {
+					// Store the reading in circuitSignalReadings
+					circuitSignalReadings[sig] = readings[index]
 				}
 			}
-		} // this is the component for loop
-		numExecutedAfter = len(executedComponents)
-
-		// Return with error if there is no change in number of executed components.
-		if numExecutedBefore == numExecutedAfter {
-			errMsg := fmt.Sprintf("circuit execution failed. number of executed components (%d) remained same across consecutive rounds", numExecutedBefore)
-			logger.Error().Msg(errMsg)
-			return errors.New(errMsg)
 		}
 	}
 	// Invoke TickEndCallback(s)
@@ -359,6 +317,9 @@ func (circuit *Circuit) Execute(tickInfo TickInfo) error {
 
 // DynamicConfigUpdate updates the circuit with the new dynamic config.
 func (circuit *Circuit) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+	// Components are not updated in the middle of a tick
+	circuit.LockExecution()
+	defer circuit.UnlockExecution()
 	// loop through all the components
 	for _, cmp := range circuit.components {
 		component := cmp.CompiledComponent.Component
@@ -367,6 +328,19 @@ func (circuit *Circuit) DynamicConfigUpdate(event notifiers.Event, unmarshaller
 	}
 }
 
+// GetSignalReadings returns the readings of the signals computed in the current tick, keyed by signal name.
+// It is meant to be called from a TickEndCallback, while the circuit execution lock is held.
+func (circuit *Circuit) GetSignalReadings() map[string]Reading {
+	signalReadings := make(map[string]Reading, len(circuit.signalReadings))
+	for signal, reading := range circuit.signalReadings {
+		// Looped signals hold the readings of the previous tick, current readings are stored without the looped flag
+		if !signal.Looped {
+			signalReadings[signal.Name] = reading
+		}
+	}
+	return signalReadings
+}
+
 // LockExecution locks the execution of the circuit.
 func (circuit *Circuit) LockExecution() {
 	circuit.executionLock.Lock()
@@ -0,0 +1,105 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExecutionOrder returns the order in which components need to be executed so that all the input signals of a component
+// are computed before the component runs. Looped signals are read from the previous tick, hence they do not constrain the order.
+// An error naming the involved components is returned if the components form a cycle that is not broken by a looped signal.
+func ExecutionOrder(components []CompiledComponentAndPorts) ([]int, error) {
+	// Map from signal name to the index of the component which emits the signal.
+	producers := make(map[string]int)
+	for cmpIdx, cmp := range components {
+		for _, signals := range cmp.OutPortToSignalsMap {
+			for _, signal := range signals {
+				producers[signal.Name] = cmpIdx
+			}
+		}
+	}
+
+	// Build the dependency graph, edges point from producer to consumer.
+	consumers := make([][]int, len(components))
+	inDegree := make([]int, len(components))
+	for cmpIdx, cmp := range components {
+		dependencies := make(map[int]bool)
+		for port, signals := range cmp.InPortToSignalsMap {
+			for _, signal := range signals {
+				if signal.Looped {
+					continue
+				}
+				producer, ok := producers[signal.Name]
+				if !ok {
+					return nil, fmt.Errorf("undefined signal %s at in_port %s of component %s", signal.Name, port, componentLabel(components, cmpIdx))
+				}
+				if !dependencies[producer] {
+					dependencies[producer] = true
+					consumers[producer] = append(consumers[producer], cmpIdx)
+					inDegree[cmpIdx]++
+				}
+			}
+		}
+	}
+
+	// Kahn's algorithm. Components that are ready are executed in the order they were defined.
+	order := make([]int, 0, len(components))
+	for cmpIdx := range components {
+		if inDegree[cmpIdx] == 0 {
+			order = append(order, cmpIdx)
+		}
+	}
+	for i := 0; i < len(order); i++ {
+		for _, consumer := range consumers[order[i]] {
+			inDegree[consumer]--
+			if inDegree[consumer] == 0 {
+				order = append(order, consumer)
+			}
+		}
+	}
+
+	if len(order) < len(components) {
+		return nil, cycleError(components, consumers, order)
+	}
+	return order, nil
+}
+
+// cycleError reports the components that could not be ordered, leaving out the ones that merely depend on a cycle.
+func cycleError(components []CompiledComponentAndPorts, consumers [][]int, order []int) error {
+	remaining := make(map[int]bool)
+	for cmpIdx := range components {
+		remaining[cmpIdx] = true
+	}
+	for _, cmpIdx := range order {
+		delete(remaining, cmpIdx)
+	}
+	// Repeatedly remove components that do not feed any other remaining component.
+	for pruned := true; pruned; {
+		pruned = false
+		for cmpIdx := range remaining {
+			feedsRemaining := false
+			for _, consumer := range consumers[cmpIdx] {
+				if remaining[consumer] {
+					feedsRemaining = true
+					break
+				}
+			}
+			if !feedsRemaining {
+				delete(remaining, cmpIdx)
+				pruned = true
+			}
+		}
+	}
+
+	labels := make([]string, 0, len(remaining))
+	for cmpIdx := range components {
+		if remaining[cmpIdx] {
+			labels = append(labels, componentLabel(components, cmpIdx))
+		}
+	}
+	return fmt.Errorf("circuit contains a cycle without a looped signal between components: %s", strings.Join(labels, ", "))
+}
+
+func componentLabel(components []CompiledComponentAndPorts, cmpIdx int) string {
+	return fmt.Sprintf("%s[%d]", components[cmpIdx].CompiledComponent.Name, cmpIdx)
+}
@@ -0,0 +1,60 @@
+package runtime
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func testComponent(name string, in, out []Signal) CompiledComponentAndPorts {
+	cmp := CompiledComponentAndPorts{
+		CompiledComponent:   CompiledComponent{Name: name},
+		InPortToSignalsMap:  make(PortToSignal),
+		OutPortToSignalsMap: make(PortToSignal),
+	}
+	if len(in) > 0 {
+		cmp.InPortToSignalsMap["input"] = in
+	}
+	if len(out) > 0 {
+		cmp.OutPortToSignalsMap["output"] = out
+	}
+	return cmp
+}
+
+var _ = Describe("ExecutionOrder", func() {
+	It("orders components after the producers of their input signals", func() {
+		order, err := ExecutionOrder([]CompiledComponentAndPorts{
+			testComponent("C", []Signal{{Name: "B"}}, nil),
+			testComponent("B", []Signal{{Name: "A"}}, []Signal{{Name: "B"}}),
+			testComponent("A", nil, []Signal{{Name: "A"}}),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(order).To(Equal([]int{2, 1, 0}))
+	})
+
+	It("does not constrain the order by looped signals", func() {
+		order, err := ExecutionOrder([]CompiledComponentAndPorts{
+			testComponent("A", []Signal{{Name: "B", Looped: true}}, []Signal{{Name: "A"}}),
+			testComponent("B", []Signal{{Name: "A"}}, []Signal{{Name: "B", Looped: true}}),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(order).To(Equal([]int{0, 1}))
+	})
+
+	It("names the components of a cycle without a looped signal", func() {
+		_, err := ExecutionOrder([]CompiledComponentAndPorts{
+			testComponent("A", []Signal{{Name: "B"}}, []Signal{{Name: "A"}}),
+			testComponent("B", []Signal{{Name: "A"}}, []Signal{{Name: "B"}}),
+			testComponent("C", []Signal{{Name: "B"}}, nil),
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("A[0], B[1]"))
+		Expect(err.Error()).NotTo(ContainSubstring("C[2]"))
+	})
+
+	It("fails on undefined signals", func() {
+		_, err := ExecutionOrder([]CompiledComponentAndPorts{
+			testComponent("A", []Signal{{Name: "MISSING"}}, nil),
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
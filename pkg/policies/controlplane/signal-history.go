@@ -0,0 +1,195 @@
+package controlplane
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	monitoringv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/monitoring/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/iface"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+)
+
+const (
+	signalHistoryConfigKey = iface.PoliciesRoot + ".signal_history"
+	// Number of ticks that can be queued for a stream subscriber before ticks are dropped.
+	signalStreamBufferSize = 16
+)
+
+// SignalHistoryConfig holds configuration for the signal history of policies.
+// swagger:model
+type SignalHistoryConfig struct {
+	// Number of most recent ticks of signal readings that are retained per policy.
+	Ticks int `json:"ticks" validate:"gte=0" default:"300"`
+}
+
+// SignalHistory retains the signal readings of the most recent ticks of each policy's circuit and fans out new ticks to subscribers.
+type SignalHistory struct {
+	policies map[string]*policySignalHistory
+	ticks    int
+	lock     sync.Mutex
+}
+
+// policySignalHistory is a ring buffer of the signal readings of a single policy.
+type policySignalHistory struct {
+	subscribers map[chan *monitoringv1.SignalsTick]struct{}
+	ring        []*monitoringv1.SignalsTick
+	next        int
+}
+
+func provideSignalHistory(unmarshaller config.Unmarshaller) (*SignalHistory, error) {
+	var historyConfig SignalHistoryConfig
+	if err := unmarshaller.UnmarshalKey(signalHistoryConfigKey, &historyConfig); err != nil {
+		log.Error().Err(err).Msg("Unable to deserialize signal history configuration!")
+		return nil, err
+	}
+	return newSignalHistory(historyConfig.Ticks), nil
+}
+
+func newSignalHistory(ticks int) *SignalHistory {
+	return &SignalHistory{
+		policies: make(map[string]*policySignalHistory),
+		ticks:    ticks,
+	}
+}
+
+// setupPolicySignalHistory records the signal readings of the circuit at the end of every tick.
+func (sh *SignalHistory) setupPolicySignalHistory(circuit *runtime.Circuit, lifecycle fx.Lifecycle) {
+	policyName := circuit.GetPolicyName()
+	history := &policySignalHistory{
+		subscribers: make(map[chan *monitoringv1.SignalsTick]struct{}),
+		ring:        make([]*monitoringv1.SignalsTick, 0, sh.ticks),
+	}
+
+	circuit.RegisterTickEndCallback(func(tickInfo runtime.TickInfo) error {
+		sh.record(history, newSignalsTick(tickInfo, circuit.GetSignalReadings()))
+		return nil
+	})
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			sh.lock.Lock()
+			defer sh.lock.Unlock()
+			sh.policies[policyName] = history
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			sh.lock.Lock()
+			defer sh.lock.Unlock()
+			// The policy might have been replaced by a newer version of itself already
+			if sh.policies[policyName] == history {
+				delete(sh.policies, policyName)
+			}
+			// Subscribers need to subscribe again to follow the newer version of the policy
+			for ch := range history.subscribers {
+				close(ch)
+			}
+			history.subscribers = make(map[chan *monitoringv1.SignalsTick]struct{})
+			return nil
+		},
+	})
+}
+
+func newSignalsTick(tickInfo runtime.TickInfo, signalReadings map[string]runtime.Reading) *monitoringv1.SignalsTick {
+	tick := &monitoringv1.SignalsTick{
+		Timestamp:      timestamppb.New(tickInfo.Timestamp()),
+		Tick:           int64(tickInfo.Tick()),
+		SignalReadings: make([]*monitoringv1.SignalReading, 0, len(signalReadings)),
+	}
+	for signalName, reading := range signalReadings {
+		signalReading := &monitoringv1.SignalReading{
+			SignalName: signalName,
+			Valid:      reading.Valid(),
+		}
+		if reading.Valid() {
+			signalReading.Value = reading.Value()
+		}
+		tick.SignalReadings = append(tick.SignalReadings, signalReading)
+	}
+	sort.Slice(tick.SignalReadings, func(i, j int) bool {
+		return tick.SignalReadings[i].SignalName < tick.SignalReadings[j].SignalName
+	})
+	return tick
+}
+
+func (sh *SignalHistory) record(history *policySignalHistory, tick *monitoringv1.SignalsTick) {
+	sh.lock.Lock()
+	defer sh.lock.Unlock()
+
+	if sh.ticks > 0 {
+		if len(history.ring) < sh.ticks {
+			history.ring = append(history.ring, tick)
+		} else {
+			history.ring[history.next] = tick
+		}
+		history.next = (history.next + 1) % sh.ticks
+	}
+
+	for ch := range history.subscribers {
+		select {
+		case ch <- tick:
+		default:
+			log.Debug().Int64("tick", tick.Tick).Msg("Signal stream subscriber is lagging behind, dropping tick")
+		}
+	}
+}
+
+// lastTicks returns up to n most recent ticks of the policy in execution order. All the retained ticks are returned if n is not positive.
+func (history *policySignalHistory) lastTicks(n int) []*monitoringv1.SignalsTick {
+	size := len(history.ring)
+	if n <= 0 || n > size {
+		n = size
+	}
+	ticks := make([]*monitoringv1.SignalsTick, 0, n)
+	// The oldest tick is at history.next once the ring is full, at 0 otherwise
+	for i := size - n; i < size; i++ {
+		ticks = append(ticks, history.ring[(history.next+i)%size])
+	}
+	return ticks
+}
+
+// LastTicks returns up to n most recent ticks of the policy. The second return value is false if the policy is not running.
+func (sh *SignalHistory) LastTicks(policyName string, n int) ([]*monitoringv1.SignalsTick, bool) {
+	sh.lock.Lock()
+	defer sh.lock.Unlock()
+
+	history, ok := sh.policies[policyName]
+	if !ok {
+		return nil, false
+	}
+	return history.lastTicks(n), true
+}
+
+// Subscribe returns up to n most recent ticks of the policy and a channel on which new ticks are delivered.
+// The channel is closed when the policy stops. The returned cancel function must be called to unsubscribe.
+// The second return value is false if the policy is not running.
+func (sh *SignalHistory) Subscribe(policyName string, n int) ([]*monitoringv1.SignalsTick, <-chan *monitoringv1.SignalsTick, func(), bool) {
+	sh.lock.Lock()
+	defer sh.lock.Unlock()
+
+	history, ok := sh.policies[policyName]
+	if !ok {
+		return nil, nil, nil, false
+	}
+	var ticks []*monitoringv1.SignalsTick
+	if n > 0 {
+		ticks = history.lastTicks(n)
+	}
+	ch := make(chan *monitoringv1.SignalsTick, signalStreamBufferSize)
+	history.subscribers[ch] = struct{}{}
+
+	cancel := func() {
+		sh.lock.Lock()
+		defer sh.lock.Unlock()
+		if _, ok := history.subscribers[ch]; ok {
+			delete(history.subscribers, ch)
+			close(ch)
+		}
+	}
+	return ticks, ch, cancel, true
+}
@@ -0,0 +1,132 @@
+package controlplane
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	monitoringv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/monitoring/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+	"github.com/fluxninja/aperture/pkg/status"
+)
+
+var _ = Describe("SignalHistory", func() {
+	var (
+		history *SignalHistory
+		circuit *runtime.Circuit
+		app     *fx.App
+	)
+
+	execute := func(ticks int) {
+		for tick := 0; tick < ticks; tick++ {
+			timestamp := time.Unix(int64(tick), 0)
+			err := circuit.Execute(runtime.NewTickInfo(timestamp, timestamp.Add(time.Second), tick, time.Second))
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+
+	BeforeEach(func() {
+		policyMessage := &policylangv1.Policy{}
+		err := config.UnmarshalYAML([]byte(signalHistoryPolicy), policyMessage)
+		Expect(err).NotTo(HaveOccurred())
+		wrapperMessage, err := hashAndPolicyWrap(policyMessage, "test")
+		Expect(err).NotTo(HaveOccurred())
+		policy, compiledCircuit, _, err := compilePolicyWrapper(wrapperMessage, status.NewRegistry(log.GetGlobalLogger()))
+		Expect(err).NotTo(HaveOccurred())
+		compWithPortsList := make([]runtime.CompiledComponentAndPorts, 0, len(compiledCircuit))
+		for _, compiledComponent := range compiledCircuit {
+			compWithPortsList = append(compWithPortsList, compiledComponent.CompiledComponentAndPorts)
+		}
+		var circuitOption fx.Option
+		circuit, circuitOption, err = runtime.NewCircuitAndOptions(compWithPortsList, policy)
+		Expect(err).NotTo(HaveOccurred())
+
+		history = newSignalHistory(3)
+		app = fx.New(
+			fx.NopLogger,
+			fx.Supply(prometheus.NewRegistry()),
+			runtime.CircuitModule(),
+			circuitOption,
+			fx.Invoke(func(lifecycle fx.Lifecycle) {
+				history.setupPolicySignalHistory(circuit, lifecycle)
+			}),
+		)
+		Expect(app.Start(context.Background())).To(Succeed())
+	})
+
+	AfterEach(func() {
+		_ = app.Stop(context.Background())
+	})
+
+	It("retains the most recent ticks", func() {
+		execute(5)
+		ticks, ok := history.LastTicks("test", 0)
+		Expect(ok).To(BeTrue())
+		Expect(tickNumbers(ticks)).To(Equal([]int64{2, 3, 4}))
+		Expect(ticks[0].SignalReadings).To(HaveLen(2))
+		Expect(ticks[0].SignalReadings[0].SignalName).To(Equal("DOUBLE"))
+		Expect(ticks[0].SignalReadings[0].Value).To(Equal(4.0))
+		Expect(ticks[0].SignalReadings[1].SignalName).To(Equal("TWO"))
+
+		ticks, _ = history.LastTicks("test", 2)
+		Expect(tickNumbers(ticks)).To(Equal([]int64{3, 4}))
+	})
+
+	It("reports unknown policies", func() {
+		_, ok := history.LastTicks("unknown", 0)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("streams new ticks to subscribers until the policy stops", func() {
+		execute(1)
+		ticks, ch, cancel, ok := history.Subscribe("test", 1)
+		Expect(ok).To(BeTrue())
+		defer cancel()
+		Expect(tickNumbers(ticks)).To(Equal([]int64{0}))
+
+		execute(2)
+		Expect((<-ch).Tick).To(Equal(int64(0)))
+		Expect((<-ch).Tick).To(Equal(int64(1)))
+
+		Expect(app.Stop(context.Background())).To(Succeed())
+		Eventually(ch).Should(BeClosed())
+		_, ok = history.LastTicks("test", 0)
+		Expect(ok).To(BeFalse())
+	})
+})
+
+func tickNumbers(ticks []*monitoringv1.SignalsTick) []int64 {
+	numbers := make([]int64, len(ticks))
+	for i, tick := range ticks {
+		numbers[i] = tick.Tick
+	}
+	return numbers
+}
+
+const signalHistoryPolicy = `
+circuit:
+  evaluation_interval: 1s
+  components:
+    - constant:
+        value: 2
+        out_ports:
+          output:
+            signal_name: TWO
+    - arithmetic_combinator:
+        operator: add
+        in_ports:
+          lhs:
+            signal_name: TWO
+          rhs:
+            signal_name: TWO
+        out_ports:
+          output:
+            signal_name: DOUBLE
+`
@@ -0,0 +1,75 @@
+package controlplane
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	monitoringv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/monitoring/v1"
+	"github.com/fluxninja/aperture/pkg/net/grpcgateway"
+)
+
+// signalServiceModule provides the signal history of policies and registers SignalService handlers with grpc and grpc-gateway.
+func signalServiceModule() fx.Option {
+	return fx.Options(
+		fx.Provide(provideSignalHistory),
+		grpcgateway.RegisterHandler{Handler: monitoringv1.RegisterSignalServiceHandlerFromEndpoint}.Annotate(),
+		fx.Invoke(RegisterSignalService),
+	)
+}
+
+// SignalService is the implementation of monitoringv1.SignalServiceServer interface.
+type SignalService struct {
+	monitoringv1.UnimplementedSignalServiceServer
+	history *SignalHistory
+}
+
+// RegisterSignalService registers a service for signal history.
+func RegisterSignalService(server *grpc.Server, history *SignalHistory) {
+	svc := &SignalService{
+		history: history,
+	}
+	monitoringv1.RegisterSignalServiceServer(server, svc)
+}
+
+// GetSignalHistory returns the signal readings of the most recent ticks of a policy.
+func (svc *SignalService) GetSignalHistory(ctx context.Context, req *monitoringv1.GetSignalHistoryRequest) (*monitoringv1.GetSignalHistoryResponse, error) {
+	ticks, ok := svc.history.LastTicks(req.PolicyName, int(req.Ticks))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "policy %q is not running", req.PolicyName)
+	}
+	return &monitoringv1.GetSignalHistoryResponse{
+		Ticks: ticks,
+	}, nil
+}
+
+// StreamSignals sends the requested number of recent ticks of a policy and then streams new ticks until the client goes away or the policy stops.
+func (svc *SignalService) StreamSignals(req *monitoringv1.StreamSignalsRequest, stream monitoringv1.SignalService_StreamSignalsServer) error {
+	ticks, ch, cancel, ok := svc.history.Subscribe(req.PolicyName, int(req.Ticks))
+	if !ok {
+		return status.Errorf(codes.NotFound, "policy %q is not running", req.PolicyName)
+	}
+	defer cancel()
+
+	for _, tick := range ticks {
+		if err := stream.Send(tick); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case tick, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(tick); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane/runtime"
+	"github.com/fluxninja/aperture/pkg/status"
+)
+
+// simulatedComponentNames are the components that depend on Prometheus or etcd.
+// During simulation, their output signals are read from the input series and their side effects are skipped.
+var simulatedComponentNames = map[string]bool{
+	"PromQL":                    true,
+	"Scheduler":                 true,
+	"LoadShedActuator":          true,
+	"ConcurrencyBudgetActuator": true,
+	"RateLimiter":               true,
+	"QuotaLimiter":              true,
+}
+
+// SignalSeries is a map from signal name to the signal values, one value per tick. NaN represents an invalid reading.
+type SignalSeries map[string][]float64
+
+// SimulationTick holds signal readings of a single simulated tick.
+type SimulationTick struct {
+	Timestamp time.Time
+	Readings  map[string]runtime.Reading
+	Tick      int
+}
+
+// SimulationTickCallback is called at the end of each simulated tick.
+type SimulationTickCallback func(SimulationTick) error
+
+// Simulate runs the circuit of a policy tick by tick without Prometheus and etcd.
+// Output signals of PromQL components and actuators are read from inputs.
+func Simulate(
+	policyName string,
+	policyMessage *policylangv1.Policy,
+	inputs SignalSeries,
+	ticks int,
+	cb SimulationTickCallback,
+) error {
+	wrapperMessage, err := hashAndPolicyWrap(policyMessage, policyName)
+	if err != nil {
+		return err
+	}
+	registry := status.NewRegistry(log.GetGlobalLogger())
+	policy, compiledCircuit, _, err := compilePolicyWrapper(wrapperMessage, registry)
+	if err != nil {
+		return err
+	}
+	interval := policy.GetEvaluationInterval()
+	if interval <= 0 {
+		return fmt.Errorf("evaluation interval must be positive, got %s", interval)
+	}
+
+	readings := make(map[string]runtime.Reading)
+	compWithPortsList := make([]runtime.CompiledComponentAndPorts, 0, len(compiledCircuit))
+	for _, compiledComponent := range compiledCircuit {
+		// Skip nil component
+		if compiledComponent.CompiledComponent.Component == nil {
+			continue
+		}
+		compWithPorts := compiledComponent.CompiledComponentAndPorts
+		if simulatedComponentNames[compWithPorts.CompiledComponent.Name] {
+			compWithPorts.CompiledComponent.Component = &seriesComponent{
+				outPortToSignalsMap: compWithPorts.OutPortToSignalsMap,
+				inputs:              inputs,
+			}
+		}
+		compWithPorts.CompiledComponent.Component = &recordingComponent{
+			Component:           compWithPorts.CompiledComponent.Component,
+			outPortToSignalsMap: compWithPorts.OutPortToSignalsMap,
+			readings:            readings,
+		}
+		compWithPortsList = append(compWithPortsList, compWithPorts)
+	}
+
+	circuit, circuitOption, err := runtime.NewCircuitAndOptions(compWithPortsList, policy)
+	if err != nil {
+		return err
+	}
+	app := fx.New(
+		fx.NopLogger,
+		fx.Supply(prometheus.NewRegistry()),
+		runtime.CircuitModule(),
+		circuitOption,
+	)
+	if err = app.Start(context.Background()); err != nil {
+		return err
+	}
+	defer func() {
+		_ = app.Stop(context.Background())
+	}()
+
+	start := time.Unix(0, 0).UTC()
+	for tick := 0; tick < ticks; tick++ {
+		timestamp := start.Add(time.Duration(tick) * interval)
+		tickInfo := runtime.NewTickInfo(timestamp, timestamp.Add(interval), tick, interval)
+		for signal := range readings {
+			delete(readings, signal)
+		}
+		if err = circuit.Execute(tickInfo); err != nil {
+			log.Debug().Err(err).Int("tick", tick).Msg("Circuit execution returned an error")
+		}
+		tickReadings := make(map[string]runtime.Reading, len(readings))
+		for signal, reading := range readings {
+			tickReadings[signal] = reading
+		}
+		err = cb(SimulationTick{
+			Tick:      tick,
+			Timestamp: timestamp,
+			Readings:  tickReadings,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SignalNames returns sorted names of the signals that are emitted by the circuit of a compiled policy.
+func SignalNames(compiledCircuit CompiledCircuit) []string {
+	var names []string
+	for _, compiledComponent := range compiledCircuit {
+		for _, signals := range compiledComponent.OutPortToSignalsMap {
+			for _, signal := range signals {
+				names = append(names, signal.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// seriesComponent emits readings from input series on its out ports.
+type seriesComponent struct {
+	outPortToSignalsMap runtime.PortToSignal
+	inputs              SignalSeries
+}
+
+// Execute implements runtime.Component.Execute.
+func (sc *seriesComponent) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	outPortReadings := make(runtime.PortToValue)
+	for port, signals := range sc.outPortToSignalsMap {
+		portReadings := make([]runtime.Reading, len(signals))
+		for index, signal := range signals {
+			portReadings[index] = runtime.InvalidReading()
+			if values, ok := sc.inputs[signal.Name]; ok && tickInfo.Tick() < len(values) {
+				portReadings[index] = runtime.NewReading(values[tickInfo.Tick()])
+			}
+		}
+		outPortReadings[port] = portReadings
+	}
+	return outPortReadings, nil
+}
+
+// DynamicConfigUpdate is a no-op for seriesComponent.
+func (sc *seriesComponent) DynamicConfigUpdate(event notifiers.Event, unmarshaller config.Unmarshaller) {
+}
+
+// recordingComponent records the readings emitted by the wrapped component.
+type recordingComponent struct {
+	runtime.Component
+	outPortToSignalsMap runtime.PortToSignal
+	readings            map[string]runtime.Reading
+}
+
+// Execute implements runtime.Component.Execute.
+func (rc *recordingComponent) Execute(inPortReadings runtime.PortToValue, tickInfo runtime.TickInfo) (runtime.PortToValue, error) {
+	outPortReadings, err := rc.Component.Execute(inPortReadings, tickInfo)
+	for port, signals := range rc.outPortToSignalsMap {
+		for index, signal := range signals {
+			reading := runtime.InvalidReading()
+			if index < len(outPortReadings[port]) {
+				reading = outPortReadings[port][index]
+			}
+			rc.readings[signal.Name] = reading
+		}
+	}
+	return outPortReadings, err
+}
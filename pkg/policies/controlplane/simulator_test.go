@@ -0,0 +1,64 @@
+package controlplane_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/policies/controlplane"
+)
+
+var _ = Describe("Simulate", func() {
+	It("replaces PromQL components with input series", func() {
+		policy := &policylangv1.Policy{}
+		err := config.UnmarshalYAML([]byte(simulatedPolicy), policy)
+		Expect(err).NotTo(HaveOccurred())
+
+		inputs := controlplane.SignalSeries{
+			"LATENCY": {10, math.NaN(), 30},
+		}
+		var latencies, doubled []float64
+		var valid []bool
+		err = controlplane.Simulate("test", policy, inputs, 4, func(tick controlplane.SimulationTick) error {
+			latencies = append(latencies, tick.Readings["LATENCY"].Value())
+			doubled = append(doubled, tick.Readings["DOUBLE_LATENCY"].Value())
+			valid = append(valid, tick.Readings["DOUBLE_LATENCY"].Valid())
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valid).To(Equal([]bool{true, false, true, false}))
+		Expect(latencies[0]).To(Equal(10.0))
+		Expect(doubled[0]).To(Equal(20.0))
+		Expect(doubled[2]).To(Equal(60.0))
+	})
+})
+
+const simulatedPolicy = `
+circuit:
+  evaluation_interval: 1s
+  components:
+    - promql:
+        evaluation_interval: 1s
+        query_string: "sum(rate(latency[10s]))"
+        out_ports:
+          output:
+            signal_name: LATENCY
+    - constant:
+        value: 2
+        out_ports:
+          output:
+            signal_name: TWO
+    - arithmetic_combinator:
+        operator: mul
+        in_ports:
+          lhs:
+            signal_name: LATENCY
+          rhs:
+            signal_name: TWO
+        out_ports:
+          output:
+            signal_name: DOUBLE_LATENCY
+`
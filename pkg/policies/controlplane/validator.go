@@ -76,7 +76,7 @@ func ValidateAndCompile(ctx context.Context, name string, yamlSrc []byte) (Compi
 					PolicyHash:     "dummy",
 					ComponentIndex: 0,
 				},
-			})
+			}, nil)
 			if err != nil {
 				if errors.Is(err, compiler.BadExtractor) || errors.Is(err, compiler.BadSelector) ||
 					errors.Is(err, compiler.BadRego) || errors.Is(err, compiler.BadLabelName) {
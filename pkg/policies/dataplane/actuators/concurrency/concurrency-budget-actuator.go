@@ -0,0 +1,436 @@
+package concurrency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/buraksezer/olric"
+	olricconfig "github.com/buraksezer/olric/config"
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/proto"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	wrappersv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/wrappers/v1"
+	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/distcache"
+	etcdclient "github.com/fluxninja/aperture/pkg/etcd/client"
+	etcdwatcher "github.com/fluxninja/aperture/pkg/etcd/watcher"
+	"github.com/fluxninja/aperture/pkg/jobs"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/common"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/actuators/concurrency/scheduler"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+	"github.com/fluxninja/aperture/pkg/status"
+)
+
+const (
+	// Leases are sized with some headroom over the incoming concurrency, so that the agent is not limited by its own lease when the budget is plentiful.
+	leaseHeadroom = 1.2
+	// Suffix of the keys locking the leases, olric locks are stored alongside the values in the DMap.
+	leasesLockSuffix = "/lock"
+	// Time after which the lock of the leases is released in case the holder goes away.
+	leasesLockTimeout = time.Second
+	// Time to wait for the lock of the leases before giving up on renewal.
+	leasesLockDeadline = 100 * time.Millisecond
+)
+
+type concurrencyBudgetActuatorFactory struct {
+	concurrencyBudgetDecisionWatcher notifiers.Watcher
+	distCache                        *distcache.DistCache
+	leaseJobGroup                    *jobs.JobGroup
+	// token bucket metric vectors are shared with the load shed actuators
+	loadShedActuatorFactory *loadShedActuatorFactory
+	agentGroupName          string
+	// identifies the leases of this agent
+	agentID string
+}
+
+// newConcurrencyBudgetActuatorFactory sets up the concurrency budget module in the main fx app.
+func newConcurrencyBudgetActuatorFactory(
+	lc fx.Lifecycle,
+	etcdClient *etcdclient.Client,
+	agentGroup string,
+	distCache *distcache.DistCache,
+	loadShedActuatorFactory *loadShedActuatorFactory,
+	registry status.Registry,
+) (*concurrencyBudgetActuatorFactory, error) {
+	// Scope the sync to the agent group.
+	etcdPath := path.Join(common.ConcurrencyBudgetDecisionsPath, common.AgentGroupPrefix(agentGroup))
+	concurrencyBudgetDecisionWatcher, err := etcdwatcher.NewWatcher(etcdClient, etcdPath)
+	if err != nil {
+		return nil, err
+	}
+	leaseJobGroup, err := jobs.NewJobGroup(registry.Child("concurrency_budget_lease_jobs"), 0, jobs.RescheduleMode, nil)
+	if err != nil {
+		return nil, err
+	}
+	f := &concurrencyBudgetActuatorFactory{
+		concurrencyBudgetDecisionWatcher: concurrencyBudgetDecisionWatcher,
+		distCache:                        distCache,
+		leaseJobGroup:                    leaseJobGroup,
+		loadShedActuatorFactory:          loadShedActuatorFactory,
+		agentGroupName:                   agentGroup,
+		agentID:                          uuid.NewString(),
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			err := leaseJobGroup.Start()
+			if err != nil {
+				return err
+			}
+			return concurrencyBudgetDecisionWatcher.Start()
+		},
+		OnStop: func(_ context.Context) error {
+			var errMulti error
+			err := concurrencyBudgetDecisionWatcher.Stop()
+			if err != nil {
+				errMulti = multierr.Append(errMulti, err)
+			}
+			err = leaseJobGroup.Stop()
+			if err != nil {
+				errMulti = multierr.Append(errMulti, err)
+			}
+			return errMulti
+		},
+	})
+	return f, nil
+}
+
+// newConcurrencyBudgetActuator creates a new concurrency budget actuator based on proto spec.
+func (cbaFactory *concurrencyBudgetActuatorFactory) newConcurrencyBudgetActuator(conLimiter *concurrencyLimiter,
+	concurrencyBudgetActuatorProto *policylangv1.ConcurrencyBudgetActuator,
+	registry status.Registry,
+	clock clockwork.Clock,
+	lifecycle fx.Lifecycle,
+	metricLabels prometheus.Labels,
+) (*concurrencyBudgetActuator, error) {
+	reg := registry.Child("concurrency_budget_actuator")
+
+	cba := &concurrencyBudgetActuator{
+		conLimiter:     conLimiter,
+		clock:          clock,
+		statusRegistry: reg,
+		name:           "concurrency-budget-" + iface.ComponentID(conLimiter),
+		agentID:        cbaFactory.agentID,
+		leaseTTL:       concurrencyBudgetActuatorProto.GetLeaseTtl().AsDuration(),
+		budget:         -1,
+	}
+
+	unmarshaller, err := config.NewProtobufUnmarshaller(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// decision notifier
+	decisionNotifier := notifiers.NewUnmarshalKeyNotifier(
+		notifiers.Key(common.DataplaneComponentKey(cbaFactory.agentGroupName, cba.conLimiter.GetPolicyName(), cba.conLimiter.GetComponentIndex())),
+		unmarshaller,
+		cba.decisionUpdateCallback,
+	)
+
+	leaseInterval := concurrencyBudgetActuatorProto.GetLeaseInterval().AsDuration()
+	leaseJob := &jobs.BasicJob{
+		JobFunc: cba.renewLease,
+	}
+	leaseJob.JobName = cba.name
+
+	lsaFactory := cbaFactory.loadShedActuatorFactory
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			retErr := func(err error) error {
+				cba.statusRegistry.SetStatus(status.NewStatus(nil, err))
+				return err
+			}
+
+			tokenBucketFillRateGauge, err := lsaFactory.tokenBucketFillRateGaugeVec.GetMetricWith(metricLabels)
+			if err != nil {
+				return retErr(errors.Wrap(err, "Failed to get token bucket fill rate gauge"))
+			}
+
+			tokenBucketBucketCapacityGauge, err := lsaFactory.tokenBucketBucketCapacityGaugeVec.GetMetricWith(metricLabels)
+			if err != nil {
+				return retErr(errors.Wrap(err, "Failed to get token bucket bucket capacity gauge"))
+			}
+
+			tokenBucketAvailableTokensGauge, err := lsaFactory.tokenBucketAvailableTokensGaugeVec.GetMetricWith(metricLabels)
+			if err != nil {
+				return retErr(errors.Wrap(err, "Failed to get token bucket available tokens gauge"))
+			}
+
+			// Initialize the token bucket
+			cba.tokenBucketBudget = scheduler.NewTokenBucketBudget(clock.Now(), &scheduler.TokenBucketMetrics{
+				FillRateGauge:        tokenBucketFillRateGauge,
+				BucketCapacityGauge:  tokenBucketBucketCapacityGauge,
+				AvailableTokensGauge: tokenBucketAvailableTokensGauge,
+			})
+
+			dc := cbaFactory.distCache
+			dc.Mutex.Lock()
+			dc.AddDMapCustomConfig(cba.name, olricconfig.DMap{TTLDuration: cba.leaseTTL})
+			cba.dMap, err = dc.Olric.NewDMap(cba.name)
+			dc.RemoveDMapCustomConfig(cba.name)
+			dc.Mutex.Unlock()
+			if err != nil {
+				return retErr(errors.Wrap(err, "Failed to create DMap for concurrency budget leases"))
+			}
+
+			err = cbaFactory.leaseJobGroup.RegisterJob(leaseJob, jobs.JobConfig{
+				ExecutionPeriod:  config.MakeDuration(leaseInterval),
+				ExecutionTimeout: config.MakeDuration(leaseInterval),
+				InitialDelay:     config.MakeDuration(-1),
+			})
+			if err != nil {
+				return retErr(err)
+			}
+
+			err = cbaFactory.concurrencyBudgetDecisionWatcher.AddKeyNotifier(decisionNotifier)
+			if err != nil {
+				return retErr(err)
+			}
+			return retErr(nil)
+		},
+		OnStop: func(context.Context) error {
+			var errMulti error
+			err := cbaFactory.concurrencyBudgetDecisionWatcher.RemoveKeyNotifier(decisionNotifier)
+			if err != nil {
+				errMulti = multierr.Append(errMulti, err)
+			}
+			err = cbaFactory.leaseJobGroup.DeregisterJob(cba.name)
+			if err != nil {
+				errMulti = multierr.Append(errMulti, err)
+			}
+			// Give back the lease to the other agents right away
+			err = cba.releaseLease()
+			if err != nil {
+				errMulti = multierr.Append(errMulti, err)
+			}
+
+			deleted := lsaFactory.tokenBucketFillRateGaugeVec.Delete(metricLabels)
+			if !deleted {
+				errMulti = multierr.Append(errMulti, errors.New("failed to delete token_bucket_fill_rate gauge from its metric vector"))
+			}
+			deleted = lsaFactory.tokenBucketBucketCapacityGaugeVec.Delete(metricLabels)
+			if !deleted {
+				errMulti = multierr.Append(errMulti, errors.New("failed to delete token_bucket_capacity gauge from its metric vector"))
+			}
+			deleted = lsaFactory.tokenBucketAvailableTokensGaugeVec.Delete(metricLabels)
+			if !deleted {
+				errMulti = multierr.Append(errMulti, errors.New("failed to delete token_bucket_available_tokens gauge from its metric vector"))
+			}
+
+			cba.statusRegistry.SetStatus(status.NewStatus(nil, errMulti))
+			return errMulti
+		},
+	})
+	return cba, nil
+}
+
+// concurrencyBudgetActuator leases slices of the concurrency budget received from controller.
+type concurrencyBudgetActuator struct {
+	conLimiter        *concurrencyLimiter
+	clock             clockwork.Clock
+	tokenBucketBudget *scheduler.TokenBucketBudget
+	statusRegistry    status.Registry
+	dMap              *olric.DMap
+	name              string
+	agentID           string
+	leaseTTL          time.Duration
+	budgetLock        sync.Mutex
+	budget            float64 // negative if unknown
+}
+
+func (cba *concurrencyBudgetActuator) decisionUpdateCallback(event notifiers.Event, unmarshaller config.Unmarshaller) {
+	logger := cba.statusRegistry.GetLogger()
+	if event.Type == notifiers.Remove {
+		logger.Debug().Msg("Decision was removed")
+		return
+	}
+
+	var wrapperMessage wrappersv1.ConcurrencyBudgetDecisionWrapper
+	err := unmarshaller.Unmarshal(&wrapperMessage)
+	concurrencyBudgetDecision := wrapperMessage.ConcurrencyBudgetDecision
+	if err != nil || concurrencyBudgetDecision == nil {
+		statusMsg := "Failed to unmarshal config wrapper"
+		logger.Warn().Err(err).Msg(statusMsg)
+		cba.statusRegistry.SetStatus(status.NewStatus(nil, err))
+		return
+	}
+	commonAttributes := wrapperMessage.GetCommonAttributes()
+	if commonAttributes == nil {
+		statusMsg := "Failed to get common attributes from config wrapper"
+		logger.Error().Err(err).Msg(statusMsg)
+		cba.statusRegistry.SetStatus(status.NewStatus(nil, err))
+		return
+	}
+	// check if this decision is for the same policy id as what we have
+	if commonAttributes.PolicyHash != cba.conLimiter.GetPolicyHash() {
+		err = errors.New("policy id mismatch")
+		statusMsg := fmt.Sprintf("Expected policy hash: %s, Got: %s", cba.conLimiter.GetPolicyHash(), commonAttributes.PolicyHash)
+		logger.Warn().Err(err).Msg(statusMsg)
+		cba.statusRegistry.SetStatus(status.NewStatus(nil, err))
+		return
+	}
+
+	budget := concurrencyBudgetDecision.ConcurrencyBudget
+	logger.Trace().Float64("concurrencyBudget", budget).Msg("Setting concurrency budget")
+	cba.budgetLock.Lock()
+	cba.budget = budget
+	cba.budgetLock.Unlock()
+	if budget < 0 {
+		// Unknown budget, stop limiting right away
+		cba.tokenBucketBudget.SetLease(cba.clock.Now(), -1)
+	}
+}
+
+// renewLease resizes the lease of this agent to its incoming concurrency and applies it to the token bucket.
+func (cba *concurrencyBudgetActuator) renewLease(_ context.Context) (proto.Message, error) {
+	cba.budgetLock.Lock()
+	budget := cba.budget
+	cba.budgetLock.Unlock()
+	if budget < 0 {
+		return nil, nil
+	}
+	demand, ok := cba.tokenBucketBudget.IncomingTokenRate()
+	if !ok {
+		return nil, nil
+	}
+
+	var lease float64
+	err := cba.updateLeases(func(leases budgetLeases, now time.Time) {
+		lease = leases.renew(cba.agentID, now, demand*leaseHeadroom, budget, cba.leaseTTL)
+	})
+	if err != nil {
+		// Keep the previous lease until the next renewal
+		cba.statusRegistry.GetLogger().Sample(zerolog.Sometimes).Warn().Err(err).Msg("Failed to renew concurrency budget lease")
+		return nil, err
+	}
+	cba.tokenBucketBudget.SetLease(cba.clock.Now(), lease)
+	return nil, nil
+}
+
+// releaseLease gives back the lease of this agent.
+func (cba *concurrencyBudgetActuator) releaseLease() error {
+	if cba.dMap == nil {
+		return nil
+	}
+	return cba.updateLeases(func(leases budgetLeases, _ time.Time) {
+		delete(leases, cba.agentID)
+	})
+}
+
+// updateLeases applies update to the leases of the agent group while holding their lock.
+func (cba *concurrencyBudgetActuator) updateLeases(update func(leases budgetLeases, now time.Time)) error {
+	lock, err := cba.dMap.LockWithTimeout(cba.name+leasesLockSuffix, leasesLockTimeout, leasesLockDeadline)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = lock.Unlock()
+	}()
+
+	leases := make(budgetLeases)
+	value, err := cba.dMap.Get(cba.name)
+	if err == nil {
+		data, isBytes := value.([]byte)
+		if !isBytes {
+			return errors.New("unexpected type of concurrency budget leases")
+		}
+		err = json.Unmarshal(data, &leases)
+		if err != nil {
+			return err
+		}
+	} else if err != olric.ErrKeyNotFound {
+		return err
+	}
+
+	update(leases, cba.clock.Now())
+
+	data, err := json.Marshal(leases)
+	if err != nil {
+		return err
+	}
+	return cba.dMap.PutEx(cba.name, data, cba.leaseTTL)
+}
+
+// budgetLease is a slice of the concurrency budget held by an agent.
+type budgetLease struct {
+	Expiry time.Time `json:"expiry"`
+	Tokens float64   `json:"tokens"`
+	// demand of the agent at its last renewal, used to compute the fair shares of the other agents
+	Demand float64 `json:"demand"`
+}
+
+// budgetLeases are the leases of the agent group, keyed by agent ID.
+type budgetLeases map[string]budgetLease
+
+// renew drops expired leases and resizes the lease of the agent to its demand (in tokens/sec), returning the new lease.
+//
+// The budget is divided by max-min fairness of the demands of the agents, and the lease never exceeds the part
+// of the budget not leased by other agents, so the leases add up to at most the budget. An agent whose fair share
+// is held by others gets it once they renew and shrink their leases to their own fair shares.
+//
+// An agent with less demand than its fair share of the budget still leases up to that fair share, out of the budget
+// neither leased nor demanded by the other agents, so that an idle agent does not reject all the flows until its next renewal.
+func (leases budgetLeases) renew(agentID string, now time.Time, demand, budget float64, ttl time.Duration) float64 {
+	demand = math.Max(demand, 0)
+	leasedByOthers := 0.0
+	demands := []float64{demand}
+	for id, lease := range leases {
+		if id == agentID {
+			continue
+		}
+		if !now.Before(lease.Expiry) {
+			delete(leases, id)
+			continue
+		}
+		leasedByOthers += lease.Tokens
+		demands = append(demands, lease.Demand)
+	}
+	level := fairLevel(demands, budget)
+	demandedByOthers := 0.0
+	for _, otherDemand := range demands[1:] {
+		demandedByOthers += math.Min(otherDemand, level)
+	}
+	free := math.Max(budget-leasedByOthers, 0)
+	fairShare := budget / float64(len(demands))
+
+	tokens := math.Min(math.Min(demand, level), free)
+	tokens = math.Max(tokens, math.Min(math.Min(fairShare, free), budget-demandedByOthers))
+	leases[agentID] = budgetLease{
+		Tokens: tokens,
+		Demand: demand,
+		Expiry: now.Add(ttl),
+	}
+	return tokens
+}
+
+// fairLevel returns the max-min fair allocation level of the budget among the demands:
+// demands below the level are fully satisfied and the others get the level.
+func fairLevel(demands []float64, budget float64) float64 {
+	sorted := make([]float64, len(demands))
+	copy(sorted, demands)
+	sort.Float64s(sorted)
+	remaining := budget
+	for i, demand := range sorted {
+		level := remaining / float64(len(sorted)-i)
+		if demand > level {
+			return level
+		}
+		remaining -= demand
+	}
+	return math.Inf(1)
+}
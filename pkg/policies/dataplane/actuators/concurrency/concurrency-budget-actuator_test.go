@@ -0,0 +1,134 @@
+package concurrency
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/buraksezer/olric"
+	olricconfig "github.com/buraksezer/olric/config"
+	"github.com/jonboulle/clockwork"
+
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/actuators/concurrency/scheduler"
+	"github.com/fluxninja/aperture/pkg/status"
+)
+
+func TestBudgetLeasesRenew(t *testing.T) {
+	now := time.Now()
+	ttl := 5 * time.Second
+	leases := make(budgetLeases)
+	expectLease := func(agentID string, now time.Time, demand, expected float64) {
+		t.Helper()
+		if lease := leases.renew(agentID, now, demand, 100, ttl); math.Abs(lease-expected) > 1e-9 {
+			t.Errorf("Expected lease of %f for %s, got %f", expected, agentID, lease)
+		}
+		total := 0.0
+		for _, lease := range leases {
+			total += lease.Tokens
+		}
+		if total > 100+1e-9 {
+			t.Errorf("Expected leases to add up to at most the budget, got %f", total)
+		}
+	}
+
+	// a lone agent leases the whole budget
+	expectLease("a", now, 80, 100)
+	// the second agent gets what is left until the first one gives back the rest of its fair share
+	expectLease("b", now, 70, 0)
+	// the first agent shrinks its lease to its fair share at renewal
+	expectLease("a", now, 80, 50)
+	expectLease("b", now, 70, 50)
+	// an idle agent keeps the budget not demanded by the others, up to its fair share
+	expectLease("b", now, 10, 20)
+	expectLease("a", now, 80, 80)
+	// the budget is shared fairly once the agents renew their leases
+	expectLease("c", now, 60, 0)
+	expectLease("b", now, 10, 10)
+	expectLease("a", now, 80, 45)
+	expectLease("c", now, 60, 45)
+	// expired leases are dropped
+	later := now.Add(ttl)
+	expectLease("a", later, 120, 100)
+	if _, ok := leases["b"]; ok {
+		t.Error("Expected the expired lease to be dropped")
+	}
+}
+
+func TestConcurrencyBudgetActuatorLeases(t *testing.T) {
+	dMap := newTestDMap(t)
+	clk := clockwork.NewFakeClock()
+	newActuator := func(agentID string) *concurrencyBudgetActuator {
+		return &concurrencyBudgetActuator{
+			clock:             clk,
+			tokenBucketBudget: scheduler.NewTokenBucketBudget(clk.Now(), nil),
+			statusRegistry:    status.NewRegistry(log.GetGlobalLogger()),
+			dMap:              dMap,
+			name:              "budget",
+			agentID:           agentID,
+			leaseTTL:          time.Minute,
+			budget:            100,
+		}
+	}
+	busy := newActuator("busy")
+	idle := newActuator("idle")
+
+	// the busy agent sees 50 tokens/sec, the idle agent sees none
+	for i := 0; i < 20; i++ {
+		clk.Advance(100 * time.Millisecond)
+		busy.tokenBucketBudget.PreprocessRequest(clk.Now(), scheduler.RequestContext{Tokens: 5})
+		idle.tokenBucketBudget.PreprocessRequest(clk.Now(), scheduler.RequestContext{Tokens: 0})
+	}
+
+	for _, cba := range []*concurrencyBudgetActuator{busy, idle, busy, idle} {
+		if _, err := cba.renewLease(context.Background()); err != nil {
+			t.Fatalf("Failed to renew lease of %s: %v", cba.agentID, err)
+		}
+	}
+	busyLease, idleLease := busy.tokenBucketBudget.Lease(), idle.tokenBucketBudget.Lease()
+	if busyLease+idleLease > 100 {
+		t.Errorf("Expected leases to add up to at most the budget, got %f and %f", busyLease, idleLease)
+	}
+	if busyLease < 50 {
+		t.Errorf("Expected the busy agent to lease at least its fair share, got %f", busyLease)
+	}
+
+	// the idle agent admits a flow arriving before its next renewal
+	clk.Advance(100 * time.Millisecond)
+	if idle.tokenBucketBudget.PreprocessRequest(clk.Now(), scheduler.RequestContext{Tokens: 1}) {
+		t.Fatal("Expected the idle agent to go through its token bucket")
+	}
+	if !idle.tokenBucketBudget.TakeIfAvailable(clk.Now(), 1) {
+		t.Errorf("Expected the idle agent to admit a flow, lease %f", idleLease)
+	}
+}
+
+func newTestDMap(t *testing.T) *olric.DMap {
+	c := olricconfig.New("local")
+	ctx, cancel := context.WithCancel(context.Background())
+	c.Started = cancel
+	o, err := olric.New(c)
+	if err != nil {
+		t.Fatalf("Failed to create olric: %v", err)
+	}
+	go func() {
+		if err := o.Start(); err != nil {
+			t.Errorf("Failed to start olric: %v", err)
+		}
+	}()
+	select {
+	case <-time.After(10 * time.Second):
+		t.Fatal("Olric cannot be started in ten seconds")
+	case <-ctx.Done():
+	}
+	t.Cleanup(func() {
+		_ = o.Shutdown(context.Background())
+	})
+
+	dMap, err := o.NewDMap("budget")
+	if err != nil {
+		t.Fatalf("Failed to create DMap: %v", err)
+	}
+	return dMap
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/http"
 	"path"
 	"strconv"
 	"time"
@@ -20,6 +21,7 @@ import (
 	wrappersv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/wrappers/v1"
 	"github.com/fluxninja/aperture/pkg/agentinfo"
 	"github.com/fluxninja/aperture/pkg/config"
+	"github.com/fluxninja/aperture/pkg/distcache"
 	etcdclient "github.com/fluxninja/aperture/pkg/etcd/client"
 	etcdwatcher "github.com/fluxninja/aperture/pkg/etcd/watcher"
 	"github.com/fluxninja/aperture/pkg/log"
@@ -80,12 +82,14 @@ type concurrencyLimiterFactory struct {
 	engineAPI iface.Engine
 	registry  status.Registry
 
-	autoTokensFactory       *autoTokensFactory
-	loadShedActuatorFactory *loadShedActuatorFactory
+	autoTokensFactory                *autoTokensFactory
+	loadShedActuatorFactory          *loadShedActuatorFactory
+	concurrencyBudgetActuatorFactory *concurrencyBudgetActuatorFactory
 
 	// WFQ Metrics.
-	wfqFlowsGaugeVec    *prometheus.GaugeVec
-	wfqRequestsGaugeVec *prometheus.GaugeVec
+	wfqFlowsGaugeVec                  *prometheus.GaugeVec
+	wfqRequestsGaugeVec               *prometheus.GaugeVec
+	wfqWorkloadQueuedRequestsGaugeVec *prometheus.GaugeVec
 
 	// TODO: following will be moved to scheduler.
 	incomingConcurrencyCounterVec *prometheus.CounterVec
@@ -103,6 +107,7 @@ func setupConcurrencyLimiterFactory(
 	prometheusRegistry *prometheus.Registry,
 	etcdClient *etcdclient.Client,
 	ai *agentinfo.AgentInfo,
+	distCache *distcache.DistCache,
 ) error {
 	agentGroup := ai.GetAgentGroup()
 
@@ -119,11 +124,17 @@ func setupConcurrencyLimiterFactory(
 
 	reg := statusRegistry.Child("concurrency_limiter")
 
+	concurrencyBudgetActuatorFactory, err := newConcurrencyBudgetActuatorFactory(lifecycle, etcdClient, agentGroup, distCache, loadShedActuatorFactory, reg)
+	if err != nil {
+		return err
+	}
+
 	conLimiterFactory := &concurrencyLimiterFactory{
-		engineAPI:               e,
-		autoTokensFactory:       autoTokensFactory,
-		loadShedActuatorFactory: loadShedActuatorFactory,
-		registry:                reg,
+		engineAPI:                        e,
+		autoTokensFactory:                autoTokensFactory,
+		loadShedActuatorFactory:          loadShedActuatorFactory,
+		concurrencyBudgetActuatorFactory: concurrencyBudgetActuatorFactory,
+		registry:                         reg,
 	}
 
 	conLimiterFactory.wfqFlowsGaugeVec = prometheus.NewGaugeVec(
@@ -140,6 +151,16 @@ func setupConcurrencyLimiterFactory(
 		},
 		metricLabelKeys,
 	)
+	conLimiterFactory.wfqWorkloadQueuedRequestsGaugeVec = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: metrics.WFQWorkloadQueuedRequestsMetricName,
+			Help: "A gauge that tracks the number of queued requests per workload in the WFQScheduler",
+		},
+		[]string{
+			metrics.PolicyNameLabel, metrics.PolicyHashLabel, metrics.ComponentIndexLabel,
+			metrics.WorkloadIndexLabel,
+		},
+	)
 	conLimiterFactory.incomingConcurrencyCounterVec = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: metrics.IncomingConcurrencyMetricName,
@@ -185,6 +206,10 @@ func setupConcurrencyLimiterFactory(
 			if err != nil {
 				merr = multierr.Append(merr, err)
 			}
+			err = prometheusRegistry.Register(conLimiterFactory.wfqWorkloadQueuedRequestsGaugeVec)
+			if err != nil {
+				merr = multierr.Append(merr, err)
+			}
 			err = prometheusRegistry.Register(conLimiterFactory.incomingConcurrencyCounterVec)
 			if err != nil {
 				merr = multierr.Append(merr, err)
@@ -211,6 +236,10 @@ func setupConcurrencyLimiterFactory(
 				err := fmt.Errorf("failed to unregister wfq_requests metric")
 				merr = multierr.Append(merr, err)
 			}
+			if !prometheusRegistry.Unregister(conLimiterFactory.wfqWorkloadQueuedRequestsGaugeVec) {
+				err := fmt.Errorf("failed to unregister wfq_workload_queued_requests metric")
+				merr = multierr.Append(merr, err)
+			}
 			if !prometheusRegistry.Unregister(conLimiterFactory.incomingConcurrencyCounterVec) {
 				err := fmt.Errorf("failed to unregister incoming_concurrency metric")
 				merr = multierr.Append(merr, err)
@@ -290,6 +319,9 @@ func (conLimiterFactory *concurrencyLimiterFactory) newConcurrencyLimiterOptions
 		defaultWorkloadParametersProto: schedulerProto.DefaultWorkloadParameters,
 		schedulerProto:                 schedulerProto,
 		workloadLatencySummaryVec:      conLimiterFactory.workloadLatencySummaryVec,
+		deniedResponse: common.NewDeniedResponse(concurrencyLimiterMessage.GetRejectionResponse(),
+			http.StatusServiceUnavailable,
+			schedulerProto.GetMaxTimeout().AsDuration()),
 	}
 
 	return fx.Options(
@@ -327,15 +359,17 @@ type concurrencyLimiter struct {
 	workloadMultiMatcher           *multiMatcher
 	defaultWorkloadParametersProto *policylangv1.Scheduler_WorkloadParameters
 	schedulerProto                 *policylangv1.Scheduler
+	deniedResponse                 *flowcontrolv1.DeniedResponse
 }
 
 // Make sure ConcurrencyLimiter implements the iface.ConcurrencyLimiter.
-var _ iface.Limiter = &concurrencyLimiter{}
+var _ iface.ConcurrencyLimiter = &concurrencyLimiter{}
 
 func (conLimiter *concurrencyLimiter) setup(lifecycle fx.Lifecycle) error {
 	// Factories
 	conLimiterFactory := conLimiter.concurrencyLimiterFactory
 	loadShedActuatorFactory := conLimiterFactory.loadShedActuatorFactory
+	concurrencyBudgetActuatorFactory := conLimiterFactory.concurrencyBudgetActuatorFactory
 	autoTokensFactory := conLimiterFactory.autoTokensFactory
 	// Form metric labels
 	metricLabels := make(prometheus.Labels)
@@ -344,9 +378,21 @@ func (conLimiter *concurrencyLimiter) setup(lifecycle fx.Lifecycle) error {
 	metricLabels[metrics.ComponentIndexLabel] = strconv.FormatInt(conLimiter.GetComponentIndex(), 10)
 	// Create sub components.
 	clock := clockwork.NewRealClock()
-	loadShedActuator, err := loadShedActuatorFactory.newLoadShedActuator(conLimiter, conLimiter.registry, clock, lifecycle, metricLabels)
-	if err != nil {
-		return err
+	// The token manager is created when the actuator starts
+	var getTokenManager func() scheduler.TokenManager
+	if concurrencyBudgetActuatorProto := conLimiter.concurrencyLimiterProto.GetConcurrencyBudgetActuator(); concurrencyBudgetActuatorProto != nil {
+		concurrencyBudgetActuator, err := concurrencyBudgetActuatorFactory.newConcurrencyBudgetActuator(conLimiter,
+			concurrencyBudgetActuatorProto, conLimiter.registry, clock, lifecycle, metricLabels)
+		if err != nil {
+			return err
+		}
+		getTokenManager = func() scheduler.TokenManager { return concurrencyBudgetActuator.tokenBucketBudget }
+	} else {
+		loadShedActuator, err := loadShedActuatorFactory.newLoadShedActuator(conLimiter, conLimiter.registry, clock, lifecycle, metricLabels)
+		if err != nil {
+			return err
+		}
+		getTokenManager = func() scheduler.TokenManager { return loadShedActuator.tokenBucketLoadShed }
 	}
 	if conLimiter.schedulerProto.AutoTokens {
 		autoTokens, err := autoTokensFactory.newAutoTokens(
@@ -361,6 +407,7 @@ func (conLimiter *concurrencyLimiter) setup(lifecycle fx.Lifecycle) error {
 	engineAPI := conLimiterFactory.engineAPI
 	wfqFlowsGaugeVec := conLimiterFactory.wfqFlowsGaugeVec
 	wfqRequestsGaugeVec := conLimiterFactory.wfqRequestsGaugeVec
+	wfqWorkloadQueuedRequestsGaugeVec := conLimiterFactory.wfqWorkloadQueuedRequestsGaugeVec
 	incomingConcurrencyCounterVec := conLimiterFactory.incomingConcurrencyCounterVec
 	acceptedConcurrencyCounterVec := conLimiterFactory.acceptedConcurrencyCounterVec
 
@@ -381,13 +428,28 @@ func (conLimiter *concurrencyLimiter) setup(lifecycle fx.Lifecycle) error {
 				return errors.Wrap(err, "failed to get wfq requests gauge")
 			}
 
+			wfqWorkloadQueuedRequestsGauges, err := wfqWorkloadQueuedRequestsGaugeVec.CurryWith(metricLabels)
+			if err != nil {
+				return errors.Wrap(err, "failed to get wfq workload queued requests gauge")
+			}
+
 			wfqMetrics := &scheduler.WFQMetrics{
-				FlowsGauge:        wfqFlowsGauge,
-				HeapRequestsGauge: wfqRequestsGauge,
+				FlowsGauge:                     wfqFlowsGauge,
+				HeapRequestsGauge:              wfqRequestsGauge,
+				WorkloadQueuedRequestsGaugeVec: wfqWorkloadQueuedRequestsGauges,
+			}
+
+			var codelParams *scheduler.CoDelParams
+			if codelProto := conLimiter.schedulerProto.GetCodel(); codelProto != nil {
+				codelParams = &scheduler.CoDelParams{
+					Target:       codelProto.Target.AsDuration(),
+					Interval:     codelProto.Interval.AsDuration(),
+					AdaptiveLIFO: codelProto.AdaptiveLifo,
+				}
 			}
 
 			// setup scheduler
-			conLimiter.scheduler = scheduler.NewWFQScheduler(conLimiter.schedulerProto.MaxTimeout.AsDuration(), loadShedActuator.tokenBucketLoadShed, clock, wfqMetrics)
+			conLimiter.scheduler = scheduler.NewWFQScheduler(conLimiter.schedulerProto.MaxTimeout.AsDuration(), getTokenManager(), clock, wfqMetrics, codelParams)
 
 			incomingConcurrencyCounter, err := incomingConcurrencyCounterVec.GetMetricWith(metricLabels)
 			if err != nil {
@@ -424,6 +486,8 @@ func (conLimiter *concurrencyLimiter) setup(lifecycle fx.Lifecycle) error {
 			if !deleted {
 				errMulti = multierr.Append(errMulti, errors.New("failed to delete wfq_requests gauge from its metric vector"))
 			}
+			// Workload queue gauges are created lazily, there might be none
+			wfqWorkloadQueuedRequestsGaugeVec.DeletePartialMatch(metricLabels)
 			deleted = incomingConcurrencyCounterVec.Delete(metricLabels)
 			if !deleted {
 				errMulti = multierr.Append(errMulti, errors.New("failed to delete incoming_concurrency counter from its metric vector"))
@@ -499,14 +563,21 @@ func (conLimiter *concurrencyLimiter) RunLimiter(labels map[string]string) *flow
 		timeout = conLimiter.schedulerProto.MaxTimeout.AsDuration()
 	}
 
+	// In shadow mode, the flow is never dropped or delayed but the would-be drop is recorded
+	shadowMode := conLimiter.concurrencyLimiterProto.GetShadowMode()
+
 	reqContext := scheduler.RequestContext{
-		FairnessLabel: fairnessLabel,
-		Priority:      uint8(matchedWorkloadProto.Priority),
-		Timeout:       timeout,
-		Tokens:        tokens,
+		NoWait:            shadowMode,
+		FairnessLabel:     fairnessLabel,
+		Priority:          uint8(matchedWorkloadProto.Priority),
+		Timeout:           timeout,
+		Tokens:            tokens,
+		WorkloadKey:       matchedWorkloadIndex,
+		MaxQueuedRequests: matchedWorkloadProto.MaxQueuedRequests,
+		MaxQueuedTokens:   matchedWorkloadProto.MaxQueuedTokens,
 	}
 
-	accepted := conLimiter.scheduler.Schedule(reqContext)
+	accepted, rejectReason := conLimiter.scheduler.Schedule(reqContext)
 
 	// update concurrency metrics and decisionType
 	conLimiter.incomingConcurrencyCounter.Add(float64(reqContext.Tokens))
@@ -515,19 +586,32 @@ func (conLimiter *concurrencyLimiter) RunLimiter(labels map[string]string) *flow
 		conLimiter.acceptedConcurrencyCounter.Add(float64(reqContext.Tokens))
 	}
 
+	reason := flowcontrolv1.LimiterDecision_LIMITER_REASON_UNSPECIFIED
+	if rejectReason == scheduler.RejectReasonQueueFull {
+		reason = flowcontrolv1.LimiterDecision_LIMITER_REASON_QUEUE_FULL
+	}
+
 	return &flowcontrolv1.LimiterDecision{
-		PolicyName:     conLimiter.GetPolicyName(),
-		PolicyHash:     conLimiter.GetPolicyHash(),
-		ComponentIndex: conLimiter.GetComponentIndex(),
-		Dropped:        !accepted,
+		PolicyName:       conLimiter.GetPolicyName(),
+		PolicyHash:       conLimiter.GetPolicyHash(),
+		ComponentIndex:   conLimiter.GetComponentIndex(),
+		Dropped:          !accepted && !shadowMode,
+		WouldHaveDropped: !accepted && shadowMode,
+		Reason:           reason,
 		Details: &flowcontrolv1.LimiterDecision_ConcurrencyLimiterInfo_{
 			ConcurrencyLimiterInfo: &flowcontrolv1.LimiterDecision_ConcurrencyLimiterInfo{
 				WorkloadIndex: matchedWorkloadIndex,
+				Tokens:        tokens,
 			},
 		},
 	}
 }
 
+// ReturnTokens returns tokens which were taken by a flow but not used to the scheduler.
+func (conLimiter *concurrencyLimiter) ReturnTokens(tokens uint64) {
+	conLimiter.scheduler.Return(tokens)
+}
+
 // GetLimiterID returns the limiter ID.
 func (conLimiter *concurrencyLimiter) GetLimiterID() iface.LimiterID {
 	// TODO: move this to limiter base.
@@ -548,3 +632,8 @@ func (conLimiter *concurrencyLimiter) GetObserver(labels map[string]string) prom
 
 	return latencyHistogram
 }
+
+// GetDeniedResponse returns the response for the clients of rejected flows.
+func (conLimiter *concurrencyLimiter) GetDeniedResponse(*flowcontrolv1.LimiterDecision) *flowcontrolv1.DeniedResponse {
+	return conLimiter.deniedResponse
+}
@@ -0,0 +1,59 @@
+package scheduler
+
+import (
+	"math"
+	"time"
+)
+
+// CoDelParams holds the parameters of controlled delay (CoDel) queueing discipline.
+type CoDelParams struct {
+	// Target queueing delay
+	Target time.Duration
+	// Interval over which the minimum queueing delay is tracked
+	Interval time.Duration
+	// Serve newest requests first within a flow while overloaded
+	AdaptiveLIFO bool
+}
+
+// coDel detects a standing queue by tracking the minimum queueing delay over an interval.
+// While the standing queue persists, requests that waited for too long are dropped early.
+type coDel struct {
+	intervalTime time.Time // End of the current interval
+	params       CoDelParams
+	minDelay     time.Duration // Minimum queueing delay seen in the current interval
+	overloaded   bool
+}
+
+func newCoDel(now time.Time, params CoDelParams) *coDel {
+	return &coDel{
+		params:       params,
+		intervalTime: now.Add(params.Interval),
+		minDelay:     time.Duration(math.MaxInt64),
+	}
+}
+
+// observe records the queueing delay of a dequeued request. Return value - true: drop the request.
+func (c *coDel) observe(now time.Time, delay time.Duration) bool {
+	if delay < c.minDelay {
+		c.minDelay = delay
+	}
+	if now.After(c.intervalTime) {
+		// queueing delay did not go below the target for a whole interval
+		c.overloaded = c.minDelay > c.params.Target
+		c.minDelay = delay
+		c.intervalTime = now.Add(c.params.Interval)
+	}
+	return c.overloaded && delay > 2*c.params.Target
+}
+
+// reset is called when the queue drains, there is no standing queue anymore.
+func (c *coDel) reset(now time.Time) {
+	c.overloaded = false
+	c.minDelay = time.Duration(math.MaxInt64)
+	c.intervalTime = now.Add(c.params.Interval)
+}
+
+// lifo tells whether the newest requests within a flow should be served first.
+func (c *coDel) lifo() bool {
+	return c.params.AdaptiveLIFO && c.overloaded
+}
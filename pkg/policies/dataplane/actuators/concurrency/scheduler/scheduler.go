@@ -10,13 +10,32 @@ type RequestContext struct {
 	Tokens        uint64 // expected latency for this request
 	Priority      uint8  // larger values represent higher priority
 	Timeout       time.Duration
+	NoWait        bool // accept only if tokens are available right away, never queue
+	// queue limits of the workload, zero means unlimited
+	WorkloadKey       string // identifies the workload the queue limits apply to
+	MaxQueuedRequests uint64
+	MaxQueuedTokens   uint64
 }
 
+// RejectReason tells why the scheduler rejected a request.
+type RejectReason uint8
+
+const (
+	// RejectReasonNone - request was accepted.
+	RejectReasonNone RejectReason = iota
+	// RejectReasonTimeout - request did not get tokens within its timeout.
+	RejectReasonTimeout
+	// RejectReasonQueueFull - workload queue was full when the request arrived.
+	RejectReasonQueueFull
+)
+
 // Scheduler : Interface for schedulers.
 type Scheduler interface {
 	// Schedule sends RequestContext to the underlying scheduler and returns a boolean value,
-	// where true means accept and false means reject.
-	Schedule(rContext RequestContext) bool
+	// where true means accept and false means reject. In case of reject, the reason is returned as well.
+	Schedule(rContext RequestContext) (bool, RejectReason)
+	// Return gives back tokens of an accepted request, which were not used by it.
+	Return(tokens uint64)
 }
 
 // TokenManager : Interface for token managers.
@@ -27,4 +46,6 @@ type TokenManager interface {
 	Take(now time.Time, timeout time.Duration, tokens float64) (time.Duration, bool)
 	// Provides TokenManager the request that the scheduler processing -- some TokenManager implementations use this level of visibility for their algorithms. Return value decides whether the request has to be accepted right away in case TokenManger is not yet ready or configured to accept all traffic (short circuit).
 	PreprocessRequest(now time.Time, rContext RequestContext) bool
+	// Return tokens which were taken but not used.
+	Return(now time.Time, tokens float64)
 }
@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBucketBudget is a token bucket filled at the rate leased from a concurrency budget shared by the agent group.
+type TokenBucketBudget struct {
+	lock    sync.Mutex
+	tbb     *tokenBucketBase
+	counter *WindowedCounter
+	leased  bool // false until the first lease, accept all traffic meanwhile
+}
+
+// NewTokenBucketBudget creates a new TokenBucketBudget.
+func NewTokenBucketBudget(now time.Time, metrics *TokenBucketMetrics) *TokenBucketBudget {
+	tbbu := &TokenBucketBudget{
+		tbb: &tokenBucketBase{},
+	}
+
+	if metrics != nil {
+		tbbu.tbb.metrics = metrics
+	}
+
+	slotDuration, _ := time.ParseDuration("100ms")
+	// maintain a 1 sec window with 100ms slots for counters
+	tbbu.counter = NewWindowedCounter(now, 10, slotDuration)
+
+	return tbbu
+}
+
+// SetLease sets the leased slice of the concurrency budget in tokens/sec, negative lease stops limiting the traffic.
+func (tbbu *TokenBucketBudget) SetLease(now time.Time, lease float64) {
+	tbbu.lock.Lock()
+	defer tbbu.lock.Unlock()
+
+	if lease < 0 {
+		tbbu.leased = false
+		return
+	}
+	if !tbbu.leased {
+		// start with a full bucket
+		tbbu.tbb.setFillRate(now, lease)
+		tbbu.tbb.addTokens(lease)
+		tbbu.leased = true
+		return
+	}
+	tbbu.tbb.setFillRate(now, lease)
+}
+
+// Lease returns the leased slice of the concurrency budget in tokens/sec, negative if not leased.
+func (tbbu *TokenBucketBudget) Lease() float64 {
+	tbbu.lock.Lock()
+	defer tbbu.lock.Unlock()
+	if !tbbu.leased {
+		return -1
+	}
+	return tbbu.tbb.getFillRate()
+}
+
+// IncomingTokenRate returns the rate of incoming tokens/sec, used to size the lease. Returns false if still learning the rate.
+func (tbbu *TokenBucketBudget) IncomingTokenRate() (float64, bool) {
+	tbbu.lock.Lock()
+	defer tbbu.lock.Unlock()
+	if tbbu.counter.IsBootstrapping() {
+		return 0, false
+	}
+	return tbbu.counter.CalculateTokenRate(), true
+}
+
+// PreprocessRequest preprocesses a request and makes decision whether to accept or reject the request.
+func (tbbu *TokenBucketBudget) PreprocessRequest(now time.Time, rContext RequestContext) bool {
+	tbbu.lock.Lock()
+	defer tbbu.lock.Unlock()
+
+	// Shift counter slot if needed
+	tbbu.counter.AddTokens(now, rContext.Tokens)
+
+	// Accept this request if no lease has been taken yet
+	if !tbbu.leased {
+		tbbu.tbb.adjustTokens(now)
+		return true
+	}
+
+	return false
+}
+
+// TakeIfAvailable takes tokens from the token bucket if available, otherwise return false.
+func (tbbu *TokenBucketBudget) TakeIfAvailable(now time.Time, tokens float64) bool {
+	tbbu.lock.Lock()
+	defer tbbu.lock.Unlock()
+	return tbbu.tbb.takeIfAvailable(now, tokens)
+}
+
+// Take takes tokens from the token bucket even if available tokens are less than asked.
+// If tokens are not available at the moment, it will return amount of wait time and checks
+// whether the operation was successful or not.
+func (tbbu *TokenBucketBudget) Take(now time.Time, timeout time.Duration, tokens float64) (time.Duration, bool) {
+	tbbu.lock.Lock()
+	defer tbbu.lock.Unlock()
+	return tbbu.tbb.take(now, timeout, tokens)
+}
+
+// Return returns tokens which were taken but not used to the token bucket.
+func (tbbu *TokenBucketBudget) Return(now time.Time, tokens float64) {
+	tbbu.lock.Lock()
+	defer tbbu.lock.Unlock()
+	tbbu.tbb.returnTokens(now, tokens)
+}
@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+func TestBudgetBucket(t *testing.T) {
+	clk := clockwork.NewFakeClock()
+	budget := NewTokenBucketBudget(clk.Now(), nil)
+	rContext := RequestContext{Tokens: 10}
+
+	// no lease yet, accept all
+	if !budget.PreprocessRequest(clk.Now(), rContext) {
+		t.Fatal("Expected request to be accepted before the first lease")
+	}
+	if budget.Lease() >= 0 {
+		t.Fatalf("Expected no lease, got %f", budget.Lease())
+	}
+
+	budget.SetLease(clk.Now(), 100)
+	if budget.PreprocessRequest(clk.Now(), rContext) {
+		t.Fatal("Expected request to go through the token bucket once leased")
+	}
+	// the bucket starts full
+	for i := 0; i < 9; i++ {
+		if !budget.TakeIfAvailable(clk.Now(), 10) {
+			t.Fatalf("Expected tokens to be available for request %d", i)
+		}
+	}
+	if budget.TakeIfAvailable(clk.Now(), 10) {
+		t.Fatal("Expected the leased tokens to be exhausted")
+	}
+
+	// the bucket refills at the leased rate
+	clk.Advance(100 * time.Millisecond)
+	if !budget.TakeIfAvailable(clk.Now(), 10) {
+		t.Fatal("Expected tokens to be refilled")
+	}
+
+	// negative lease stops limiting
+	budget.SetLease(clk.Now(), -1)
+	if !budget.PreprocessRequest(clk.Now(), rContext) {
+		t.Fatal("Expected request to be accepted after the lease is dropped")
+	}
+}
+
+func TestBudgetBucketIncomingTokenRate(t *testing.T) {
+	clk := clockwork.NewFakeClock()
+	budget := NewTokenBucketBudget(clk.Now(), nil)
+
+	if _, ok := budget.IncomingTokenRate(); ok {
+		t.Fatal("Expected the incoming token rate to be unknown while bootstrapping")
+	}
+	for i := 0; i < 30; i++ {
+		clk.Advance(100 * time.Millisecond)
+		budget.PreprocessRequest(clk.Now(), RequestContext{Tokens: 5})
+	}
+	rate, ok := budget.IncomingTokenRate()
+	if !ok {
+		t.Fatal("Expected the incoming token rate to be known")
+	}
+	if rate < 40 || rate > 60 {
+		t.Fatalf("Expected incoming token rate of about 50, got %f", rate)
+	}
+}
@@ -127,6 +127,13 @@ func (tbls *TokenBucketLoadShed) Take(now time.Time, timeout time.Duration, toke
 	return tbls.tbb.take(now, timeout, tokens)
 }
 
+// Return returns tokens which were taken but not used to the token bucket.
+func (tbls *TokenBucketLoadShed) Return(now time.Time, tokens float64) {
+	tbls.lock.Lock()
+	defer tbls.lock.Unlock()
+	tbls.tbb.returnTokens(now, tokens)
+}
+
 func (tbls *TokenBucketLoadShed) setLSFGauge(v float64) {
 	if tbls.lsfGauge != nil {
 		tbls.lsfGauge.Set(v)
@@ -107,6 +107,11 @@ func (tbb *tokenBucketBase) takeIfAvailable(now time.Time, tokens float64) bool
 	return false
 }
 
+func (tbb *tokenBucketBase) returnTokens(now time.Time, tokens float64) {
+	tbb.adjustTokens(now)
+	tbb.addTokens(tokens)
+}
+
 func (tbb *tokenBucketBase) getFillRate() float64 {
 	return tbb.fillRate
 }
@@ -146,6 +151,13 @@ func (btb *BasicTokenBucket) Take(now time.Time, timeout time.Duration, tokens f
 	return btb.tbb.take(now, timeout, tokens)
 }
 
+// Return returns tokens which were taken but not used to the basic token bucket.
+func (btb *BasicTokenBucket) Return(now time.Time, tokens float64) {
+	btb.lock.Lock()
+	defer btb.lock.Unlock()
+	btb.tbb.returnTokens(now, tokens)
+}
+
 // PreprocessRequest is a no-op for BasicTokenBucket and by default, it rejects the request.
 func (btb *BasicTokenBucket) PreprocessRequest(now time.Time, rContext RequestContext) bool {
 	// do nothing
@@ -21,6 +21,8 @@ type queuedRequest struct {
 	timeout     time.Duration // Timeout for this request
 	vft         uint64        // Virtual finish time
 	cost        uint64        // Cost of the request (invPriority * tokens)
+	tokens      uint64        // Tokens of the request
+	wQueue      *workloadQueue
 }
 
 ////////
@@ -40,6 +42,7 @@ func getHeapRequest() *queuedRequest {
 
 func putHeapRequest(qRequest *queuedRequest) {
 	qRequest.fInfo = nil
+	qRequest.wQueue = nil
 	qRequestPool.Put(qRequest)
 }
 
@@ -108,6 +111,16 @@ func putFlowInfo(fInfo *flowInfo) {
 
 ////////
 
+// workloadQueue tracks the requests of a workload waiting in the scheduler queue.
+type workloadQueue struct {
+	gauge    prometheus.Gauge
+	key      string
+	requests uint64
+	tokens   uint64
+}
+
+////////
+
 func init() {
 	qRequestPool.New = newHeapRequest
 	fInfoPool.New = newFlowInfo
@@ -123,8 +136,11 @@ type WFQScheduler struct {
 	clk       clockwork.Clock
 	// metrics
 	metrics *WFQMetrics
+	// controlled delay, nil if disabled
+	codel *coDel
 	// flows
 	flows         map[string]*flowInfo
+	workloads     map[string]*workloadQueue
 	requests      requestHeap
 	minTimeout    time.Duration
 	auditDuration time.Duration
@@ -139,6 +155,8 @@ type WFQScheduler struct {
 type WFQMetrics struct {
 	FlowsGauge        prometheus.Gauge
 	HeapRequestsGauge prometheus.Gauge
+	// WorkloadQueuedRequestsGaugeVec is labeled by WorkloadKey of the requests.
+	WorkloadQueuedRequestsGaugeVec *prometheus.GaugeVec
 }
 
 // GetPendingFlows returns the number of flows in the scheduler.
@@ -152,13 +170,15 @@ func (sched *WFQScheduler) GetPendingRequests() int {
 }
 
 // NewWFQScheduler creates a new weighted fair queue scheduler,
-// timeout -- timeout for requests.
-func NewWFQScheduler(timeout time.Duration, tokenManger TokenManager, clk clockwork.Clock, metrics *WFQMetrics) Scheduler {
+// timeout -- timeout for requests,
+// codelParams -- controlled delay queueing discipline, nil disables it.
+func NewWFQScheduler(timeout time.Duration, tokenManger TokenManager, clk clockwork.Clock, metrics *WFQMetrics, codelParams *CoDelParams) Scheduler {
 	sched := new(WFQScheduler)
 	sched.queueOpen = false
 	sched.generation = 0
 	sched.vt = 0
 	sched.flows = make(map[string]*flowInfo)
+	sched.workloads = make(map[string]*workloadQueue)
 	sched.minTimeout = timeout
 	sched.auditDuration = sched.minTimeout / 2
 	sched.defaultTimeout = timeout
@@ -172,14 +192,25 @@ func NewWFQScheduler(timeout time.Duration, tokenManger TokenManager, clk clockw
 		sched.metrics = metrics
 	}
 
+	if codelParams != nil {
+		sched.codel = newCoDel(now, *codelParams)
+	}
+
 	return sched
 }
 
 // Schedule blocks until the request is scheduled or until timeout (CoDel).
-// Return value - true: Accept, false: Reject.
-func (sched *WFQScheduler) Schedule(rContext RequestContext) bool {
+// Return value - true: Accept, false: Reject along with the reason.
+func (sched *WFQScheduler) Schedule(rContext RequestContext) (bool, RejectReason) {
 	if rContext.Tokens == 0 {
-		return true
+		return true, RejectReasonNone
+	}
+
+	if rContext.NoWait {
+		if sched.takeIfAvailable(rContext) {
+			return true, RejectReasonNone
+		}
+		return false, RejectReasonTimeout
 	}
 
 	// Assign default timeout to this request if it is not set
@@ -188,17 +219,55 @@ func (sched *WFQScheduler) Schedule(rContext RequestContext) bool {
 	}
 
 	// Unable to schedule right now, so queue the request
-	qRequest := sched.enter(rContext)
+	qRequest, queueFull := sched.enter(rContext)
+
+	if queueFull {
+		// workload queue limits reached, reject right away
+		return false, RejectReasonQueueFull
+	}
 
 	if qRequest == nil {
 		// scheduler is not in overload situation and the request was able to get tokens
-		return true
+		return true, RejectReasonNone
 	}
 
 	// scheduler is in overload situation and we have to wait for ready signal and tokens
 	ready := <-qRequest.ready
 	// leave the scheduler
-	return sched.leave(rContext, qRequest, ready)
+	if sched.leave(rContext, qRequest, ready) {
+		return true, RejectReasonNone
+	}
+	return false, RejectReasonTimeout
+}
+
+// takeIfAvailable accepts the request if tokens are available right away, without queueing it.
+func (sched *WFQScheduler) takeIfAvailable(rContext RequestContext) bool {
+	sched.lock.Lock()
+	defer sched.lock.Unlock()
+
+	now := sched.clk.Now()
+	if sched.manager.PreprocessRequest(now, rContext) {
+		return true
+	}
+	return sched.manager.TakeIfAvailable(now, float64(rContext.Tokens))
+}
+
+// Return gives back tokens of an accepted request, which were not used by it, to the token manager.
+func (sched *WFQScheduler) Return(tokens uint64) {
+	if tokens == 0 {
+		return
+	}
+	sched.manager.Return(sched.clk.Now(), float64(tokens))
+}
+
+// GetQueuedRequests returns the number of requests of a workload waiting in the scheduler queue.
+func (sched *WFQScheduler) GetQueuedRequests(workloadKey string) uint64 {
+	sched.lock.Lock()
+	defer sched.lock.Unlock()
+	if wQueue, ok := sched.workloads[workloadKey]; ok {
+		return wQueue.requests
+	}
+	return 0
 }
 
 // Construct FlowID by appending RequestLabel and Priority.
@@ -243,7 +312,7 @@ func (sched *WFQScheduler) auditHeap(now time.Time) {
 				// request is stale, cancel it
 				request.ready <- false
 				// replace with the next request in the flow
-				elm := request.fInfo.queue.Front()
+				elm := sched.nextFlowElement(request.fInfo)
 				if elm != nil {
 					request.fInfo.queue.Remove(elm)
 					nextReq := elm.Value.(*queuedRequest)
@@ -272,7 +341,8 @@ func (sched *WFQScheduler) auditHeap(now time.Time) {
 // Attempt to queue this request.
 // If queued successfully, return a valid heapRequest.
 // Otherwise, request was handled right away without queuing - return nil.
-func (sched *WFQScheduler) enter(rContext RequestContext) (qRequest *queuedRequest) {
+// queueFull is true if the request was rejected due to workload queue limits.
+func (sched *WFQScheduler) enter(rContext RequestContext) (qRequest *queuedRequest, queueFull bool) {
 	sched.lock.Lock()
 	defer sched.lock.Unlock()
 
@@ -285,7 +355,7 @@ func (sched *WFQScheduler) enter(rContext RequestContext) (qRequest *queuedReque
 	}
 
 	if sched.manager.PreprocessRequest(now, rContext) {
-		return nil
+		return nil, false
 	}
 
 	// try to schedule right now
@@ -293,8 +363,31 @@ func (sched *WFQScheduler) enter(rContext RequestContext) (qRequest *queuedReque
 		ok := sched.manager.TakeIfAvailable(now, float64(rContext.Tokens))
 		if ok {
 			// we got the tokens, no need to queue
-			return nil
+			return nil, false
+		}
+	}
+
+	// check workload queue limits before queueing
+	wQueue, ok := sched.workloads[rContext.WorkloadKey]
+	if ok {
+		if rContext.MaxQueuedRequests > 0 && wQueue.requests >= rContext.MaxQueuedRequests {
+			return nil, true
+		}
+		if rContext.MaxQueuedTokens > 0 && wQueue.tokens+rContext.Tokens > rContext.MaxQueuedTokens {
+			return nil, true
+		}
+	} else {
+		if rContext.MaxQueuedTokens > 0 && rContext.Tokens > rContext.MaxQueuedTokens {
+			return nil, true
+		}
+		wQueue = &workloadQueue{key: rContext.WorkloadKey}
+		if sched.metrics != nil && sched.metrics.WorkloadQueuedRequestsGaugeVec != nil {
+			gauge, err := sched.metrics.WorkloadQueuedRequestsGaugeVec.GetMetricWithLabelValues(rContext.WorkloadKey)
+			if err == nil {
+				wQueue.gauge = gauge
+			}
 		}
+		sched.workloads[rContext.WorkloadKey] = wQueue
 	}
 
 	// we are in overload situation, attempt to queue
@@ -343,6 +436,13 @@ func (sched *WFQScheduler) enter(rContext RequestContext) (qRequest *queuedReque
 	// Store the cost of the request
 	qRequest.cost = cost
 
+	// Account the request in its workload queue
+	qRequest.tokens = rContext.Tokens
+	qRequest.wQueue = wQueue
+	wQueue.requests++
+	wQueue.tokens += rContext.Tokens
+	sched.setWorkloadQueuedRequestsGauge(wQueue)
+
 	// Run audits if needed (audit runs at half the minTimeout, i.e. nyquist frequency)
 	if now.Sub(fInfo.auditTime) > sched.auditDuration {
 		sched.auditFlow(now, fInfo)
@@ -366,7 +466,7 @@ func (sched *WFQScheduler) enter(rContext RequestContext) (qRequest *queuedReque
 		// This is the only request in queue at this time, wake it up
 		qRequest.ready <- true
 	}
-	return qRequest
+	return qRequest, false
 }
 
 // adjust queue counters. Note: qRequest pointer should not be used after calling this function as it will get recycled via Pool.
@@ -405,6 +505,7 @@ func (sched *WFQScheduler) leave(rContext RequestContext, qRequest *queuedReques
 		} else { // check whether new requests arrived in between that need loading
 			sched.loadNextFlowReq(qRequest.fInfo)
 		}
+		sched.removeFromWorkloadQueue(qRequest)
 		putHeapRequest(qRequest)
 		qRequest = nil
 	}()
@@ -445,12 +546,18 @@ func (sched *WFQScheduler) wakeNextRequest() {
 			// close the queue
 			sched.generation++
 			sched.queueOpen = false
+			if sched.codel != nil {
+				sched.codel.reset(now)
+			}
 			return
 		}
 		// Pop from queue and wake a valid request
 		qRequest := heap.Pop(&sched.requests).(*queuedRequest)
 		qRequest.fInfo.requestOnHeap = false
-		if now.Sub(qRequest.enqueueTime) < qRequest.timeout {
+		delay := now.Sub(qRequest.enqueueTime)
+		// drop early if there's a standing queue (CoDel)
+		codelDrop := sched.codel != nil && sched.codel.observe(now, delay)
+		if delay < qRequest.timeout && !codelDrop {
 			// wake up this request
 			qRequest.ready <- true
 			return
@@ -463,7 +570,7 @@ func (sched *WFQScheduler) wakeNextRequest() {
 
 func (sched *WFQScheduler) loadNextFlowReq(fInfo *flowInfo) {
 	if !fInfo.requestOnHeap {
-		elm := fInfo.queue.Front()
+		elm := sched.nextFlowElement(fInfo)
 		if elm != nil {
 			fInfo.queue.Remove(elm)
 			nextReq := elm.Value.(*queuedRequest)
@@ -474,6 +581,15 @@ func (sched *WFQScheduler) loadNextFlowReq(fInfo *flowInfo) {
 	}
 }
 
+// nextFlowElement returns the request of the flow that goes on the heap next.
+// It's the oldest one, or the newest one if adaptive LIFO kicked in.
+func (sched *WFQScheduler) nextFlowElement(fInfo *flowInfo) *list.Element {
+	if sched.codel != nil && sched.codel.lifo() {
+		return fInfo.queue.Back()
+	}
+	return fInfo.queue.Front()
+}
+
 func (sched *WFQScheduler) setFlowsGauge(v float64) {
 	if sched.metrics != nil && sched.metrics.FlowsGauge != nil {
 		sched.metrics.FlowsGauge.Set(v)
@@ -485,3 +601,20 @@ func (sched *WFQScheduler) setRequestsGauge(v float64) {
 		sched.metrics.HeapRequestsGauge.Set(v)
 	}
 }
+
+// parent function must hold the lock.
+func (sched *WFQScheduler) removeFromWorkloadQueue(qRequest *queuedRequest) {
+	wQueue := qRequest.wQueue
+	wQueue.requests--
+	wQueue.tokens -= qRequest.tokens
+	sched.setWorkloadQueuedRequestsGauge(wQueue)
+	if wQueue.requests == 0 {
+		delete(sched.workloads, wQueue.key)
+	}
+}
+
+func (sched *WFQScheduler) setWorkloadQueuedRequestsGauge(wQueue *workloadQueue) {
+	if wQueue.gauge != nil {
+		wQueue.gauge.Set(float64(wQueue.requests))
+	}
+}
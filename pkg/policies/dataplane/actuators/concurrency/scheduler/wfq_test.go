@@ -88,6 +88,7 @@ type flowTracker struct {
 	// counters
 	totalRequests    uint64 // total requests made
 	acceptedRequests uint64 // total requests accepted
+	acceptedWaitTime int64  // total time accepted requests waited in the scheduler (ns)
 }
 
 func (flow *flowTracker) String() string {
@@ -140,6 +141,7 @@ func runFlows(sched Scheduler, wg *sync.WaitGroup, flows flowTrackers, duration
 		// reset counters
 		flow.totalRequests = 0
 		flow.acceptedRequests = 0
+		flow.acceptedWaitTime = 0
 		wg.Add(1)
 		go runFlow(sched, wg, flow, duration, clk)
 	}
@@ -154,7 +156,7 @@ func runFlow(sched Scheduler, wg *sync.WaitGroup, flow *flowTracker, duration ti
 	for {
 		atomic.AddUint64(&flow.totalRequests, 1)
 		wg.Add(1)
-		go runRequest(sched, wg, flow)
+		go runRequest(sched, wg, flow, clk)
 		requestDelay := requestDelay
 		nextReqTime := clk.Now().Add(requestDelay)
 		if nextReqTime.Before(stopTime) {
@@ -165,14 +167,24 @@ func runFlow(sched Scheduler, wg *sync.WaitGroup, flow *flowTracker, duration ti
 	}
 }
 
-func runRequest(sched Scheduler, wg *sync.WaitGroup, flow *flowTracker) {
+func runRequest(sched Scheduler, wg *sync.WaitGroup, flow *flowTracker, clk clockwork.Clock) {
 	defer wg.Done()
-	ok := sched.Schedule(flow.makeRequestContext())
+	startTime := clk.Now()
+	ok, _ := sched.Schedule(flow.makeRequestContext())
 	if ok {
 		atomic.AddUint64(&flow.acceptedRequests, 1)
+		atomic.AddInt64(&flow.acceptedWaitTime, int64(clk.Since(startTime)))
 	}
 }
 
+// average time accepted requests of a flow waited in the scheduler
+func (flow *flowTracker) averageWaitTime() time.Duration {
+	if flow.acceptedRequests == 0 {
+		return 0
+	}
+	return time.Duration(flow.acceptedWaitTime / int64(flow.acceptedRequests))
+}
+
 func (flow *flowTracker) makeRequestContext() RequestContext {
 	return RequestContext{
 		FairnessLabel: flow.fairnessLabel,
@@ -231,7 +243,7 @@ func BenchmarkBasicTokenBucket(b *testing.B) {
 		FlowsGauge:        wfqFlowsGauge,
 		HeapRequestsGauge: wfqHeapRequestsGauge,
 	}
-	sched := NewWFQScheduler(timeout, manager, c, schedMetrics)
+	sched := NewWFQScheduler(timeout, manager, c, schedMetrics, nil)
 
 	b.Logf("iterations: %d", b.N)
 
@@ -239,7 +251,7 @@ func BenchmarkBasicTokenBucket(b *testing.B) {
 	b.RunParallel(func(pb *testing.PB) {
 		i := 0
 		for pb.Next() {
-			_ = sched.Schedule(flows[i%len(flows)].makeRequestContext())
+			_, _ = sched.Schedule(flows[i%len(flows)].makeRequestContext())
 		}
 	})
 }
@@ -262,13 +274,13 @@ func BenchmarkTokenBucketLoadShed(b *testing.B) {
 		FlowsGauge:        wfqFlowsGauge,
 		HeapRequestsGauge: wfqHeapRequestsGauge,
 	}
-	sched := NewWFQScheduler(timeout, manager, c, schedMetrics)
+	sched := NewWFQScheduler(timeout, manager, c, schedMetrics, nil)
 
 	// bootstrap bucket
 	bootstrapTime := time.Second * 1
 	for c.Now().Before(startTime.Add(bootstrapTime)) {
 		for _, flow := range flows {
-			_ = sched.Schedule(flow.makeRequestContext())
+			_, _ = sched.Schedule(flow.makeRequestContext())
 		}
 	}
 
@@ -281,7 +293,7 @@ func BenchmarkTokenBucketLoadShed(b *testing.B) {
 	b.RunParallel(func(pb *testing.PB) {
 		i := 0
 		for pb.Next() {
-			_ = sched.Schedule(flows[i%len(flows)].makeRequestContext())
+			_, _ = sched.Schedule(flows[i%len(flows)].makeRequestContext())
 		}
 	})
 }
@@ -305,6 +317,10 @@ func calculateFillRate(flows flowTrackers, lsf float64) float64 {
 }
 
 func baseOfBasicBucketTest(t *testing.T, flows flowTrackers, fillRate float64, noOfRuns int) {
+	baseOfBasicBucketTestWithCoDel(t, flows, fillRate, noOfRuns, nil)
+}
+
+func baseOfBasicBucketTestWithCoDel(t *testing.T, flows flowTrackers, fillRate float64, noOfRuns int, codelParams *CoDelParams) {
 	runtime.GOMAXPROCS(runtime.NumCPU())
 
 	timeout := 50 * time.Millisecond
@@ -319,7 +335,7 @@ func baseOfBasicBucketTest(t *testing.T, flows flowTrackers, fillRate float64, n
 		FlowsGauge:        wfqFlowsGauge,
 		HeapRequestsGauge: wfqHeapRequestsGauge,
 	}
-	sched := NewWFQScheduler(timeout, basicBucket, c, metrics)
+	sched := NewWFQScheduler(timeout, basicBucket, c, metrics, codelParams)
 	var wg sync.WaitGroup
 	var acceptedTokenRatio float64
 
@@ -373,6 +389,11 @@ func baseOfBasicBucketTest(t *testing.T, flows flowTrackers, fillRate float64, n
 		t.Logf("Tokens sent per flow: %v\n", totalTokens)
 		t.Logf("Total accepted tokens per flow after run are: %v\n", acceptedTokens)
 		t.Logf("Total accepted tokens after run are: %v\n", acceptedTokenSum)
+		waitTimes := make([]time.Duration, len(flows))
+		for i, flow := range flows {
+			waitTimes[i] = flow.averageWaitTime()
+		}
+		t.Logf("Average wait time of accepted requests per flow: %v\n", waitTimes)
 
 		for i := range acceptedTokens {
 			// Will not get an accurate reading if traffic rate is very low
@@ -526,6 +547,67 @@ func TestTimeouts(t *testing.T) {
 	baseOfBasicBucketTest(t, flows, calculateFillRate(flows, 0.5), 1)
 }
 
+func TestCoDelHighRpsFlows(t *testing.T) {
+	flows := flowTrackers{
+		{fairnessLabel: "workload0", requestTokens: 5, priority: 0, requestRate: 100},
+		{fairnessLabel: "workload1", requestTokens: 5, priority: 0, requestRate: 100},
+		{fairnessLabel: "workload2", requestTokens: 5, priority: 0, requestRate: 100},
+		{fairnessLabel: "workload3", requestTokens: 5, priority: 50, requestRate: 100},
+	}
+	codelParams := &CoDelParams{Target: 10 * time.Millisecond, Interval: 100 * time.Millisecond}
+	baseOfBasicBucketTestWithCoDel(t, flows, calculateFillRate(flows, 0.5), 1, codelParams)
+}
+
+func TestAdaptiveLIFOMixRpsFlows(t *testing.T) {
+	flows := flowTrackers{
+		{fairnessLabel: "workload0", requestTokens: 10, priority: 5, requestRate: 100},
+		{fairnessLabel: "workload1", requestTokens: 15, priority: 4, requestRate: 200},
+		{fairnessLabel: "workload2", requestTokens: 15, priority: 3, requestRate: 80},
+		{fairnessLabel: "workload3", requestTokens: 10, priority: 2, requestRate: 120},
+	}
+	codelParams := &CoDelParams{Target: 10 * time.Millisecond, Interval: 100 * time.Millisecond, AdaptiveLIFO: true}
+	baseOfBasicBucketTestWithCoDel(t, flows, calculateFillRate(flows, 0.5), 1, codelParams)
+}
+
+func TestCoDel(t *testing.T) {
+	now := time.Now()
+	target := 10 * time.Millisecond
+	interval := 100 * time.Millisecond
+	codel := newCoDel(now, CoDelParams{Target: target, Interval: interval, AdaptiveLIFO: true})
+
+	// short delays never lead to drops
+	for i := 0; i < 20; i++ {
+		now = now.Add(10 * time.Millisecond)
+		if codel.observe(now, 5*time.Millisecond) {
+			t.Fatalf("Request dropped while queueing delay is below target")
+		}
+	}
+	if codel.lifo() {
+		t.Errorf("Expected FIFO while queueing delay is below target")
+	}
+
+	// standing queue, delay above target for more than an interval
+	for i := 0; i < 20; i++ {
+		now = now.Add(10 * time.Millisecond)
+		codel.observe(now, 15*time.Millisecond)
+	}
+	if !codel.lifo() {
+		t.Errorf("Expected LIFO while queueing delay is above target")
+	}
+	if codel.observe(now, 15*time.Millisecond) {
+		t.Errorf("Request below twice the target dropped")
+	}
+	if !codel.observe(now, 25*time.Millisecond) {
+		t.Errorf("Request above twice the target not dropped")
+	}
+
+	// queue drained
+	codel.reset(now)
+	if codel.lifo() || codel.observe(now, 25*time.Millisecond) {
+		t.Errorf("Expected no drops and FIFO after queue drained")
+	}
+}
+
 func TestLoadShedBucket(t *testing.T) {
 	runtime.GOMAXPROCS(runtime.NumCPU())
 	var wg sync.WaitGroup
@@ -543,7 +625,7 @@ func TestLoadShedBucket(t *testing.T) {
 	go updateClock(t, c, timeout, flows)
 
 	loadShedBucket := NewTokenBucketLoadShed(c.Now(), getMetrics())
-	sched := NewWFQScheduler(timeout, loadShedBucket, c, schedMetrics)
+	sched := NewWFQScheduler(timeout, loadShedBucket, c, schedMetrics, nil)
 
 	trainAndDeplete := func() {
 		// Running Train and deplete the bucket
@@ -587,6 +669,98 @@ func TestLoadShedBucket(t *testing.T) {
 	runExperiment()
 }
 
+func TestWorkloadQueueLimits(t *testing.T) {
+	c := clockwork.NewFakeClock()
+	// zero fill rate, so that every request has to be queued
+	basicBucket := NewBasicTokenBucket(c.Now(), 0, nil)
+	sched := NewWFQScheduler(time.Second, basicBucket, c, nil, nil).(*WFQScheduler)
+
+	rContext := RequestContext{
+		FairnessLabel:     "workload:0",
+		WorkloadKey:       "0",
+		Tokens:            5,
+		Timeout:           time.Second,
+		MaxQueuedRequests: 2,
+		MaxQueuedTokens:   12,
+	}
+
+	first, queueFull := sched.enter(rContext)
+	if first == nil || queueFull {
+		t.Fatalf("First request was not queued")
+	}
+	second, queueFull := sched.enter(rContext)
+	if second == nil || queueFull {
+		t.Fatalf("Second request was not queued")
+	}
+	if sched.GetQueuedRequests("0") != 2 {
+		t.Errorf("Expected 2 queued requests, got %d", sched.GetQueuedRequests("0"))
+	}
+
+	accepted, reason := sched.Schedule(rContext)
+	if accepted || reason != RejectReasonQueueFull {
+		t.Errorf("Expected request to be rejected due to full queue, got accepted: %v, reason: %v", accepted, reason)
+	}
+
+	// other workloads have their own queue
+	otherContext := rContext
+	otherContext.FairnessLabel = "workload:1"
+	otherContext.WorkloadKey = "1"
+	other, queueFull := sched.enter(otherContext)
+	if other == nil || queueFull {
+		t.Errorf("Request of other workload was not queued")
+	}
+
+	// 10 tokens are queued, 3 more exceed the token limit
+	tokensContext := rContext
+	tokensContext.MaxQueuedRequests = 0
+	tokensContext.Tokens = 3
+	_, queueFull = sched.enter(tokensContext)
+	if !queueFull {
+		t.Errorf("Expected request to be rejected due to token limit")
+	}
+
+	// a request leaving the queue makes room for the next one
+	if sched.leave(rContext, first, false) {
+		t.Errorf("Expected canceled request to be rejected")
+	}
+	if sched.GetQueuedRequests("0") != 1 {
+		t.Errorf("Expected 1 queued request, got %d", sched.GetQueuedRequests("0"))
+	}
+	_, queueFull = sched.enter(rContext)
+	if queueFull {
+		t.Errorf("Expected request to be queued after a request left the queue")
+	}
+}
+
+func TestNoWait(t *testing.T) {
+	c := clockwork.NewFakeClock()
+	basicBucket := NewBasicTokenBucket(c.Now(), 1000, nil)
+	sched := NewWFQScheduler(time.Second, basicBucket, c, nil, nil).(*WFQScheduler)
+
+	rContext := RequestContext{
+		FairnessLabel: "workload:0",
+		WorkloadKey:   "0",
+		Tokens:        5,
+		Timeout:       time.Second,
+		NoWait:        true,
+	}
+
+	// bucket is empty, a request that would have to wait is rejected right away instead of being queued
+	accepted, reason := sched.Schedule(rContext)
+	if accepted || reason != RejectReasonTimeout {
+		t.Errorf("Expected request to be rejected without waiting, got accepted: %v, reason: %v", accepted, reason)
+	}
+	if sched.GetQueuedRequests("0") != 0 || sched.queueOpen {
+		t.Errorf("Expected no queued requests, got %d", sched.GetQueuedRequests("0"))
+	}
+
+	c.Advance(time.Second)
+	accepted, _ = sched.Schedule(rContext)
+	if !accepted {
+		t.Errorf("Expected request to be accepted once tokens are available")
+	}
+}
+
 func TestPanic(t *testing.T) {
 	// No need to check whether `recover()` is nil. Just turn off the panic.
 	defer func() { _ = recover() }()
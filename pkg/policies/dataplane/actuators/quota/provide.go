@@ -0,0 +1,10 @@
+package quota
+
+import "go.uber.org/fx"
+
+// Module returns the fx options for dataplane side pieces of quota limiter.
+func Module() fx.Option {
+	return fx.Options(
+		quotaLimiterModule(),
+	)
+}
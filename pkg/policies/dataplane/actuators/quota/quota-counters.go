@@ -0,0 +1,191 @@
+package quota
+
+import (
+	"sync"
+	"time"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+)
+
+// windowBounds returns the calendar window containing now, aligned in the given location.
+func windowBounds(now time.Time, location *time.Location, window policylangv1.QuotaLimiter_Window) (start, end time.Time) {
+	t := now.In(location)
+	switch window {
+	case policylangv1.QuotaLimiter_WINDOW_MONTH:
+		start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, location)
+		end = start.AddDate(0, 1, 0)
+	default:
+		start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+		end = start.AddDate(0, 0, 1)
+	}
+	return start, end
+}
+
+// windowID identifies the window starting at start in etcd keys.
+func windowID(start time.Time) string {
+	return start.Format("2006-01-02")
+}
+
+// labelCounts maps label to the number of flows admitted within a window.
+type labelCounts map[string]int64
+
+// remoteCount is the persisted counter of a label of another agent.
+type remoteCount struct {
+	agentID string
+	label   string
+	count   int64
+}
+
+// quotaCounters tracks quota usage of the current window.
+// Flows admitted by this agent are counted locally and persisted periodically,
+// usage of other agents is learned from their persisted counters.
+type quotaCounters struct {
+	lock        sync.Mutex
+	windowStart time.Time
+	windowEnd   time.Time
+	location    *time.Location
+	local       labelCounts            // admitted by this agent
+	dirty       map[string]struct{}    // labels of local counters changed since they were last persisted
+	remote      labelCounts            // admitted by other agents, as of the last sync
+	remoteAgent map[string]labelCounts // counters of remote, by agent ID
+	revision    int64                  // etcd revision of the last sync of remote counters, 0 if not synced yet
+	window      policylangv1.QuotaLimiter_Window
+	limit       int64 // negative means no limit
+}
+
+func newQuotaCounters(now time.Time, location *time.Location, window policylangv1.QuotaLimiter_Window) *quotaCounters {
+	qc := &quotaCounters{
+		location: location,
+		window:   window,
+		limit:    -1,
+	}
+	qc.rollWindow(now)
+	return qc
+}
+
+// rollWindow resets the counters if now is past the current window, parent function must hold the lock.
+func (qc *quotaCounters) rollWindow(now time.Time) {
+	if qc.local != nil && now.Before(qc.windowEnd) {
+		return
+	}
+	qc.windowStart, qc.windowEnd = windowBounds(now, qc.location, qc.window)
+	qc.local = make(labelCounts)
+	qc.dirty = make(map[string]struct{})
+	qc.remote = make(labelCounts)
+	qc.remoteAgent = make(map[string]labelCounts)
+	qc.revision = 0
+}
+
+// setLimit sets the number of flows allowed per window per label, negative disables the limit.
+func (qc *quotaCounters) setLimit(limit int64) {
+	qc.lock.Lock()
+	defer qc.lock.Unlock()
+	qc.limit = limit
+}
+
+// takeN takes n flows from the quota of the label, negative n returns them.
+// Returns whether the flows were admitted, remaining quota (-1 if there's no limit), current usage and end of the window.
+func (qc *quotaCounters) takeN(now time.Time, label string, n int64) (bool, int64, int64, time.Time) {
+	qc.lock.Lock()
+	defer qc.lock.Unlock()
+	qc.rollWindow(now)
+
+	current := qc.local[label] + qc.remote[label]
+	if qc.limit >= 0 && n > 0 && current+n > qc.limit {
+		remaining := qc.limit - current
+		if remaining < 0 {
+			remaining = 0
+		}
+		return false, remaining, current, qc.windowEnd
+	}
+
+	local := qc.local[label] + n
+	if local < 0 {
+		local = 0
+	}
+	current += local - qc.local[label]
+	if local != qc.local[label] {
+		qc.local[label] = local
+		qc.dirty[label] = struct{}{}
+	}
+
+	if qc.limit < 0 {
+		return true, -1, current, qc.windowEnd
+	}
+	remaining := qc.limit - current
+	if remaining < 0 {
+		remaining = 0
+	}
+	return true, remaining, current, qc.windowEnd
+}
+
+// currentWindow returns the bounds of the current window and the etcd revision of the last sync within it.
+func (qc *quotaCounters) currentWindow(now time.Time) (time.Time, time.Time, int64) {
+	qc.lock.Lock()
+	defer qc.lock.Unlock()
+	qc.rollWindow(now)
+	return qc.windowStart, qc.windowEnd, qc.revision
+}
+
+// loadOwn adds the persisted counters of this agent (e.g. from before a restart) to the local ones.
+// Returns false if the window has changed meanwhile.
+func (qc *quotaCounters) loadOwn(windowStart time.Time, own labelCounts) bool {
+	qc.lock.Lock()
+	defer qc.lock.Unlock()
+	if !qc.windowStart.Equal(windowStart) {
+		return false
+	}
+	for label, count := range own {
+		qc.local[label] += count
+	}
+	return true
+}
+
+// applyRemote updates the counters of other agents changed since the last sync, as of the given etcd revision.
+// Returns false if the window has changed meanwhile.
+func (qc *quotaCounters) applyRemote(windowStart time.Time, revision int64, changed []remoteCount) bool {
+	qc.lock.Lock()
+	defer qc.lock.Unlock()
+	if !qc.windowStart.Equal(windowStart) {
+		return false
+	}
+	for _, rc := range changed {
+		counts, ok := qc.remoteAgent[rc.agentID]
+		if !ok {
+			counts = make(labelCounts)
+			qc.remoteAgent[rc.agentID] = counts
+		}
+		qc.remote[rc.label] += rc.count - counts[rc.label]
+		counts[rc.label] = rc.count
+	}
+	qc.revision = revision
+	return true
+}
+
+// takeDirty returns the local counters changed since they were last persisted and marks them as persisted.
+// Returns false if the window has changed meanwhile.
+func (qc *quotaCounters) takeDirty(windowStart time.Time) (labelCounts, bool) {
+	qc.lock.Lock()
+	defer qc.lock.Unlock()
+	if !qc.windowStart.Equal(windowStart) {
+		return nil, false
+	}
+	dirty := make(labelCounts, len(qc.dirty))
+	for label := range qc.dirty {
+		dirty[label] = qc.local[label]
+	}
+	qc.dirty = make(map[string]struct{})
+	return dirty, true
+}
+
+// markDirty marks the local counters of the labels to be persisted again, e.g. after a failed sync.
+func (qc *quotaCounters) markDirty(windowStart time.Time, counts labelCounts) {
+	qc.lock.Lock()
+	defer qc.lock.Unlock()
+	if !qc.windowStart.Equal(windowStart) {
+		return
+	}
+	for label := range counts {
+		qc.dirty[label] = struct{}{}
+	}
+}
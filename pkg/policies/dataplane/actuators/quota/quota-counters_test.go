@@ -0,0 +1,172 @@
+package quota
+
+import (
+	"testing"
+	"time"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s not available: %v", name, err)
+	}
+	return location
+}
+
+func TestWindowBounds(t *testing.T) {
+	location := mustLoadLocation(t, "America/New_York")
+	// 2022-03-01 02:00 UTC is still 2022-02-28 in New York
+	now := time.Date(2022, 3, 1, 2, 0, 0, 0, time.UTC)
+
+	start, end := windowBounds(now, location, policylangv1.QuotaLimiter_WINDOW_DAY)
+	if !start.Equal(time.Date(2022, 2, 28, 0, 0, 0, 0, location)) || !end.Equal(time.Date(2022, 3, 1, 0, 0, 0, 0, location)) {
+		t.Errorf("unexpected day window: %v - %v", start, end)
+	}
+	if windowID(start) != "2022-02-28" {
+		t.Errorf("unexpected window id: %s", windowID(start))
+	}
+
+	start, end = windowBounds(now, location, policylangv1.QuotaLimiter_WINDOW_MONTH)
+	if !start.Equal(time.Date(2022, 2, 1, 0, 0, 0, 0, location)) || !end.Equal(time.Date(2022, 3, 1, 0, 0, 0, 0, location)) {
+		t.Errorf("unexpected month window: %v - %v", start, end)
+	}
+
+	// daylight saving time starts on 2022-03-13, the day is 23 hours long
+	start, end = windowBounds(time.Date(2022, 3, 13, 12, 0, 0, 0, location), location, policylangv1.QuotaLimiter_WINDOW_DAY)
+	if end.Sub(start) != 23*time.Hour {
+		t.Errorf("unexpected day window length: %v", end.Sub(start))
+	}
+}
+
+func TestTakeN(t *testing.T) {
+	now := time.Date(2022, 5, 10, 12, 0, 0, 0, time.UTC)
+	qc := newQuotaCounters(now, time.UTC, policylangv1.QuotaLimiter_WINDOW_DAY)
+
+	ok, remaining, current, _ := qc.takeN(now, "user:a", 1)
+	if !ok || remaining != -1 || current != 1 {
+		t.Errorf("expected flow to be admitted without limit, got %v %d %d", ok, remaining, current)
+	}
+
+	qc.setLimit(3)
+	for i := 0; i < 2; i++ {
+		ok, _, _, _ = qc.takeN(now, "user:a", 1)
+		if !ok {
+			t.Fatalf("expected flow %d to be admitted", i)
+		}
+	}
+	ok, remaining, current, windowEnd := qc.takeN(now, "user:a", 1)
+	if ok || remaining != 0 || current != 3 {
+		t.Errorf("expected flow to be rejected, got %v %d %d", ok, remaining, current)
+	}
+	if !windowEnd.Equal(time.Date(2022, 5, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected window end: %v", windowEnd)
+	}
+
+	// other labels have their own quota
+	ok, remaining, _, _ = qc.takeN(now, "user:b", 1)
+	if !ok || remaining != 2 {
+		t.Errorf("expected flow of other label to be admitted, got %v %d", ok, remaining)
+	}
+
+	// returned flows free up the quota
+	qc.takeN(now, "user:a", -1)
+	ok, remaining, _, _ = qc.takeN(now, "user:a", 1)
+	if !ok || remaining != 0 {
+		t.Errorf("expected flow to be admitted after return, got %v %d", ok, remaining)
+	}
+
+	// quota is reset in the next window
+	ok, remaining, current, _ = qc.takeN(now.Add(12*time.Hour), "user:a", 1)
+	if !ok || remaining != 2 || current != 1 {
+		t.Errorf("expected flow to be admitted in the next window, got %v %d %d", ok, remaining, current)
+	}
+}
+
+func TestSync(t *testing.T) {
+	now := time.Date(2022, 5, 10, 12, 0, 0, 0, time.UTC)
+	qc := newQuotaCounters(now, time.UTC, policylangv1.QuotaLimiter_WINDOW_MONTH)
+	qc.setLimit(10)
+	windowStart, _, revision := qc.currentWindow(now)
+	if revision != 0 {
+		t.Errorf("expected no revision before the first sync, got %d", revision)
+	}
+
+	// counters persisted before a restart are loaded before admitting flows
+	if !qc.loadOwn(windowStart, labelCounts{"user:a": 3}) {
+		t.Fatal("expected persisted counters to be loaded")
+	}
+	qc.takeN(now, "user:a", 2)
+
+	changed := []remoteCount{
+		{agentID: "x", label: "user:a", count: 1},
+		{agentID: "y", label: "user:a", count: 2},
+		{agentID: "y", label: "user:b", count: 4},
+	}
+	if !qc.applyRemote(windowStart, 5, changed) {
+		t.Fatal("expected remote counters to be applied")
+	}
+	_, remaining, current, _ := qc.takeN(now, "user:b", 1)
+	if remaining != 5 || current != 5 {
+		t.Errorf("expected usage of other agents to be counted, got %d %d", remaining, current)
+	}
+	_, _, current, _ = qc.takeN(now, "user:a", 0)
+	if current != 8 {
+		t.Errorf("expected own and remote usage of 8, got %d", current)
+	}
+
+	// only changed counters of other agents are synced, replacing their previous values
+	if !qc.applyRemote(windowStart, 7, []remoteCount{{agentID: "y", label: "user:a", count: 4}}) {
+		t.Fatal("expected remote counters to be applied")
+	}
+	_, _, current, _ = qc.takeN(now, "user:a", 0)
+	if current != 10 {
+		t.Errorf("expected own and remote usage of 10, got %d", current)
+	}
+	if _, _, revision = qc.currentWindow(now); revision != 7 {
+		t.Errorf("expected revision of the last sync, got %d", revision)
+	}
+
+	// only changed local counters are persisted
+	dirty, ok := qc.takeDirty(windowStart)
+	if !ok || len(dirty) != 2 || dirty["user:a"] != 5 || dirty["user:b"] != 1 {
+		t.Errorf("expected changed counters to be persisted, got %v %v", ok, dirty)
+	}
+	if dirty, _ = qc.takeDirty(windowStart); len(dirty) != 0 {
+		t.Errorf("expected no counters to persist without changes, got %v", dirty)
+	}
+	qc.markDirty(windowStart, labelCounts{"user:b": 1})
+	if dirty, _ = qc.takeDirty(windowStart); len(dirty) != 1 || dirty["user:b"] != 1 {
+		t.Errorf("expected counters of a failed sync to be persisted again, got %v", dirty)
+	}
+
+	// counters of another window are ignored
+	otherWindow := windowStart.AddDate(0, -1, 0)
+	if qc.applyRemote(otherWindow, 9, changed) || qc.loadOwn(otherWindow, labelCounts{"user:a": 1}) {
+		t.Errorf("expected sync of another window to be ignored")
+	}
+	if _, ok = qc.takeDirty(otherWindow); ok {
+		t.Errorf("expected counters of another window not to be persisted")
+	}
+
+	// the next window starts from scratch
+	_, _, revision = qc.currentWindow(now.AddDate(0, 1, 0))
+	if revision != 0 {
+		t.Errorf("expected no revision in the next window, got %d", revision)
+	}
+}
+
+func TestParseCounterKV(t *testing.T) {
+	windowPath := "/quota_limiter_counters/group/policy/1/2022-05-01/"
+	rc, err := parseCounterKV(windowPath, []byte(windowPath+"agent/user:a%2Fb"), []byte("42"))
+	if err != nil || rc != (remoteCount{agentID: "agent", label: "user:a/b", count: 42}) {
+		t.Errorf("unexpected counter: %v %v", rc, err)
+	}
+	if _, err = parseCounterKV(windowPath, []byte(windowPath+"agent"), []byte("42")); err == nil {
+		t.Error("expected key without label to be rejected")
+	}
+	if _, err = parseCounterKV(windowPath, []byte(windowPath+"agent/user:a"), []byte("{}")); err == nil {
+		t.Error("expected non-numeric counter to be rejected")
+	}
+}
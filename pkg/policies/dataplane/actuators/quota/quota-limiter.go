@@ -0,0 +1,577 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/fx"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	selectorv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/common/selector/v1"
+	flowcontrolv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/flowcontrol/v1"
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	wrappersv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/wrappers/v1"
+	"github.com/fluxninja/aperture/pkg/agentinfo"
+	"github.com/fluxninja/aperture/pkg/config"
+	etcdclient "github.com/fluxninja/aperture/pkg/etcd/client"
+	etcdwatcher "github.com/fluxninja/aperture/pkg/etcd/watcher"
+	"github.com/fluxninja/aperture/pkg/info"
+	"github.com/fluxninja/aperture/pkg/jobs"
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/notifiers"
+	"github.com/fluxninja/aperture/pkg/policies/common"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+	"github.com/fluxninja/aperture/pkg/status"
+)
+
+const quotaLimiterStatusRoot = "quota_limiters"
+
+// Persisted counters outlive their window by this long, so that late syncs do not lose them.
+const countersRetention = 24 * time.Hour
+
+// Maximum number of counters persisted in a single etcd transaction, etcd limits the operations per transaction to 128 by default.
+const maxPutsPerTxn = 128
+
+var fxNameTag = config.NameTag(quotaLimiterStatusRoot)
+
+func quotaLimiterModule() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			fx.Annotate(
+				provideWatchers,
+				fx.ResultTags(fxNameTag),
+			),
+		),
+		fx.Invoke(
+			fx.Annotate(
+				setupQuotaLimiterFactory,
+				fx.ParamTags(fxNameTag),
+			),
+		),
+	)
+}
+
+func provideWatchers(
+	etcdClient *etcdclient.Client,
+	ai *agentinfo.AgentInfo,
+) (notifiers.Watcher, error) {
+	agentGroupName := ai.GetAgentGroup()
+
+	etcdPath := path.Join(common.QuotaLimiterConfigPath, common.AgentGroupPrefix(agentGroupName))
+	watcher, err := etcdwatcher.NewWatcher(etcdClient, etcdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return watcher, nil
+}
+
+type quotaLimiterFactory struct {
+	engineAPI        iface.Engine
+	registry         status.Registry
+	etcdClient       *etcdclient.Client
+	syncJobGroup     *jobs.JobGroup
+	decisionsWatcher notifiers.Watcher
+	agentGroupName   string
+	agentID          string
+}
+
+// main fx app.
+func setupQuotaLimiterFactory(
+	watcher notifiers.Watcher,
+	lifecycle fx.Lifecycle,
+	e iface.Engine,
+	statusRegistry status.Registry,
+	prometheusRegistry *prometheus.Registry,
+	etcdClient *etcdclient.Client,
+	ai *agentinfo.AgentInfo,
+) error {
+	agentGroupName := ai.GetAgentGroup()
+	decisionsWatcher, err := etcdwatcher.NewWatcher(etcdClient, common.QuotaLimiterDecisionsPath)
+	if err != nil {
+		return err
+	}
+
+	reg := statusRegistry.Child(quotaLimiterStatusRoot)
+	logger := reg.GetLogger()
+
+	syncJobGroup, err := jobs.NewJobGroup(reg.Child("sync_jobs"), 0, jobs.RescheduleMode, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to create sync job group")
+		return err
+	}
+
+	quotaLimiterFactory := &quotaLimiterFactory{
+		engineAPI:        e,
+		etcdClient:       etcdClient,
+		syncJobGroup:     syncJobGroup,
+		decisionsWatcher: decisionsWatcher,
+		agentGroupName:   agentGroupName,
+		// Counters of a restarted agent are picked up again if the hostname is stable
+		agentID:  info.Hostname,
+		registry: reg,
+	}
+
+	fxDriver := &notifiers.FxDriver{
+		FxOptionsFuncs: []notifiers.FxOptionsFunc{
+			quotaLimiterFactory.newQuotaLimiterOptions,
+		},
+		UnmarshalPrefixNotifier: notifiers.UnmarshalPrefixNotifier{
+			GetUnmarshallerFunc: config.NewProtobufUnmarshaller,
+		},
+		StatusRegistry:     reg,
+		PrometheusRegistry: prometheusRegistry,
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			err := syncJobGroup.Start()
+			if err != nil {
+				return err
+			}
+			err = decisionsWatcher.Start()
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			var err, merr error
+			err = decisionsWatcher.Stop()
+			if err != nil {
+				merr = multierr.Append(merr, err)
+			}
+			err = syncJobGroup.Stop()
+			if err != nil {
+				merr = multierr.Append(merr, err)
+			}
+			reg.Detach()
+			return merr
+		},
+	})
+
+	notifiers.WatcherLifecycle(lifecycle, watcher, []notifiers.PrefixNotifier{fxDriver})
+
+	return nil
+}
+
+// per policy component.
+func (quotaLimiterFactory *quotaLimiterFactory) newQuotaLimiterOptions(
+	key notifiers.Key,
+	unmarshaller config.Unmarshaller,
+	reg status.Registry,
+) (fx.Option, error) {
+	logger := quotaLimiterFactory.registry.GetLogger()
+	wrapperMessage := &wrappersv1.QuotaLimiterWrapper{}
+	err := unmarshaller.Unmarshal(wrapperMessage)
+	if err != nil || wrapperMessage.QuotaLimiter == nil {
+		reg.SetStatus(status.NewStatus(nil, err))
+		logger.Warn().Err(err).Msg("Failed to unmarshal quota limiter config")
+		return fx.Options(), err
+	}
+
+	quotaLimiterProto := wrapperMessage.QuotaLimiter
+	location, err := time.LoadLocation(quotaLimiterProto.GetTimeZone())
+	if err != nil {
+		reg.SetStatus(status.NewStatus(nil, err))
+		logger.Warn().Err(err).Msg("Failed to load time zone of quota limiter")
+		return fx.Options(), err
+	}
+
+	quotaLimiter := &quotaLimiter{
+		Component:           wrapperMessage.GetCommonAttributes(),
+		quotaLimiterProto:   quotaLimiterProto,
+		quotaLimiterFactory: quotaLimiterFactory,
+		registry:            reg,
+		counters:            newQuotaCounters(time.Now(), location, quotaLimiterProto.GetWindow()),
+	}
+	quotaLimiter.name = iface.ComponentID(quotaLimiter)
+	quotaLimiter.countersEtcdPath = path.Join(common.QuotaLimiterCountersPath,
+		common.DataplaneComponentKey(quotaLimiterFactory.agentGroupName, quotaLimiter.GetPolicyName(), quotaLimiter.GetComponentIndex()))
+
+	return fx.Options(
+		fx.Invoke(
+			quotaLimiter.setup,
+		),
+	), nil
+}
+
+// quotaLimiter implements quota limiter on the data plane side.
+type quotaLimiter struct {
+	iface.Component
+	registry            status.Registry
+	quotaLimiterFactory *quotaLimiterFactory
+	quotaLimiterProto   *policylangv1.QuotaLimiter
+	counters            *quotaCounters
+	name                string
+	countersEtcdPath    string
+	// etcd lease keeping the persisted counters of the current window
+	leaseLock        sync.Mutex
+	leaseWindowStart time.Time
+	leaseID          clientv3.LeaseID
+}
+
+// Make sure quotaLimiter implements iface.QuotaLimiter.
+var _ iface.QuotaLimiter = (*quotaLimiter)(nil)
+
+func (quotaLimiter *quotaLimiter) setup(lifecycle fx.Lifecycle) error {
+	logger := quotaLimiter.registry.GetLogger()
+	etcdKey := common.DataplaneComponentKey(quotaLimiter.quotaLimiterFactory.agentGroupName, quotaLimiter.GetPolicyName(), quotaLimiter.GetComponentIndex())
+	// decision notifier
+	decisionUnmarshaller, err := config.NewProtobufUnmarshaller(nil)
+	if err != nil {
+		return err
+	}
+	decisionNotifier := notifiers.NewUnmarshalKeyNotifier(
+		notifiers.Key(etcdKey),
+		decisionUnmarshaller,
+		quotaLimiter.decisionUpdateCallback,
+	)
+
+	syncJob := &jobs.BasicJob{
+		JobFunc: quotaLimiter.sync,
+	}
+	syncJob.JobName = quotaLimiter.name
+	syncInterval := quotaLimiter.quotaLimiterProto.GetSyncInterval().AsDuration()
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			// a restarted agent counts the flows it admitted within the window before admitting more
+			err := quotaLimiter.loadOwnCounters(ctx)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to load persisted quota counters")
+				return err
+			}
+			err = quotaLimiter.quotaLimiterFactory.syncJobGroup.RegisterJob(syncJob, jobs.JobConfig{
+				ExecutionPeriod:  config.MakeDuration(syncInterval),
+				ExecutionTimeout: config.MakeDuration(syncInterval),
+				InitialDelay:     config.MakeDuration(-1),
+			})
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to register sync job")
+				return err
+			}
+			// add decisions notifier
+			err = quotaLimiter.quotaLimiterFactory.decisionsWatcher.AddKeyNotifier(decisionNotifier)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to add decision notifier")
+				return err
+			}
+			// add to data engine
+			err = quotaLimiter.quotaLimiterFactory.engineAPI.RegisterRateLimiter(quotaLimiter)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to register quota limiter")
+				return err
+			}
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			var merr, err error
+			// remove from data engine
+			err = quotaLimiter.quotaLimiterFactory.engineAPI.UnregisterRateLimiter(quotaLimiter)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to unregister quota limiter")
+				merr = multierr.Append(merr, err)
+			}
+			// remove decisions notifier
+			err = quotaLimiter.quotaLimiterFactory.decisionsWatcher.RemoveKeyNotifier(decisionNotifier)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to remove decision notifier")
+				merr = multierr.Append(merr, err)
+			}
+			err = quotaLimiter.quotaLimiterFactory.syncJobGroup.DeregisterJob(quotaLimiter.name)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to deregister sync job")
+				merr = multierr.Append(merr, err)
+			}
+			// persist the counters admitted since the last sync
+			_, err = quotaLimiter.sync(ctx)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to persist quota counters")
+				merr = multierr.Append(merr, err)
+			}
+			quotaLimiter.registry.SetStatus(status.NewStatus(nil, merr))
+
+			return merr
+		},
+	})
+	return nil
+}
+
+// GetSelector returns the selector for the quota limiter.
+func (quotaLimiter *quotaLimiter) GetSelector() *selectorv1.Selector {
+	return quotaLimiter.quotaLimiterProto.GetSelector()
+}
+
+// RunLimiter runs the limiter.
+func (quotaLimiter *quotaLimiter) RunLimiter(labels map[string]string) *flowcontrolv1.LimiterDecision {
+	reason := flowcontrolv1.LimiterDecision_LIMITER_REASON_UNSPECIFIED
+
+	label, ok, remaining, current, windowEnd := quotaLimiter.takeN(labels, 1)
+
+	if label == "" {
+		reason = flowcontrolv1.LimiterDecision_LIMITER_REASON_KEY_NOT_FOUND
+	}
+
+	// In shadow mode, the flow is never dropped but the would-be drop is recorded
+	shadowMode := quotaLimiter.quotaLimiterProto.GetShadowMode()
+
+	quotaLimiterInfo := &flowcontrolv1.LimiterDecision_QuotaLimiterInfo{
+		Label:     label,
+		Remaining: remaining,
+		Current:   current,
+	}
+	if label != "" {
+		quotaLimiterInfo.WindowEnd = timestamppb.New(windowEnd)
+	}
+
+	return &flowcontrolv1.LimiterDecision{
+		PolicyName:       quotaLimiter.GetPolicyName(),
+		PolicyHash:       quotaLimiter.GetPolicyHash(),
+		ComponentIndex:   quotaLimiter.GetComponentIndex(),
+		Dropped:          !ok && !shadowMode,
+		WouldHaveDropped: !ok && shadowMode,
+		Reason:           reason,
+		Details: &flowcontrolv1.LimiterDecision_QuotaLimiterInfo_{
+			QuotaLimiterInfo: quotaLimiterInfo,
+		},
+	}
+}
+
+// TakeN takes n flows from the quota, negative n returns them.
+func (quotaLimiter *quotaLimiter) TakeN(labels map[string]string, n int) (label string, ok bool, remaining int, current int) {
+	label, ok, remaining64, current64, _ := quotaLimiter.takeN(labels, int64(n))
+	return label, ok, int(remaining64), int(current64)
+}
+
+func (quotaLimiter *quotaLimiter) takeN(labels map[string]string, n int64) (label string, ok bool, remaining int64, current int64, windowEnd time.Time) {
+	labelKey := quotaLimiter.quotaLimiterProto.GetLabelKey()
+	labelValue, found := labels[labelKey]
+	if !found {
+		return "", true, -1, -1, time.Time{}
+	}
+
+	label = labelKey + ":" + labelValue
+	ok, remaining, current, windowEnd = quotaLimiter.counters.takeN(time.Now(), label, n)
+	return
+}
+
+// AnnotateResponse exposes the remaining quota as a flow label and a response header.
+func (quotaLimiter *quotaLimiter) AnnotateResponse(decision *flowcontrolv1.LimiterDecision, response *flowcontrolv1.CheckResponse) {
+	quotaLimiterInfo := decision.GetQuotaLimiterInfo()
+	// nothing to expose if the flow is not limited
+	if quotaLimiterInfo == nil || quotaLimiterInfo.GetLabel() == "" || quotaLimiterInfo.GetRemaining() < 0 {
+		return
+	}
+	remaining := strconv.FormatInt(quotaLimiterInfo.GetRemaining(), 10)
+	if flowLabelKey := quotaLimiter.quotaLimiterProto.GetRemainingFlowLabelKey(); flowLabelKey != "" {
+		if response.LimiterFlowLabels == nil {
+			response.LimiterFlowLabels = make(map[string]string)
+		}
+		response.LimiterFlowLabels[flowLabelKey] = remaining
+	}
+	if header := quotaLimiter.quotaLimiterProto.GetRemainingResponseHeader(); header != "" {
+		if response.ResponseHeaders == nil {
+			response.ResponseHeaders = make(map[string]string)
+		}
+		response.ResponseHeaders[header] = remaining
+	}
+}
+
+// sync reads the counters of other agents changed since the last sync and persists the counters of this agent changed meanwhile.
+//
+// Counters are stored in etcd under one key per agent and label, <window>/<agent ID>/<label>, holding the number of flows.
+func (quotaLimiter *quotaLimiter) sync(ctx context.Context) (proto.Message, error) {
+	etcdClient := quotaLimiter.quotaLimiterFactory.etcdClient
+	agentID := quotaLimiter.quotaLimiterFactory.agentID
+	windowStart, windowEnd, revision := quotaLimiter.counters.currentWindow(time.Now())
+	windowPath := quotaLimiter.windowPath(windowStart)
+
+	opts := []clientv3.OpOption{clientv3.WithPrefix()}
+	if revision > 0 {
+		opts = append(opts, clientv3.WithMinModRev(revision+1))
+	}
+	resp, err := etcdClient.KV.Get(clientv3.WithRequireLeader(ctx), windowPath, opts...)
+	if err != nil {
+		return nil, err
+	}
+	changed := make([]remoteCount, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		rc, err := parseCounterKV(windowPath, kv.Key, kv.Value)
+		if err != nil {
+			log.Warn().Err(err).Str("key", string(kv.Key)).Msg("Failed to parse quota counter")
+			continue
+		}
+		if rc.agentID == agentID {
+			continue
+		}
+		changed = append(changed, rc)
+	}
+	if !quotaLimiter.counters.applyRemote(windowStart, resp.Header.Revision, changed) {
+		// window rolled over meanwhile, the next sync picks up the new one
+		return nil, nil
+	}
+
+	dirty, ok := quotaLimiter.counters.takeDirty(windowStart)
+	if !ok || len(dirty) == 0 {
+		return nil, nil
+	}
+	err = quotaLimiter.putCounters(ctx, windowStart, windowEnd, dirty)
+	if err != nil {
+		// persist them at the next sync
+		quotaLimiter.counters.markDirty(windowStart, dirty)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// putCounters persists the given counters of this agent, in transactions of at most maxPutsPerTxn keys.
+func (quotaLimiter *quotaLimiter) putCounters(ctx context.Context, windowStart, windowEnd time.Time, counts labelCounts) error {
+	etcdClient := quotaLimiter.quotaLimiterFactory.etcdClient
+	leaseID, err := quotaLimiter.getLease(ctx, windowStart, windowEnd)
+	if err != nil {
+		return err
+	}
+	ownPath := path.Join(quotaLimiter.windowPath(windowStart), quotaLimiter.quotaLimiterFactory.agentID) + "/"
+	ops := make([]clientv3.Op, 0, maxPutsPerTxn)
+	flush := func() error {
+		if len(ops) == 0 {
+			return nil
+		}
+		_, err := etcdClient.KV.Txn(clientv3.WithRequireLeader(ctx)).Then(ops...).Commit()
+		ops = ops[:0]
+		return err
+	}
+	for label, count := range counts {
+		ops = append(ops, clientv3.OpPut(ownPath+url.PathEscape(label), strconv.FormatInt(count, 10), clientv3.WithLease(leaseID)))
+		if len(ops) == maxPutsPerTxn {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// loadOwnCounters adds the counters this agent persisted within the current window (e.g. before a restart) to the local ones.
+func (quotaLimiter *quotaLimiter) loadOwnCounters(ctx context.Context) error {
+	agentID := quotaLimiter.quotaLimiterFactory.agentID
+	windowStart, _, _ := quotaLimiter.counters.currentWindow(time.Now())
+	windowPath := quotaLimiter.windowPath(windowStart)
+
+	resp, err := quotaLimiter.quotaLimiterFactory.etcdClient.KV.Get(clientv3.WithRequireLeader(ctx),
+		path.Join(windowPath, agentID)+"/", clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	own := make(labelCounts, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		rc, err := parseCounterKV(windowPath, kv.Key, kv.Value)
+		if err != nil {
+			log.Warn().Err(err).Str("key", string(kv.Key)).Msg("Failed to parse quota counter")
+			continue
+		}
+		own[rc.label] = rc.count
+	}
+	// if the window rolled over meanwhile, there is nothing persisted in the new one
+	quotaLimiter.counters.loadOwn(windowStart, own)
+	return nil
+}
+
+// windowPath returns the etcd path of the counters of the window starting at windowStart.
+func (quotaLimiter *quotaLimiter) windowPath(windowStart time.Time) string {
+	return path.Join(quotaLimiter.countersEtcdPath, windowID(windowStart)) + "/"
+}
+
+// parseCounterKV parses a counter persisted under windowPath.
+func parseCounterKV(windowPath string, key, value []byte) (remoteCount, error) {
+	agentID, escapedLabel, found := strings.Cut(strings.TrimPrefix(string(key), windowPath), "/")
+	if !found {
+		return remoteCount{}, errors.New("missing label in key")
+	}
+	label, err := url.PathUnescape(escapedLabel)
+	if err != nil {
+		return remoteCount{}, err
+	}
+	count, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return remoteCount{}, err
+	}
+	return remoteCount{agentID: agentID, label: label, count: count}, nil
+}
+
+// getLease returns the etcd lease that keeps the persisted counters until the window is over.
+func (quotaLimiter *quotaLimiter) getLease(ctx context.Context, windowStart, windowEnd time.Time) (clientv3.LeaseID, error) {
+	quotaLimiter.leaseLock.Lock()
+	defer quotaLimiter.leaseLock.Unlock()
+	if quotaLimiter.leaseID != 0 && quotaLimiter.leaseWindowStart.Equal(windowStart) {
+		return quotaLimiter.leaseID, nil
+	}
+	ttl := time.Until(windowEnd) + countersRetention
+	lease, err := quotaLimiter.quotaLimiterFactory.etcdClient.Lease.Grant(ctx, int64(ttl.Seconds()))
+	if err != nil {
+		return 0, err
+	}
+	quotaLimiter.leaseID = lease.ID
+	quotaLimiter.leaseWindowStart = windowStart
+	return lease.ID, nil
+}
+
+func (quotaLimiter *quotaLimiter) decisionUpdateCallback(event notifiers.Event, unmarshaller config.Unmarshaller) {
+	logger := quotaLimiter.registry.GetLogger()
+	if event.Type == notifiers.Remove {
+		logger.Debug().Msg("Decision removed")
+		quotaLimiter.counters.setLimit(-1)
+		return
+	}
+
+	var wrapperMessage wrappersv1.QuotaLimiterDecisionWrapper
+	err := unmarshaller.Unmarshal(&wrapperMessage)
+	if err != nil || wrapperMessage.QuotaLimiterDecision == nil {
+		return
+	}
+	commonAttributes := wrapperMessage.GetCommonAttributes()
+	if commonAttributes == nil {
+		log.Error().Msg("Common attributes not found")
+		return
+	}
+	if commonAttributes.PolicyHash != quotaLimiter.GetPolicyHash() {
+		return
+	}
+	quotaLimiter.counters.setLimit(int64(wrapperMessage.QuotaLimiterDecision.GetLimit()))
+}
+
+// GetLimiterID returns the limiter ID.
+func (quotaLimiter *quotaLimiter) GetLimiterID() iface.LimiterID {
+	return iface.LimiterID{
+		PolicyName:     quotaLimiter.GetPolicyName(),
+		PolicyHash:     quotaLimiter.GetPolicyHash(),
+		ComponentIndex: quotaLimiter.GetComponentIndex(),
+	}
+}
+
+// GetObserver is there to satisfy Limiter interface.
+func (quotaLimiter *quotaLimiter) GetObserver(map[string]string) prometheus.Observer {
+	// Not implemented for quota limiter
+	return nil
+}
+
+// GetDeniedResponse returns the response for the clients of rejected flows.
+func (quotaLimiter *quotaLimiter) GetDeniedResponse(*flowcontrolv1.LimiterDecision) *flowcontrolv1.DeniedResponse {
+	// Clients may retry once the window is over
+	_, windowEnd, _ := quotaLimiter.counters.currentWindow(time.Now())
+	return common.NewDeniedResponse(quotaLimiter.quotaLimiterProto.GetRejectionResponse(),
+		http.StatusTooManyRequests,
+		time.Until(windowEnd))
+}
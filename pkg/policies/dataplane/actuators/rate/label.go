@@ -0,0 +1,134 @@
+package rate
+
+import (
+	"net/url"
+	"strings"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/actuators/rate/ratetracker"
+)
+
+const (
+	// wildcardValue in overrides matches any value of a label.
+	wildcardValue = "*"
+	// compositeLabelSeparator separates the key:value pairs of a composite label.
+	compositeLabelSeparator = ","
+)
+
+// getLabelKeys returns the keys of the flow labels the rate limiter is keyed by.
+func getLabelKeys(rateLimiterProto *policylangv1.RateLimiter) []string {
+	if labelKeys := rateLimiterProto.GetLabelKeys(); len(labelKeys) > 0 {
+		return labelKeys
+	}
+	return []string{rateLimiterProto.GetLabelKey()}
+}
+
+// formatLabel builds the label the rate is tracked for from the values of labelKeys.
+//
+// A single key gives "key:value". Multiple keys give "key1:value1,key2:value2", with the values escaped,
+// so that the label can be split back into the values.
+func formatLabel(labelKeys, labelValues []string) string {
+	if len(labelKeys) == 1 {
+		return labelKeys[0] + ":" + labelValues[0]
+	}
+	var sb strings.Builder
+	for i, labelKey := range labelKeys {
+		if i > 0 {
+			sb.WriteString(compositeLabelSeparator)
+		}
+		sb.WriteString(labelKey)
+		sb.WriteString(":")
+		sb.WriteString(url.QueryEscape(labelValues[i]))
+	}
+	return sb.String()
+}
+
+// getLabel returns the label of the flow and the values it is made of, found is false if any of the labels is missing.
+func getLabel(labelKeys []string, labels map[string]string) (label string, labelValues map[string]string, found bool) {
+	values := make([]string, len(labelKeys))
+	for i, labelKey := range labelKeys {
+		value, ok := labels[labelKey]
+		if !ok {
+			return "", nil, false
+		}
+		values[i] = value
+	}
+	labelValues = make(map[string]string, len(labelKeys))
+	for i, labelKey := range labelKeys {
+		labelValues[labelKey] = values[i]
+	}
+	return formatLabel(labelKeys, values), labelValues, true
+}
+
+// wildcardOverride is an override with at least one wildcard value.
+type wildcardOverride struct {
+	// segments of the composite label, empty for wildcards
+	segments    []string
+	scaleFactor float64
+}
+
+// matches tells whether the override applies to label.
+func (wo wildcardOverride) matches(label string) bool {
+	if len(wo.segments) == 1 {
+		// single label key, the value is the wildcard
+		return true
+	}
+	segments := strings.Split(label, compositeLabelSeparator)
+	if len(segments) != len(wo.segments) {
+		return false
+	}
+	for i, segment := range wo.segments {
+		if segment != "" && segment != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// buildOverrides splits the overrides into exact ones and the matcher for the ones with wildcards.
+func buildOverrides(labelKeys []string, overrides []*policylangv1.RateLimiter_Override) (ratetracker.Overrides, ratetracker.OverrideMatcher) {
+	exactOverrides := ratetracker.Overrides{}
+	var wildcardOverrides []wildcardOverride
+	for _, override := range overrides {
+		labelValues := override.GetLabelValues()
+		if len(labelValues) == 0 {
+			labelValues = []string{override.GetLabelValue()}
+		}
+		if len(labelValues) != len(labelKeys) {
+			// override does not fit the label keys, so it can never match
+			continue
+		}
+		hasWildcard := false
+		for _, labelValue := range labelValues {
+			if labelValue == wildcardValue {
+				hasWildcard = true
+			}
+		}
+		if !hasWildcard {
+			exactOverrides[formatLabel(labelKeys, labelValues)] = override.GetLimitScaleFactor()
+			continue
+		}
+		segments := make([]string, len(labelKeys))
+		for i, labelValue := range labelValues {
+			// wildcards are only possible in composite labels or as the whole single label
+			if labelValue != wildcardValue {
+				segments[i] = labelKeys[i] + ":" + url.QueryEscape(labelValue)
+			}
+		}
+		wildcardOverrides = append(wildcardOverrides, wildcardOverride{
+			segments:    segments,
+			scaleFactor: override.GetLimitScaleFactor(),
+		})
+	}
+	if len(wildcardOverrides) == 0 {
+		return exactOverrides, nil
+	}
+	return exactOverrides, func(label string) (float64, bool) {
+		for _, wo := range wildcardOverrides {
+			if wo.matches(label) {
+				return wo.scaleFactor, true
+			}
+		}
+		return 0, false
+	}
+}
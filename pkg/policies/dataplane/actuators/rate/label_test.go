@@ -0,0 +1,68 @@
+package rate
+
+import (
+	"testing"
+
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+)
+
+func TestGetLabel(t *testing.T) {
+	labels := map[string]string{"tenant": "acme", "endpoint": "/a,b", "user": "bob"}
+
+	label, labelValues, found := getLabel([]string{"user"}, labels)
+	if !found || label != "user:bob" || labelValues["user"] != "bob" {
+		t.Errorf("unexpected single label: %v %q %v", found, label, labelValues)
+	}
+
+	label, labelValues, found = getLabel([]string{"tenant", "endpoint"}, labels)
+	if !found || label != "tenant:acme,endpoint:%2Fa%2Cb" {
+		t.Errorf("unexpected composite label: %v %q", found, label)
+	}
+	if labelValues["tenant"] != "acme" || labelValues["endpoint"] != "/a,b" {
+		t.Errorf("unexpected label values: %v", labelValues)
+	}
+
+	if _, _, found = getLabel([]string{"tenant", "missing"}, labels); found {
+		t.Errorf("expected label not to be found")
+	}
+}
+
+func TestBuildOverrides(t *testing.T) {
+	labelKeys := []string{"tenant", "endpoint"}
+	overrides, overrideMatcher := buildOverrides(labelKeys, []*policylangv1.RateLimiter_Override{
+		{LabelValues: []string{"acme", "/a,b"}, LimitScaleFactor: 2},
+		{LabelValues: []string{"acme", "*"}, LimitScaleFactor: 3},
+		{LabelValues: []string{"*", "/c"}, LimitScaleFactor: 4},
+		{LabelValue: "acme", LimitScaleFactor: 5},
+	})
+	if len(overrides) != 1 || overrides["tenant:acme,endpoint:%2Fa%2Cb"] != 2 {
+		t.Errorf("unexpected exact overrides: %v", overrides)
+	}
+	if overrideMatcher == nil {
+		t.Fatalf("expected override matcher")
+	}
+
+	for _, tc := range []struct {
+		labels      map[string]string
+		scaleFactor float64
+		matched     bool
+	}{
+		{labels: map[string]string{"tenant": "acme", "endpoint": "/d"}, scaleFactor: 3, matched: true},
+		{labels: map[string]string{"tenant": "acme", "endpoint": "/c"}, scaleFactor: 3, matched: true},
+		{labels: map[string]string{"tenant": "other", "endpoint": "/c"}, scaleFactor: 4, matched: true},
+		{labels: map[string]string{"tenant": "other", "endpoint": "/d"}, matched: false},
+	} {
+		label, _, _ := getLabel(labelKeys, tc.labels)
+		scaleFactor, matched := overrideMatcher(label)
+		if matched != tc.matched || scaleFactor != tc.scaleFactor {
+			t.Errorf("unexpected match of %q: %v %v", label, matched, scaleFactor)
+		}
+	}
+
+	overrides, overrideMatcher = buildOverrides([]string{"user"}, []*policylangv1.RateLimiter_Override{
+		{LabelValue: "bob", LimitScaleFactor: 2},
+	})
+	if overrides["user:bob"] != 2 || overrideMatcher != nil {
+		t.Errorf("unexpected single label overrides: %v", overrides)
+	}
+}
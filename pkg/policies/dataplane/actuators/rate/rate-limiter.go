@@ -2,10 +2,15 @@ package rate
 
 import (
 	"context"
+	"math"
+	"net/http"
 	"path"
+	"strconv"
 	"time"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
 	"go.uber.org/fx"
 	"go.uber.org/multierr"
 
@@ -186,8 +191,12 @@ func (rateLimiterFactory *rateLimiterFactory) newRateLimiterOptions(
 	rateLimiter := &rateLimiter{
 		Component:          wrapperMessage.GetCommonAttributes(),
 		rateLimiterProto:   rateLimiterProto,
+		labelKeys:          getLabelKeys(rateLimiterProto),
 		rateLimiterFactory: rateLimiterFactory,
 		registry:           reg,
+		deniedResponse: common.NewDeniedResponse(rateLimiterProto.GetRejectionResponse(),
+			http.StatusTooManyRequests,
+			rateLimiterProto.GetLimitResetInterval().AsDuration()),
 	}
 	rateLimiter.name = iface.ComponentID(rateLimiter)
 
@@ -205,7 +214,11 @@ type rateLimiter struct {
 	rateLimiterFactory *rateLimiterFactory
 	rateTracker        ratetracker.RateTracker
 	rateLimitChecker   *ratetracker.BasicRateLimitChecker
+	// set only in token bucket mode, shares the limit and overrides with rateLimitChecker
+	tokenBucketChecker *ratetracker.TokenBucketRateLimitChecker
 	rateLimiterProto   *policylangv1.RateLimiter
+	labelKeys          []string
+	deniedResponse     *flowcontrolv1.DeniedResponse
 	name               string
 }
 
@@ -239,13 +252,35 @@ func (rateLimiter *rateLimiter) setup(lifecycle fx.Lifecycle) error {
 	lifecycle.Append(fx.Hook{
 		OnStart: func(context.Context) error {
 			var err error
-			rateLimiter.rateLimitChecker = ratetracker.NewBasicRateLimitChecker()
-			rateLimiter.updateDynamicConfig(rateLimiter.rateLimiterProto.GetInitConfig())
-			rateLimiter.rateTracker, err = ratetracker.NewDistCacheRateTracker(
-				rateLimiter.rateLimitChecker,
-				rateLimiter.rateLimiterFactory.distCache,
-				rateLimiter.name,
-				rateLimiter.rateLimiterProto.GetLimitResetInterval().AsDuration())
+			limitResetInterval := rateLimiter.rateLimiterProto.GetLimitResetInterval().AsDuration()
+			if rateLimiter.rateLimiterProto.GetInPorts().GetFillRate() != nil {
+				// token bucket mode
+				rateLimiter.tokenBucketChecker = ratetracker.NewTokenBucketRateLimitChecker()
+				rateLimiter.rateLimitChecker = rateLimiter.tokenBucketChecker.BasicRateLimitChecker
+				rateLimiter.updateDynamicConfig(rateLimiter.rateLimiterProto.GetInitConfig())
+				if rateLimiter.rateLimiterProto.GetLocal() {
+					rateLimiter.rateTracker = ratetracker.NewLocalTokenBucketRateTracker(
+						rateLimiter.tokenBucketChecker,
+						rateLimiter.name,
+						limitResetInterval,
+						clockwork.NewRealClock())
+				} else {
+					rateLimiter.rateTracker, err = ratetracker.NewDistCacheTokenBucketRateTracker(
+						rateLimiter.tokenBucketChecker,
+						rateLimiter.rateLimiterFactory.distCache,
+						rateLimiter.name,
+						limitResetInterval,
+						clockwork.NewRealClock())
+				}
+			} else {
+				rateLimiter.rateLimitChecker = ratetracker.NewBasicRateLimitChecker()
+				rateLimiter.updateDynamicConfig(rateLimiter.rateLimiterProto.GetInitConfig())
+				rateLimiter.rateTracker, err = ratetracker.NewDistCacheRateTracker(
+					rateLimiter.rateLimitChecker,
+					rateLimiter.rateLimiterFactory.distCache,
+					rateLimiter.name,
+					limitResetInterval)
+			}
 			if err != nil {
 				logger.Error().Err(err).Msg("Failed to create limiter")
 				return err
@@ -253,7 +288,7 @@ func (rateLimiter *rateLimiter) setup(lifecycle fx.Lifecycle) error {
 			// check whether lazy limiter is enabled
 			if lazySyncConfig := rateLimiter.rateLimiterProto.GetLazySync(); lazySyncConfig != nil {
 				if lazySyncConfig.GetEnabled() {
-					lazySyncInterval := time.Duration(int64(rateLimiter.rateLimiterProto.GetLimitResetInterval().AsDuration()) / int64(lazySyncConfig.GetNumSync()))
+					lazySyncInterval := time.Duration(int64(limitResetInterval) / int64(lazySyncConfig.GetNumSync()))
 					rateLimiter.rateTracker, err = ratetracker.NewLazySyncRateTracker(rateLimiter.rateTracker,
 						lazySyncInterval,
 						rateLimiter.rateLimiterFactory.lazySyncJobGroup)
@@ -320,16 +355,12 @@ func (rateLimiter *rateLimiter) updateDynamicConfig(dynamicConfig *policylangv1.
 	if dynamicConfig == nil {
 		return
 	}
-	overrides := ratetracker.Overrides{}
-	// loop through overrides
-	for _, override := range dynamicConfig.GetOverrides() {
-		label := rateLimiter.rateLimiterProto.GetLabelKey() + ":" + override.GetLabelValue()
-		overrides[label] = override.GetLimitScaleFactor()
-	}
+	overrides, overrideMatcher := buildOverrides(rateLimiter.labelKeys, dynamicConfig.GetOverrides())
 
 	logger.Debug().Interface("overrides", overrides).Str("name", rateLimiter.name).Msgf("Updating dynamic config for rate limiter")
 
 	rateLimiter.rateLimitChecker.SetOverrides(overrides)
+	rateLimiter.rateLimitChecker.SetOverrideMatcher(overrideMatcher)
 }
 
 // GetSelector returns the selector for the rate limiter.
@@ -341,40 +372,65 @@ func (rateLimiter *rateLimiter) GetSelector() *selectorv1.Selector {
 func (rateLimiter *rateLimiter) RunLimiter(labels map[string]string) *flowcontrolv1.LimiterDecision {
 	reason := flowcontrolv1.LimiterDecision_LIMITER_REASON_UNSPECIFIED
 
-	label, ok, remaining, current := rateLimiter.TakeN(labels, 1)
-
-	if label == "" {
+	tokens := rateLimiter.getTokens(labels)
+	label, labelValues, found := getLabel(rateLimiter.labelKeys, labels)
+	ok, remaining, current := true, -1, -1
+	if found {
+		ok, remaining, current = rateLimiter.rateTracker.TakeN(label, tokens)
+	} else {
 		reason = flowcontrolv1.LimiterDecision_LIMITER_REASON_KEY_NOT_FOUND
 	}
 
+	// In shadow mode, the flow is never dropped but the would-be drop is recorded
+	shadowMode := rateLimiter.rateLimiterProto.GetShadowMode()
+
 	return &flowcontrolv1.LimiterDecision{
-		PolicyName:     rateLimiter.GetPolicyName(),
-		PolicyHash:     rateLimiter.GetPolicyHash(),
-		ComponentIndex: rateLimiter.GetComponentIndex(),
-		Dropped:        !ok,
-		Reason:         reason,
+		PolicyName:       rateLimiter.GetPolicyName(),
+		PolicyHash:       rateLimiter.GetPolicyHash(),
+		ComponentIndex:   rateLimiter.GetComponentIndex(),
+		Dropped:          !ok && !shadowMode,
+		WouldHaveDropped: !ok && shadowMode,
+		Reason:           reason,
 		Details: &flowcontrolv1.LimiterDecision_RateLimiterInfo_{
 			RateLimiterInfo: &flowcontrolv1.LimiterDecision_RateLimiterInfo{
-				Label:     label,
-				Remaining: int64(remaining),
-				Current:   int64(current),
+				Label:       label,
+				Remaining:   int64(remaining),
+				Current:     int64(current),
+				Tokens:      int64(tokens),
+				LabelValues: labelValues,
 			},
 		},
 	}
 }
 
+// getTokens returns the cost of the flow as read from the tokens label, 1 if it is not available.
+func (rateLimiter *rateLimiter) getTokens(labels map[string]string) int {
+	tokensLabelKey := rateLimiter.rateLimiterProto.GetTokensLabelKey()
+	if tokensLabelKey == "" {
+		return 1
+	}
+	val, found := labels[tokensLabelKey]
+	if !found {
+		return 1
+	}
+	tokens, err := strconv.ParseFloat(val, 64)
+	if err != nil || math.IsNaN(tokens) || tokens <= 0 {
+		log.Sample(zerolog.Sometimes).Debug().Str("label", tokensLabelKey).Str("value", val).Msg("Invalid tokens label value, using 1")
+		return 1
+	}
+	if tokens >= math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int(math.Ceil(tokens))
+}
+
 // TakeN takes n tokens from the limiter.
 func (rateLimiter *rateLimiter) TakeN(labels map[string]string, n int) (label string, ok bool, remaining int, current int) {
-	labelKey := rateLimiter.rateLimiterProto.GetLabelKey()
-	var labelValue string
-	if val, found := labels[labelKey]; found {
-		labelValue = val
-	} else {
+	label, _, found := getLabel(rateLimiter.labelKeys, labels)
+	if !found {
 		return "", true, -1, -1
 	}
 
-	label = labelKey + ":" + labelValue
-
 	ok, remaining, current = rateLimiter.rateTracker.TakeN(label, n)
 	return
 }
@@ -383,6 +439,10 @@ func (rateLimiter *rateLimiter) decisionUpdateCallback(event notifiers.Event, un
 	logger := rateLimiter.registry.GetLogger()
 	if event.Type == notifiers.Remove {
 		logger.Debug().Msg("Decision removed")
+		if rateLimiter.tokenBucketChecker != nil {
+			rateLimiter.tokenBucketChecker.SetCapacity(-1)
+			return
+		}
 		rateLimiter.rateLimitChecker.SetRateLimit(-1)
 		return
 	}
@@ -401,6 +461,11 @@ func (rateLimiter *rateLimiter) decisionUpdateCallback(event notifiers.Event, un
 		return
 	}
 	limitDecision := wrapperMessage.RateLimiterDecision
+	if rateLimiter.tokenBucketChecker != nil {
+		rateLimiter.tokenBucketChecker.SetFillRate(limitDecision.GetFillRate())
+		rateLimiter.tokenBucketChecker.SetCapacity(limitDecision.GetBucketCapacity())
+		return
+	}
 	rateLimiter.rateLimitChecker.SetRateLimit(int(limitDecision.GetLimit()))
 }
 
@@ -409,6 +474,7 @@ func (rateLimiter *rateLimiter) dynamicConfigUpdateCallback(event notifiers.Even
 	if event.Type == notifiers.Remove {
 		logger.Debug().Msg("Dynamic config removed")
 		rateLimiter.rateLimitChecker.SetOverrides(ratetracker.Overrides{})
+		rateLimiter.rateLimitChecker.SetOverrideMatcher(nil)
 		return
 	}
 
@@ -443,3 +509,26 @@ func (rateLimiter *rateLimiter) GetObserver(map[string]string) prometheus.Observ
 	// Not implemented for rate limiter
 	return nil
 }
+
+// GetDeniedResponse returns the response for the clients of rejected flows.
+func (rateLimiter *rateLimiter) GetDeniedResponse(decision *flowcontrolv1.LimiterDecision) *flowcontrolv1.DeniedResponse {
+	if rateLimiter.tokenBucketChecker != nil {
+		// limit_reset_interval is only the idle TTL of the buckets, clients may retry once the bucket is refilled
+		return common.NewDeniedResponse(rateLimiter.rateLimiterProto.GetRejectionResponse(),
+			http.StatusTooManyRequests,
+			rateLimiter.getRefillDuration(decision.GetRateLimiterInfo()))
+	}
+	return rateLimiter.deniedResponse
+}
+
+// getRefillDuration returns the time it takes to refill the tokens the rejected flow was missing in token bucket mode.
+// It returns 0 if the bucket is not being filled or the flow does not fit in it at all.
+func (rateLimiter *rateLimiter) getRefillDuration(info *flowcontrolv1.LimiterDecision_RateLimiterInfo) time.Duration {
+	fillRate := rateLimiter.tokenBucketChecker.GetLabelFillRate(info.GetLabel())
+	capacity := math.Max(rateLimiter.tokenBucketChecker.GetLabelCapacity(info.GetLabel()), 1)
+	deficit := float64(info.GetTokens() - info.GetRemaining())
+	if fillRate <= 0 || deficit <= 0 || float64(info.GetTokens()) > capacity {
+		return 0
+	}
+	return time.Duration(deficit / fillRate * float64(time.Second))
+}
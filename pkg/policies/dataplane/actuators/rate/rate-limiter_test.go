@@ -0,0 +1,51 @@
+package rate
+
+import (
+	"testing"
+
+	flowcontrolv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/flowcontrol/v1"
+	policylangv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/policies/common"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/actuators/rate/ratetracker"
+)
+
+func TestTokenBucketRetryAfter(t *testing.T) {
+	tokenBucketChecker := ratetracker.NewTokenBucketRateLimitChecker()
+	tokenBucketChecker.SetFillRate(2)
+	tokenBucketChecker.SetCapacity(10)
+	rateLimiter := &rateLimiter{
+		rateLimiterProto:   &policylangv1.RateLimiter{RejectionResponse: &policylangv1.RejectionResponse{}},
+		tokenBucketChecker: tokenBucketChecker,
+	}
+
+	retryAfter := func(tokens, remaining int64) string {
+		deniedResponse := rateLimiter.GetDeniedResponse(&flowcontrolv1.LimiterDecision{
+			Details: &flowcontrolv1.LimiterDecision_RateLimiterInfo_{
+				RateLimiterInfo: &flowcontrolv1.LimiterDecision_RateLimiterInfo{
+					Label:     "user:a",
+					Tokens:    tokens,
+					Remaining: remaining,
+				},
+			},
+		})
+		return deniedResponse.GetHeaders()[common.RetryAfterHeader]
+	}
+
+	// 5 missing tokens are refilled in 2.5s
+	if got := retryAfter(6, 1); got != "3" {
+		t.Errorf("Expected Retry-After of 3s, got %q", got)
+	}
+	if got := retryAfter(1, 0); got != "1" {
+		t.Errorf("Expected Retry-After of 1s, got %q", got)
+	}
+	// a flow larger than the bucket never fits
+	if got := retryAfter(20, 10); got != "" {
+		t.Errorf("Expected no Retry-After, got %q", got)
+	}
+
+	// without a rejection response the integrations use their defaults
+	rateLimiter.rateLimiterProto.RejectionResponse = nil
+	if deniedResponse := rateLimiter.GetDeniedResponse(&flowcontrolv1.LimiterDecision{}); deniedResponse != nil {
+		t.Errorf("Expected no denied response, got %v", deniedResponse)
+	}
+}
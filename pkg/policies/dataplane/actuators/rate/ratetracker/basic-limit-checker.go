@@ -10,11 +10,15 @@ var _ RateLimitChecker = &BasicRateLimitChecker{}
 // Overrides is a map of label to limit scale factor.
 type Overrides map[string]float64
 
+// OverrideMatcher returns the limit scale factor of a label not found in Overrides, e.g. by matching it against wildcards.
+type OverrideMatcher func(label string) (scaleFactor float64, ok bool)
+
 // BasicRateLimitChecker implements LimitCheck.
 type BasicRateLimitChecker struct {
-	lock      sync.RWMutex
-	overrides Overrides
-	limit     int
+	lock            sync.RWMutex
+	overrides       Overrides
+	overrideMatcher OverrideMatcher
+	limit           int
 }
 
 // NewBasicRateLimitChecker creates a new instance of BasicLimitCheck.
@@ -32,6 +36,24 @@ func (l *BasicRateLimitChecker) SetOverrides(overrides Overrides) {
 	l.overrides = overrides
 }
 
+// SetOverrideMatcher sets the matcher consulted for labels not found in the overrides, nil disables it.
+func (l *BasicRateLimitChecker) SetOverrideMatcher(overrideMatcher OverrideMatcher) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.overrideMatcher = overrideMatcher
+}
+
+// getScaleFactor returns the limit scale factor of a label, parent function must hold the lock.
+func (l *BasicRateLimitChecker) getScaleFactor(label string) (float64, bool) {
+	if scaleFactor, ok := l.overrides[label]; ok {
+		return scaleFactor, true
+	}
+	if l.overrideMatcher != nil {
+		return l.overrideMatcher(label)
+	}
+	return 0, false
+}
+
 // CheckRateLimit checks the limit for a specific label and the remaining limit. If limit is exceeded then we return false and 0 as remaining limit.
 func (l *BasicRateLimitChecker) CheckRateLimit(label string, count int) (bool, int) {
 	l.lock.RLock()
@@ -65,7 +87,7 @@ func (l *BasicRateLimitChecker) GetRateLimit() int {
 func (l *BasicRateLimitChecker) GetLabelRateLimit(label string) int {
 	l.lock.RLock()
 	defer l.lock.RUnlock()
-	if scaleFactor, ok := l.overrides[label]; ok {
+	if scaleFactor, ok := l.getScaleFactor(label); ok {
 		return int(float64(l.limit) * scaleFactor)
 	}
 	return l.limit
@@ -0,0 +1,143 @@
+package ratetracker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/buraksezer/olric"
+	"github.com/buraksezer/olric/config"
+	"github.com/jonboulle/clockwork"
+
+	"github.com/fluxninja/aperture/pkg/distcache"
+)
+
+const (
+	// Suffix of the keys locking the buckets, olric locks are stored alongside the values in the DMap.
+	bucketLockSuffix = "/lock"
+	// Time after which the lock of a bucket is released in case the holder goes away.
+	bucketLockTimeout = time.Second
+	// Time to wait for the lock of a bucket before failing open.
+	bucketLockDeadline = 100 * time.Millisecond
+)
+
+// DistCacheTokenBucketRateTracker implements RateTracker with token buckets kept in DistCache, shared by the agent group.
+type DistCacheTokenBucketRateTracker struct {
+	mu         sync.RWMutex
+	clock      clockwork.Clock
+	limitCheck *TokenBucketRateLimitChecker
+	dMap       *olric.DMap
+	name       string
+	ttl        time.Duration
+}
+
+// NewDistCacheTokenBucketRateTracker creates a new instance of DistCacheTokenBucketRateTracker. Buckets that are not used for ttl expire.
+func NewDistCacheTokenBucketRateTracker(limitCheck *TokenBucketRateLimitChecker, dc *distcache.DistCache, name string, ttl time.Duration, clock clockwork.Clock) (RateTracker, error) {
+	dmapConfig := config.DMap{
+		TTLDuration: ttl,
+	}
+
+	dc.Mutex.Lock()
+	defer dc.Mutex.Unlock()
+	dc.AddDMapCustomConfig(name, dmapConfig)
+	dMap, err := dc.Olric.NewDMap(name)
+	if err != nil {
+		return nil, err
+	}
+	dc.RemoveDMapCustomConfig(name)
+
+	tr := &DistCacheTokenBucketRateTracker{
+		clock:      clock,
+		limitCheck: limitCheck,
+		dMap:       dMap,
+		name:       name,
+		ttl:        ttl,
+	}
+
+	return tr, nil
+}
+
+// Name returns the name of the DistCacheTokenBucketRateTracker.
+func (tr *DistCacheTokenBucketRateTracker) Name() string {
+	return tr.name
+}
+
+// Close cleans up DMap held within the DistCacheTokenBucketRateTracker.
+func (tr *DistCacheTokenBucketRateTracker) Close() error {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	err := tr.dMap.Destroy()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Take is a wrapper for TakeN(label, 1).
+func (tr *DistCacheTokenBucketRateTracker) Take(label string) (bool, int, int) {
+	return tr.TakeN(label, 1)
+}
+
+// TakeN takes n tokens from the bucket of the label and returns whether n events should be allowed along with the remaining tokens and the consumed tokens of the bucket.
+// If an error occurred it returns true, 0 and 0 (fail open).
+func (tr *DistCacheTokenBucketRateTracker) TakeN(label string, n int) (bool, int, int) {
+	ok, remaining, current := true, 0, 0
+	if !tr.updateBucket(label, func(bucket *tokenBucket, now time.Time) {
+		ok, remaining, current = bucket.takeN(tr.limitCheck, label, n, now)
+	}) {
+		return true, 0, 0
+	}
+	return ok, remaining, current
+}
+
+// SyncN takes n tokens of already admitted requests from the bucket of the label and returns the consumed tokens of the bucket.
+// If an error occurred it returns 0.
+func (tr *DistCacheTokenBucketRateTracker) SyncN(label string, n int) int {
+	current := 0
+	if !tr.updateBucket(label, func(bucket *tokenBucket, now time.Time) {
+		current = bucket.syncN(tr.limitCheck, label, n, now)
+	}) {
+		return 0
+	}
+	return current
+}
+
+// updateBucket applies update to the bucket of the label while holding its lock and stores the bucket.
+// It returns false if an error occurred.
+func (tr *DistCacheTokenBucketRateTracker) updateBucket(label string, update func(bucket *tokenBucket, now time.Time)) bool {
+	tr.mu.RLock()
+	defer tr.mu.RUnlock()
+
+	lock, err := tr.dMap.LockWithTimeout(label+bucketLockSuffix, bucketLockTimeout, bucketLockDeadline)
+	if err != nil {
+		return false
+	}
+	defer func() {
+		_ = lock.Unlock()
+	}()
+
+	now := tr.clock.Now()
+	bucket := &tokenBucket{lastFill: now}
+	value, err := tr.dMap.Get(label)
+	if err == nil {
+		data, isBytes := value.([]byte)
+		if !isBytes || !bucket.unmarshal(data) {
+			bucket = &tokenBucket{lastFill: now}
+		}
+	} else if err != olric.ErrKeyNotFound {
+		return false
+	}
+
+	update(bucket, now)
+	return tr.dMap.PutEx(label, bucket.marshal(), tr.ttl) == nil
+}
+
+// GetRateLimitChecker returns the RateLimitChecker of the DistCacheTokenBucketRateTracker.
+func (tr *DistCacheTokenBucketRateTracker) GetRateLimitChecker() RateLimitChecker {
+	return tr.limitCheck
+}
+
+// Make sure DistCacheTokenBucketRateTracker implements RateTracker and AdmittedSyncer interfaces.
+var (
+	_ RateTracker    = (*DistCacheTokenBucketRateTracker)(nil)
+	_ AdmittedSyncer = (*DistCacheTokenBucketRateTracker)(nil)
+)
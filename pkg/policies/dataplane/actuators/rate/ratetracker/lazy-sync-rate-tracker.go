@@ -19,12 +19,14 @@ type counter struct {
 
 // LazySyncRateTracker is a limiter that syncs its state lazily with another limiter.
 type LazySyncRateTracker struct {
-	counters      sync.Map
-	limiter       RateTracker
-	jobGroup      *jobs.JobGroup
-	name          string
-	syncDuration  time.Duration
-	totalCounters int64
+	counters sync.Map
+	limiter  RateTracker
+	// set if the limiter does not take tokens for rejected requests
+	admittedSyncer AdmittedSyncer
+	jobGroup       *jobs.JobGroup
+	name           string
+	syncDuration   time.Duration
+	totalCounters  int64
 }
 
 // NewLazySyncRateTracker creates a new LazySyncLimiter.
@@ -38,6 +40,7 @@ func NewLazySyncRateTracker(limiter RateTracker,
 		name:         limiter.Name() + "-lazy-sync",
 		syncDuration: syncDuration,
 	}
+	lsl.admittedSyncer, _ = limiter.(AdmittedSyncer)
 
 	job := &jobs.BasicJob{
 		JobFunc: lsl.sync,
@@ -104,7 +107,12 @@ func (lsl *LazySyncRateTracker) sync(ctx context.Context) (proto.Message, error)
 			go func(i int64) {
 				dur := time.Duration(i * int64(requestDelay))
 				time.Sleep(dur)
-				_, _, global := lsl.limiter.TakeN(label.(string), int(local))
+				var global int
+				if lsl.admittedSyncer != nil {
+					global = lsl.admittedSyncer.SyncN(label.(string), int(local))
+				} else {
+					_, _, global = lsl.limiter.TakeN(label.(string), int(local))
+				}
 				atomic.StoreInt64(&c.global, int64(global))
 			}(i)
 			i++
@@ -127,6 +135,10 @@ func (lsl *LazySyncRateTracker) TakeN(label string, n int) (bool, int, int) {
 		total := int(local) + int(atomic.LoadInt64(&c.global))
 		// check limit
 		ok, remaining := lsl.limiter.GetRateLimitChecker().CheckRateLimit(label, total)
+		if !ok && n > 0 && lsl.admittedSyncer != nil {
+			// rejected requests do not take tokens
+			total = int(atomic.AddInt32(&c.local, -int32(n))) + int(atomic.LoadInt64(&c.global))
+		}
 		return ok, remaining, total
 	}
 
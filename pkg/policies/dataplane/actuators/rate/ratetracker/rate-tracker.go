@@ -9,6 +9,12 @@ type RateTracker interface {
 	Close() error
 }
 
+// AdmittedSyncer is implemented by rate trackers that do not take tokens for rejected requests.
+// LazySyncRateTracker syncs the tokens of the requests it admitted to them with SyncN, which takes the tokens even if they exceed the limit.
+type AdmittedSyncer interface {
+	SyncN(label string, count int) (current int)
+}
+
 // RateLimitChecker is a generic limit checker interface.
 type RateLimitChecker interface {
 	CheckRateLimit(label string, count int) (ok bool, remaining int)
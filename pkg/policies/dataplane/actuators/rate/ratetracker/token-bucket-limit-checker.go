@@ -0,0 +1,162 @@
+package ratetracker
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+)
+
+// Make sure TokenBucketRateLimitChecker implements RateLimitChecker.
+var _ RateLimitChecker = &TokenBucketRateLimitChecker{}
+
+// TokenBucketRateLimitChecker implements RateLimitChecker for token buckets. The rate limit is the capacity of the bucket and overrides scale both the capacity and the fill rate.
+// The capacity is kept as float64, the integer rate limit is the capacity rounded up.
+type TokenBucketRateLimitChecker struct {
+	*BasicRateLimitChecker
+	fillRate float64
+	capacity float64
+}
+
+// NewTokenBucketRateLimitChecker creates a new instance of TokenBucketRateLimitChecker.
+func NewTokenBucketRateLimitChecker() *TokenBucketRateLimitChecker {
+	return &TokenBucketRateLimitChecker{
+		BasicRateLimitChecker: NewBasicRateLimitChecker(),
+		fillRate:              -1,
+		capacity:              -1,
+	}
+}
+
+// SetRateLimit sets the capacity of each bucket.
+func (l *TokenBucketRateLimitChecker) SetRateLimit(limit int) {
+	l.SetCapacity(float64(limit))
+}
+
+// SetCapacity sets the capacity of each bucket, negative capacity means that there is no limit.
+func (l *TokenBucketRateLimitChecker) SetCapacity(capacity float64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if capacity < 0 {
+		l.capacity = -1
+		l.limit = -1
+		return
+	}
+	l.capacity = capacity
+	l.limit = int(math.Ceil(capacity))
+}
+
+// GetCapacity returns the capacity of each bucket.
+func (l *TokenBucketRateLimitChecker) GetCapacity() float64 {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.capacity
+}
+
+// GetLabelCapacity returns the capacity of the bucket of a specific label.
+func (l *TokenBucketRateLimitChecker) GetLabelCapacity(label string) float64 {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	if scaleFactor, ok := l.getScaleFactor(label); ok && l.capacity >= 0 {
+		return l.capacity * scaleFactor
+	}
+	return l.capacity
+}
+
+// SetFillRate sets the number of tokens added to each bucket per second.
+func (l *TokenBucketRateLimitChecker) SetFillRate(fillRate float64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.fillRate = fillRate
+}
+
+// GetFillRate returns the number of tokens added to each bucket per second.
+func (l *TokenBucketRateLimitChecker) GetFillRate() float64 {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.fillRate
+}
+
+// GetLabelFillRate returns the fill rate for a specific label.
+func (l *TokenBucketRateLimitChecker) GetLabelFillRate(label string) float64 {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	if scaleFactor, ok := l.getScaleFactor(label); ok {
+		return l.fillRate * scaleFactor
+	}
+	return l.fillRate
+}
+
+// tokenBucket is the state of the token bucket of a single label.
+// Tokens are tracked as consumed from a full bucket, so that a missing bucket is a full one.
+type tokenBucket struct {
+	lastFill time.Time
+	consumed float64
+}
+
+// takeN refills the bucket up to now and takes n tokens from it, returning the same values as RateTracker.TakeN.
+// A request that does not fit is rejected without taking any tokens. Negative n returns tokens to the bucket.
+func (tb *tokenBucket) takeN(limitCheck *TokenBucketRateLimitChecker, label string, n int, now time.Time) (bool, int, int) {
+	capacity, limited := tb.refill(limitCheck, label, now)
+	if !limited {
+		return true, -1, 0
+	}
+
+	consumed := tb.consumed + float64(n)
+	if consumed > capacity && n > 0 {
+		return false, int(capacity - tb.consumed), int(math.Ceil(tb.consumed))
+	}
+	tb.consumed = math.Max(0, math.Min(consumed, capacity))
+	return true, int(capacity - tb.consumed), int(math.Ceil(tb.consumed))
+}
+
+// syncN refills the bucket up to now and takes n tokens of requests that were already admitted, returning the consumed tokens of the bucket.
+// Tokens that do not fit empty the bucket, as the requests cannot be rejected anymore.
+func (tb *tokenBucket) syncN(limitCheck *TokenBucketRateLimitChecker, label string, n int, now time.Time) int {
+	capacity, limited := tb.refill(limitCheck, label, now)
+	if !limited {
+		return 0
+	}
+	tb.consumed = math.Max(0, math.Min(tb.consumed+float64(n), capacity))
+	return int(math.Ceil(tb.consumed))
+}
+
+// refill adds the tokens filled since the last refill and returns the capacity of the bucket.
+// It returns false if there is no limit, in which case the bucket is kept full.
+func (tb *tokenBucket) refill(limitCheck *TokenBucketRateLimitChecker, label string, now time.Time) (float64, bool) {
+	capacity := limitCheck.GetLabelCapacity(label)
+	fillRate := limitCheck.GetLabelFillRate(label)
+	// capacity < 0 means that there is no limit
+	if capacity < 0 || fillRate < 0 {
+		tb.consumed = 0
+		tb.lastFill = now
+		return capacity, false
+	}
+	// a bucket that is being filled holds at least one token, otherwise fill rates below 1/s would reject every request
+	if fillRate > 0 && capacity < 1 {
+		capacity = 1
+	}
+
+	if elapsed := now.Sub(tb.lastFill).Seconds(); elapsed > 0 {
+		tb.consumed = math.Max(0, tb.consumed-fillRate*elapsed)
+	}
+	tb.lastFill = now
+	return capacity, true
+}
+
+// tokenBucketSize is the size of an encoded tokenBucket.
+const tokenBucketSize = 16
+
+func (tb *tokenBucket) marshal() []byte {
+	data := make([]byte, tokenBucketSize)
+	binary.BigEndian.PutUint64(data[:8], uint64(tb.lastFill.UnixNano()))
+	binary.BigEndian.PutUint64(data[8:], math.Float64bits(tb.consumed))
+	return data
+}
+
+func (tb *tokenBucket) unmarshal(data []byte) bool {
+	if len(data) != tokenBucketSize {
+		return false
+	}
+	tb.lastFill = time.Unix(0, int64(binary.BigEndian.Uint64(data[:8])))
+	tb.consumed = math.Float64frombits(binary.BigEndian.Uint64(data[8:]))
+	return true
+}
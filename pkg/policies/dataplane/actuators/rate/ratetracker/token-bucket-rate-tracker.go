@@ -0,0 +1,103 @@
+package ratetracker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+// LocalTokenBucketRateTracker implements RateTracker with token buckets kept in memory.
+type LocalTokenBucketRateTracker struct {
+	mu         sync.Mutex
+	clock      clockwork.Clock
+	limitCheck *TokenBucketRateLimitChecker
+	buckets    map[string]*tokenBucket
+	lastSweep  time.Time
+	name       string
+	ttl        time.Duration
+}
+
+// NewLocalTokenBucketRateTracker creates a new instance of LocalTokenBucketRateTracker. Buckets that stay full for ttl are dropped.
+func NewLocalTokenBucketRateTracker(limitCheck *TokenBucketRateLimitChecker, name string, ttl time.Duration, clock clockwork.Clock) RateTracker {
+	return &LocalTokenBucketRateTracker{
+		clock:      clock,
+		limitCheck: limitCheck,
+		buckets:    make(map[string]*tokenBucket),
+		lastSweep:  clock.Now(),
+		name:       name,
+		ttl:        ttl,
+	}
+}
+
+// Name returns the name of the LocalTokenBucketRateTracker.
+func (tr *LocalTokenBucketRateTracker) Name() string {
+	return tr.name
+}
+
+// Close drops all the buckets.
+func (tr *LocalTokenBucketRateTracker) Close() error {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tr.buckets = make(map[string]*tokenBucket)
+	return nil
+}
+
+// Take is a wrapper for TakeN(label, 1).
+func (tr *LocalTokenBucketRateTracker) Take(label string) (bool, int, int) {
+	return tr.TakeN(label, 1)
+}
+
+// TakeN takes n tokens from the bucket of the label and returns whether n events should be allowed along with the remaining tokens and the consumed tokens of the bucket.
+func (tr *LocalTokenBucketRateTracker) TakeN(label string, n int) (bool, int, int) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	now := tr.clock.Now()
+	tr.sweep(now)
+
+	bucket, ok := tr.buckets[label]
+	if !ok {
+		bucket = &tokenBucket{lastFill: now}
+		tr.buckets[label] = bucket
+	}
+	return bucket.takeN(tr.limitCheck, label, n, now)
+}
+
+// SyncN takes n tokens of already admitted requests from the bucket of the label and returns the consumed tokens of the bucket.
+func (tr *LocalTokenBucketRateTracker) SyncN(label string, n int) int {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	now := tr.clock.Now()
+	tr.sweep(now)
+
+	bucket, ok := tr.buckets[label]
+	if !ok {
+		bucket = &tokenBucket{lastFill: now}
+		tr.buckets[label] = bucket
+	}
+	return bucket.syncN(tr.limitCheck, label, n, now)
+}
+
+// sweep drops the buckets that have not been used for ttl, as they would be full by now anyway.
+func (tr *LocalTokenBucketRateTracker) sweep(now time.Time) {
+	if now.Sub(tr.lastSweep) < tr.ttl {
+		return
+	}
+	tr.lastSweep = now
+	for label, bucket := range tr.buckets {
+		if now.Sub(bucket.lastFill) >= tr.ttl {
+			delete(tr.buckets, label)
+		}
+	}
+}
+
+// GetRateLimitChecker returns the RateLimitChecker of the LocalTokenBucketRateTracker.
+func (tr *LocalTokenBucketRateTracker) GetRateLimitChecker() RateLimitChecker {
+	return tr.limitCheck
+}
+
+// Make sure LocalTokenBucketRateTracker implements RateTracker and AdmittedSyncer interfaces.
+var (
+	_ RateTracker    = (*LocalTokenBucketRateTracker)(nil)
+	_ AdmittedSyncer = (*LocalTokenBucketRateTracker)(nil)
+)
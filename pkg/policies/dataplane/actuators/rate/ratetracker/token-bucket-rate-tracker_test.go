@@ -0,0 +1,243 @@
+package ratetracker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+func newTestTokenBucketLimitChecker(fillRate float64, capacity int, overrides map[string]float64) *TokenBucketRateLimitChecker {
+	limitCheck := NewTokenBucketRateLimitChecker()
+	limitCheck.SetFillRate(fillRate)
+	limitCheck.SetRateLimit(capacity)
+	limitCheck.SetOverrides(overrides)
+	return limitCheck
+}
+
+// takeUntilRejected takes tokens one by one and returns the number of accepted takes.
+func takeUntilRejected(t *testing.T, limiter RateTracker, label string) int {
+	for accepted := 0; accepted < 1000; accepted++ {
+		if ok, _, _ := limiter.Take(label); !ok {
+			return accepted
+		}
+	}
+	t.Fatalf("Limiter did not reject any request for %s", label)
+	return 0
+}
+
+// TestLocalTokenBucketBurstAndRefill tests that the bucket allows a burst of its capacity and then refills at the fill rate.
+func TestLocalTokenBucketBurstAndRefill(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	limitCheck := newTestTokenBucketLimitChecker(2, 10, nil)
+	limiter := NewLocalTokenBucketRateTracker(limitCheck, "Limiter", time.Minute, clock)
+
+	if accepted := takeUntilRejected(t, limiter, "user-0"); accepted != 10 {
+		t.Errorf("Expected a burst of 10 requests, got %d", accepted)
+	}
+
+	clock.Advance(time.Second)
+	if accepted := takeUntilRejected(t, limiter, "user-0"); accepted != 2 {
+		t.Errorf("Expected 2 requests after refill, got %d", accepted)
+	}
+
+	// the bucket never holds more than its capacity
+	clock.Advance(time.Hour)
+	if accepted := takeUntilRejected(t, limiter, "user-0"); accepted != 10 {
+		t.Errorf("Expected a burst of 10 requests after idling, got %d", accepted)
+	}
+}
+
+// TestLocalTokenBucketSlowFillRate tests that a bucket filled slower than one token per second still admits requests.
+func TestLocalTokenBucketSlowFillRate(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	limitCheck := NewTokenBucketRateLimitChecker()
+	// 30 requests per minute, with the capacity defaulting to the fill rate
+	limitCheck.SetFillRate(0.5)
+	limitCheck.SetCapacity(0.5)
+	limiter := NewLocalTokenBucketRateTracker(limitCheck, "Limiter", time.Minute, clock)
+
+	if accepted := takeUntilRejected(t, limiter, "user-0"); accepted != 1 {
+		t.Errorf("Expected a burst of 1 request, got %d", accepted)
+	}
+	clock.Advance(time.Second)
+	if ok, _, _ := limiter.Take("user-0"); ok {
+		t.Error("Expected the request to be rejected before a whole token is refilled")
+	}
+	clock.Advance(2 * time.Second)
+	if accepted := takeUntilRejected(t, limiter, "user-0"); accepted != 1 {
+		t.Errorf("Expected 1 request after refill, got %d", accepted)
+	}
+}
+
+// TestLocalTokenBucketFractionalCapacity tests that a fractional capacity is not truncated.
+func TestLocalTokenBucketFractionalCapacity(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	limitCheck := NewTokenBucketRateLimitChecker()
+	limitCheck.SetFillRate(0.1)
+	limitCheck.SetCapacity(1.9)
+	limiter := NewLocalTokenBucketRateTracker(limitCheck, "Limiter", time.Minute, clock)
+
+	if ok, _, _ := limiter.Take("user-0"); !ok {
+		t.Error("Expected the first request to be accepted")
+	}
+	// 0.9 tokens are left in the bucket, so 0.1 more make a whole token, which takes 1s instead of 10s
+	clock.Advance(time.Second)
+	if ok, _, _ := limiter.Take("user-0"); !ok {
+		t.Error("Expected the request to be accepted after refill")
+	}
+	if got := limitCheck.GetRateLimit(); got != 2 {
+		t.Errorf("Expected the rate limit to be the capacity rounded up, got %d", got)
+	}
+}
+
+// TestLocalTokenBucketWithOverride tests that overrides scale both the capacity and the fill rate of a label.
+func TestLocalTokenBucketWithOverride(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	limitCheck := newTestTokenBucketLimitChecker(4, 10, map[string]float64{"user-1": 0.5})
+	limiter := NewLocalTokenBucketRateTracker(limitCheck, "Limiter", time.Minute, clock)
+
+	if accepted := takeUntilRejected(t, limiter, "user-1"); accepted != 5 {
+		t.Errorf("Expected a burst of 5 requests, got %d", accepted)
+	}
+	clock.Advance(time.Second)
+	if accepted := takeUntilRejected(t, limiter, "user-1"); accepted != 2 {
+		t.Errorf("Expected 2 requests after refill, got %d", accepted)
+	}
+	if accepted := takeUntilRejected(t, limiter, "user-0"); accepted != 10 {
+		t.Errorf("Expected a burst of 10 requests for a label without override, got %d", accepted)
+	}
+}
+
+// TestLocalTokenBucketReturnTokens tests that negative counts return tokens to the bucket.
+func TestLocalTokenBucketReturnTokens(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	limitCheck := newTestTokenBucketLimitChecker(1, 3, nil)
+	limiter := NewLocalTokenBucketRateTracker(limitCheck, "Limiter", time.Minute, clock)
+
+	takeUntilRejected(t, limiter, "user-0")
+	ok, remaining, current := limiter.TakeN("user-0", -1)
+	if !ok || remaining != 1 || current != 2 {
+		t.Errorf("Expected one returned token, got ok=%v remaining=%d current=%d", ok, remaining, current)
+	}
+	if ok, _, _ := limiter.Take("user-0"); !ok {
+		t.Error("Expected the returned token to be available")
+	}
+}
+
+// TestLocalTokenBucketRejectDoesNotConsume tests that a rejected request takes no tokens, so it does not starve later requests.
+func TestLocalTokenBucketRejectDoesNotConsume(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	limitCheck := newTestTokenBucketLimitChecker(1, 10, nil)
+	limiter := NewLocalTokenBucketRateTracker(limitCheck, "Limiter", time.Minute, clock)
+
+	ok, remaining, current := limiter.TakeN("user-0", 100)
+	if ok || remaining != 10 || current != 0 {
+		t.Errorf("Expected the request to be rejected without taking tokens, got ok=%v remaining=%d current=%d", ok, remaining, current)
+	}
+	if accepted := takeUntilRejected(t, limiter, "user-0"); accepted != 10 {
+		t.Errorf("Expected a burst of 10 requests after the rejected one, got %d", accepted)
+	}
+}
+
+// TestLocalTokenBucketSyncN tests that tokens of already admitted requests are taken up to the capacity.
+func TestLocalTokenBucketSyncN(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	limitCheck := newTestTokenBucketLimitChecker(1, 10, nil)
+	limiter := NewLocalTokenBucketRateTracker(limitCheck, "Limiter", time.Minute, clock).(*LocalTokenBucketRateTracker)
+
+	if current := limiter.SyncN("user-0", 4); current != 4 {
+		t.Errorf("Expected 4 consumed tokens, got %d", current)
+	}
+	if current := limiter.SyncN("user-0", 20); current != 10 {
+		t.Errorf("Expected the bucket to be empty, got %d consumed tokens", current)
+	}
+	if ok, _, _ := limiter.Take("user-0"); ok {
+		t.Error("Expected the request to be rejected")
+	}
+	clock.Advance(time.Second)
+	if current := limiter.SyncN("user-0", -3); current != 6 {
+		t.Errorf("Expected returned tokens to be refilled, got %d consumed tokens", current)
+	}
+}
+
+// TestLazySyncTokenBucket tests that the lazy sync limiter does not count rejected requests against token buckets and syncs the admitted ones.
+func TestLazySyncTokenBucket(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	limitCheck := newTestTokenBucketLimitChecker(1, 10, nil)
+	limiter := NewLocalTokenBucketRateTracker(limitCheck, "Limiter", time.Minute, clock)
+	lazySyncLimiter, err := NewLazySyncRateTracker(limiter, time.Hour, createJobGroup(limiter))
+	if err != nil {
+		t.Fatalf("Failed to create lazy sync limiter: %v", err)
+	}
+	defer closeLimiters(t, []RateTracker{lazySyncLimiter})
+	lsl := lazySyncLimiter.(*LazySyncRateTracker)
+
+	// the first request is taken from the bucket, the other 9 are counted locally
+	if accepted := takeUntilRejected(t, lazySyncLimiter, "user-0"); accepted != 10 {
+		t.Errorf("Expected a burst of 10 requests, got %d", accepted)
+	}
+	if ok, _, _ := lazySyncLimiter.TakeN("user-0", 100); ok {
+		t.Error("Expected the request to be rejected")
+	}
+	v, _ := lsl.counters.Load("user-0")
+	if local := atomic.LoadInt32(&v.(*counter).local); local != 9 {
+		t.Errorf("Expected 9 locally admitted tokens, got %d", local)
+	}
+
+	_, _ = lsl.sync(context.Background())
+	deadline := time.Now().Add(time.Second)
+	for {
+		_, _, current := limiter.TakeN("user-0", 0)
+		if current == 10 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the admitted tokens to be synced, got %d consumed tokens", current)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestLocalTokenBucketNoLimit tests that a negative fill rate disables the limiter.
+func TestLocalTokenBucketNoLimit(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	limitCheck := newTestTokenBucketLimitChecker(-1, -1, nil)
+	limiter := NewLocalTokenBucketRateTracker(limitCheck, "Limiter", time.Minute, clock)
+
+	for i := 0; i < 100; i++ {
+		if ok, remaining, _ := limiter.Take("user-0"); !ok || remaining != -1 {
+			t.Fatalf("Expected no limit, got ok=%v remaining=%d", ok, remaining)
+		}
+	}
+}
+
+// TestOlricClusterTokenBucket tests that the token buckets are shared by a cluster of DistCacheTokenBucketRateTracker.
+func TestOlricClusterTokenBucket(t *testing.T) {
+	cl := newTestDistCacheCluster(t, 3)
+	defer closeTestDistCacheCluster(t, cl)
+
+	clock := clockwork.NewFakeClock()
+	limitCheck := newTestTokenBucketLimitChecker(1, 10, nil)
+	var limiters []RateTracker
+	for _, distCache := range cl.members {
+		limiter, err := NewDistCacheTokenBucketRateTracker(limitCheck, distCache, "TokenBucketLimiter", time.Minute, clock)
+		if err != nil {
+			t.Fatalf("Failed to create DistCacheTokenBucketRateTracker: %v", err)
+		}
+		limiters = append(limiters, limiter)
+	}
+	defer closeLimiters(t, limiters)
+
+	accepted := 0
+	for i := 0; i < 30; i++ {
+		if ok, _, _ := limiters[i%len(limiters)].Take("user-0"); ok {
+			accepted++
+		}
+	}
+	if accepted != 10 {
+		t.Errorf("Expected a burst of 10 requests across the cluster, got %d", accepted)
+	}
+}
@@ -3,7 +3,9 @@ package dataplane
 import (
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/exp/maps"
 
 	selectorv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/common/selector/v1"
@@ -38,6 +40,7 @@ func ProvideEngineAPI() iface.Engine {
 		multiMatchers: make(map[selectors.ControlPointID]*multiMatcher),
 		fluxMetersMap: make(map[iface.FluxMeterID]iface.FluxMeter),
 		conLimiterMap: make(map[iface.LimiterID]iface.Limiter),
+		issuedTokens:  newIssuedTokens(time.Now()),
 	}
 	return e
 }
@@ -53,6 +56,7 @@ type Engine struct {
 	conLimiterMap      map[iface.LimiterID]iface.Limiter
 	multiMatchersMutex sync.RWMutex
 	multiMatchers      map[selectors.ControlPointID]*multiMatcher
+	issuedTokens       *issuedTokens
 }
 
 // ProcessRequest .
@@ -86,6 +90,13 @@ func (e *Engine) ProcessRequest(controlPoint selectors.ControlPoint, serviceIDs
 	rateLimiterDecisions, rateLimitersDecisionType := runLimiters(rateLimiters, labels)
 	response.LimiterDecisions = rateLimiterDecisions
 
+	// expose remaining quota, rejected flows included
+	for i, rl := range mmr.rateLimiters {
+		if ql, ok := rl.(iface.QuotaLimiter); ok {
+			ql.AnnotateResponse(rateLimiterDecisions[i], response)
+		}
+	}
+
 	defer func() {
 		if response.DecisionType == flowcontrolv1.CheckResponse_DECISION_TYPE_REJECTED {
 			returnExtraTokens(mmr.rateLimiters, rateLimiterDecisions, labels)
@@ -97,6 +108,7 @@ func (e *Engine) ProcessRequest(controlPoint selectors.ControlPoint, serviceIDs
 	if rateLimitersDecisionType == flowcontrolv1.CheckResponse_DECISION_TYPE_REJECTED {
 		response.DecisionType = rateLimitersDecisionType
 		response.RejectReason = flowcontrolv1.CheckResponse_REJECT_REASON_RATE_LIMITED
+		response.DeniedResponse = getDeniedResponse(rateLimiters, rateLimiterDecisions)
 		return
 	}
 
@@ -109,10 +121,17 @@ func (e *Engine) ProcessRequest(controlPoint selectors.ControlPoint, serviceIDs
 
 	if concurrencyLimitersDecisionType == flowcontrolv1.CheckResponse_DECISION_TYPE_REJECTED {
 		response.DecisionType = flowcontrolv1.CheckResponse_DECISION_TYPE_REJECTED
-		response.RejectReason = flowcontrolv1.CheckResponse_REJECT_REASON_CONCURRENCY_LIMITED
+		response.RejectReason = getConcurrencyRejectReason(concurrencyLimiterDecisions)
+		response.DeniedResponse = getDeniedResponse(concurrencyLimiters, concurrencyLimiterDecisions)
 		return
 	}
 
+	// remember the tokens issued to the flow, so that the unused ones can be returned when it ends
+	if tokens := getIssuedTokens(concurrencyLimiters, concurrencyLimiterDecisions); len(tokens) > 0 {
+		response.FlowId = uuid.NewString()
+		e.issuedTokens.record(time.Now(), response.FlowId, tokens)
+	}
+
 	return
 }
 
@@ -131,6 +150,7 @@ func runLimiters(limiters []iface.Limiter, labels map[string]string) ([]*flowcon
 		return func() {
 			defer wg.Done()
 			decisions[i] = limiter.RunLimiter(labels)
+			// Limiters in shadow mode never set Dropped, so they do not affect the decision type
 			if decisions[i].Dropped {
 				once.Do(setDecisionRejected)
 			}
@@ -151,14 +171,59 @@ func runLimiters(limiters []iface.Limiter, labels map[string]string) ([]*flowcon
 	return decisions, decisionType
 }
 
+// getDeniedResponse returns the denied response of the first limiter that dropped the flow.
+func getDeniedResponse(limiters []iface.Limiter, decisions []*flowcontrolv1.LimiterDecision) *flowcontrolv1.DeniedResponse {
+	for i, decision := range decisions {
+		if decision.Dropped {
+			return limiters[i].GetDeniedResponse(decision)
+		}
+	}
+	return nil
+}
+
+// getIssuedTokens returns the tokens the concurrency limiters issued to an accepted flow.
+func getIssuedTokens(limiters []iface.Limiter, decisions []*flowcontrolv1.LimiterDecision) []iface.IssuedTokens {
+	var tokens []iface.IssuedTokens
+	for i, decision := range decisions {
+		cl := decision.GetConcurrencyLimiterInfo()
+		// shadow mode does not take tokens for the flows it would have dropped
+		if cl == nil || decision.Dropped || decision.WouldHaveDropped || cl.GetTokens() == 0 {
+			continue
+		}
+		tokens = append(tokens, iface.IssuedTokens{
+			LimiterID: limiters[i].GetLimiterID(),
+			Tokens:    cl.GetTokens(),
+		})
+	}
+	return tokens
+}
+
+// getConcurrencyRejectReason returns the reject reason based on the first concurrency limiter that dropped the flow.
+func getConcurrencyRejectReason(decisions []*flowcontrolv1.LimiterDecision) flowcontrolv1.CheckResponse_RejectReason {
+	for _, decision := range decisions {
+		if decision.Dropped {
+			if decision.Reason == flowcontrolv1.LimiterDecision_LIMITER_REASON_QUEUE_FULL {
+				return flowcontrolv1.CheckResponse_REJECT_REASON_QUEUE_FULL
+			}
+			break
+		}
+	}
+	return flowcontrolv1.CheckResponse_REJECT_REASON_CONCURRENCY_LIMITED
+}
+
 func returnExtraTokens(
 	rateLimiters []iface.RateLimiter,
 	rateLimiterDecisions []*flowcontrolv1.LimiterDecision,
 	labels map[string]string,
 ) {
 	for i, l := range rateLimiterDecisions {
-		if !l.Dropped && l.Reason == flowcontrolv1.LimiterDecision_LIMITER_REASON_UNSPECIFIED {
-			go rateLimiters[i].TakeN(labels, -1)
+		if !l.Dropped && !l.WouldHaveDropped && l.Reason == flowcontrolv1.LimiterDecision_LIMITER_REASON_UNSPECIFIED {
+			// refund the tokens taken by the flow
+			tokens := 1
+			if rateLimiterInfo := l.GetRateLimiterInfo(); rateLimiterInfo != nil && rateLimiterInfo.GetTokens() > 0 {
+				tokens = int(rateLimiterInfo.GetTokens())
+			}
+			go rateLimiters[i].TakeN(labels, -tokens)
 		}
 	}
 }
@@ -233,6 +298,11 @@ func (e *Engine) GetFluxMeter(fluxMeterName string) iface.FluxMeter {
 	return e.fluxMetersMap[fmID]
 }
 
+// GetMatchingFluxMeters returns the flux meters matching given control point, services and labels.
+func (e *Engine) GetMatchingFluxMeters(controlPoint selectors.ControlPoint, serviceIDs []string, labels map[string]string) []iface.FluxMeter {
+	return e.getMatches(controlPoint, serviceIDs, labels).fluxMeters
+}
+
 // GetConcurrencyLimiter Lookup function for getting concurrency limiter.
 func (e *Engine) GetConcurrencyLimiter(limiterID iface.LimiterID) iface.Limiter {
 	e.conLimiterMapMutex.RLock()
@@ -240,6 +310,14 @@ func (e *Engine) GetConcurrencyLimiter(limiterID iface.LimiterID) iface.Limiter
 	return e.conLimiterMap[limiterID]
 }
 
+// TakeIssuedTokens returns the scheduler tokens issued to a flow and forgets them.
+func (e *Engine) TakeIssuedTokens(flowID string) []iface.IssuedTokens {
+	if flowID == "" {
+		return nil
+	}
+	return e.issuedTokens.take(time.Now(), flowID)
+}
+
 // RegisterRateLimiter adds limiter actuator to multimatcher.
 func (e *Engine) RegisterRateLimiter(rl iface.RateLimiter) error {
 	limiterActuatorMatchedCB := func(mmr multiMatchResult) multiMatchResult {
@@ -181,5 +181,88 @@ var _ = Describe("Dataplane Engine", func() {
 			Expect(mmr.fluxMeters).NotTo(BeEmpty())
 			Expect(mmr.concurrencyLimiters).NotTo(BeEmpty())
 		})
+
+		It("Accepts flows that a limiter in shadow mode would have dropped", func() {
+			_ = engine.RegisterConcurrencyLimiter(mockLimiter)
+			mockLimiter.EXPECT().RunLimiter(gomock.Any()).Return(&flowcontrolv1.LimiterDecision{
+				PolicyName:       "test",
+				WouldHaveDropped: true,
+			})
+
+			controlPoint := selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, "")
+			svcs := []string{"testService.testNamespace.svc.cluster.local"}
+			labels := map[string]string{"service": "testService.testNamespace.svc.cluster.local"}
+
+			response := engine.ProcessRequest(controlPoint, svcs, labels)
+			Expect(response.DecisionType).To(Equal(flowcontrolv1.CheckResponse_DECISION_TYPE_ACCEPTED))
+			Expect(response.LimiterDecisions).To(HaveLen(1))
+			Expect(response.LimiterDecisions[0].WouldHaveDropped).To(BeTrue())
+		})
+
+		It("Returns the denied response of the limiter that dropped the flow", func() {
+			_ = engine.RegisterConcurrencyLimiter(mockLimiter)
+			mockLimiter.EXPECT().RunLimiter(gomock.Any()).Return(&flowcontrolv1.LimiterDecision{
+				PolicyName: "test",
+				Dropped:    true,
+			})
+			deniedResponse := &flowcontrolv1.DeniedResponse{
+				HttpStatusCode: 503,
+				Headers:        map[string]string{"Retry-After": "1"},
+			}
+			mockLimiter.EXPECT().GetDeniedResponse(gomock.Any()).Return(deniedResponse)
+
+			controlPoint := selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, "")
+			svcs := []string{"testService.testNamespace.svc.cluster.local"}
+			labels := map[string]string{"service": "testService.testNamespace.svc.cluster.local"}
+
+			response := engine.ProcessRequest(controlPoint, svcs, labels)
+			Expect(response.DecisionType).To(Equal(flowcontrolv1.CheckResponse_DECISION_TYPE_REJECTED))
+			Expect(response.RejectReason).To(Equal(flowcontrolv1.CheckResponse_REJECT_REASON_CONCURRENCY_LIMITED))
+			Expect(response.DeniedResponse).To(Equal(deniedResponse))
+		})
+
+		It("Refunds the tokens taken by rate limiters of rejected flows", func() {
+			_ = engine.RegisterConcurrencyLimiter(mockLimiter)
+			mockLimiter.EXPECT().RunLimiter(gomock.Any()).Return(&flowcontrolv1.LimiterDecision{
+				PolicyName: "test",
+				Dropped:    true,
+			})
+			mockLimiter.EXPECT().GetDeniedResponse(gomock.Any()).Return(nil)
+
+			mockRateLimiter := mocks.NewMockLimiter(mockCtrl)
+			mockRateLimiter.EXPECT().GetPolicyName().AnyTimes()
+			mockRateLimiter.EXPECT().GetSelector().Return(selector).AnyTimes()
+			mockRateLimiter.EXPECT().GetLimiterID().Return(iface.LimiterID{PolicyName: "test", ComponentIndex: 1}).AnyTimes()
+			mockRateLimiter.EXPECT().RunLimiter(gomock.Any()).Return(&flowcontrolv1.LimiterDecision{
+				PolicyName: "test",
+				Details: &flowcontrolv1.LimiterDecision_RateLimiterInfo_{
+					RateLimiterInfo: &flowcontrolv1.LimiterDecision_RateLimiterInfo{
+						Label:  "user:a",
+						Tokens: 5,
+					},
+				},
+			})
+			rateLimiter := &takeNRecorder{MockLimiter: mockRateLimiter, taken: make(chan int, 1)}
+			Expect(engine.RegisterRateLimiter(rateLimiter)).To(Succeed())
+
+			controlPoint := selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, "")
+			svcs := []string{"testService.testNamespace.svc.cluster.local"}
+			labels := map[string]string{"service": "testService.testNamespace.svc.cluster.local"}
+
+			response := engine.ProcessRequest(controlPoint, svcs, labels)
+			Expect(response.DecisionType).To(Equal(flowcontrolv1.CheckResponse_DECISION_TYPE_REJECTED))
+			Eventually(rateLimiter.taken).Should(Receive(Equal(-5)))
+		})
 	})
 })
+
+// takeNRecorder is a rate limiter recording the tokens taken by the engine.
+type takeNRecorder struct {
+	*mocks.MockLimiter
+	taken chan int
+}
+
+func (r *takeNRecorder) TakeN(labels map[string]string, n int) (string, bool, int, int) {
+	r.taken <- n
+	return "", true, 0, 0
+}
@@ -0,0 +1,107 @@
+package dataplane
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rs/zerolog"
+
+	flowcontrolv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/flowcontrol/v1"
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/metrics"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+)
+
+// ObserveWorkloadLatency observes the latency (in ms) of an ended flow in the workload latency histograms
+// of the concurrency limiters that scheduled it.
+func ObserveWorkloadLatency(engine iface.Engine, checkResponse *flowcontrolv1.CheckResponse, latency float64) {
+	for _, decision := range checkResponse.GetLimiterDecisions() {
+		cl := decision.GetConcurrencyLimiterInfo()
+		if cl == nil {
+			continue
+		}
+		limiter := getConcurrencyLimiter(engine, decision)
+		if limiter == nil {
+			continue
+		}
+		labels := map[string]string{
+			metrics.PolicyNameLabel:     decision.PolicyName,
+			metrics.PolicyHashLabel:     decision.PolicyHash,
+			metrics.ComponentIndexLabel: fmt.Sprintf("%d", decision.ComponentIndex),
+			metrics.DecisionTypeLabel:   checkResponse.DecisionType.String(),
+			metrics.WorkloadIndexLabel:  cl.GetWorkloadIndex(),
+		}
+		latencyHistogram := limiter.GetObserver(labels)
+		if latencyHistogram != nil {
+			latencyHistogram.Observe(latency)
+		}
+	}
+}
+
+// ReturnUnusedTokens returns the tokens that the concurrency limiters issued to an ended flow, but which
+// were not used as the flow took less time (latency in ms) than the tokens estimated.
+//
+// The tokens are looked up by the flow ID of the check response in the records of the engine, so
+// each flow gets its unused tokens returned at most once, regardless of the path it is reported by.
+func ReturnUnusedTokens(engine iface.Engine, checkResponse *flowcontrolv1.CheckResponse, latency float64) {
+	usedTokens := uint64(math.Ceil(math.Max(0, latency)))
+	for _, issued := range engine.TakeIssuedTokens(checkResponse.GetFlowId()) {
+		if issued.Tokens <= usedTokens {
+			continue
+		}
+		limiter := getConcurrencyLimiterByID(engine, issued.LimiterID)
+		if limiter == nil {
+			continue
+		}
+		if concurrencyLimiter, ok := limiter.(iface.ConcurrencyLimiter); ok {
+			concurrencyLimiter.ReturnTokens(issued.Tokens - usedTokens)
+		}
+	}
+}
+
+func getConcurrencyLimiter(engine iface.Engine, decision *flowcontrolv1.LimiterDecision) iface.Limiter {
+	return getConcurrencyLimiterByID(engine, iface.LimiterID{
+		PolicyName:     decision.PolicyName,
+		PolicyHash:     decision.PolicyHash,
+		ComponentIndex: decision.ComponentIndex,
+	})
+}
+
+func getConcurrencyLimiterByID(engine iface.Engine, limiterID iface.LimiterID) iface.Limiter {
+	limiter := engine.GetConcurrencyLimiter(limiterID)
+	if limiter == nil {
+		log.Sample(zerolog.Sometimes).Warn().
+			Str(metrics.PolicyNameLabel, limiterID.PolicyName).
+			Str(metrics.PolicyHashLabel, limiterID.PolicyHash).
+			Int64(metrics.ComponentIndexLabel, limiterID.ComponentIndex).
+			Msg("ConcurrencyLimiter not found")
+	}
+	return limiter
+}
+
+// ObserveFluxMeters observes an ended flow in the histograms of its flux meters.
+// metricValue returns the value of the flow at the attribute key of a flux meter.
+func ObserveFluxMeters(
+	engine iface.Engine,
+	checkResponse *flowcontrolv1.CheckResponse,
+	statusCode string,
+	featureStatus string,
+	metricValue func(attributeKey string) float64,
+) {
+	decisionType := checkResponse.GetDecisionType()
+	for _, fluxMeterInfo := range checkResponse.GetFluxMeterInfos() {
+		fluxMeter := engine.GetFluxMeter(fluxMeterInfo.GetFluxMeterName())
+		if fluxMeter == nil {
+			log.Sample(zerolog.Sometimes).Warn().Str(metrics.FluxMeterNameLabel, fluxMeterInfo.GetFluxMeterName()).
+				Str(metrics.DecisionTypeLabel, decisionType.String()).
+				Str(metrics.StatusCodeLabel, statusCode).
+				Str(metrics.FeatureStatusLabel, featureStatus).
+				Msg("FluxMeter not found")
+			continue
+		}
+		fluxMeterHistogram := fluxMeter.GetHistogram(decisionType, statusCode, featureStatus)
+		if fluxMeterHistogram != nil {
+			fluxMeterHistogram.Observe(metricValue(fluxMeter.GetAttributeKey()))
+		}
+	}
+}
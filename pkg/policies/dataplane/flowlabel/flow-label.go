@@ -5,8 +5,18 @@ type FlowLabels map[string]FlowLabelValue
 
 // FlowLabelValue is a value of a flow label with additional metadata.
 type FlowLabelValue struct {
-	Value     string
-	Telemetry bool
+	Value string
+	// TelemetryValue replaces Value in telemetry, if set (eg. hashed or redacted value).
+	TelemetryValue string
+	Telemetry      bool
+}
+
+// ValueForTelemetry returns the value of the flow label to be used in telemetry.
+func (flv FlowLabelValue) ValueForTelemetry() string {
+	if flv.TelemetryValue != "" {
+		return flv.TelemetryValue
+	}
+	return flv.Value
 }
 
 // NewFromPlainMap returns flow labels from normal map[string]string. Telemetry flag is set to true for all flow labels.
@@ -30,6 +40,15 @@ func (fl FlowLabels) ToPlainMap() map[string]string {
 	return plainMap
 }
 
+// ToTelemetryMap returns flow labels as normal map[string]string, with the values to be used in telemetry.
+func (fl FlowLabels) ToTelemetryMap() map[string]string {
+	telemetryMap := make(map[string]string, len(fl))
+	for key, val := range fl {
+		telemetryMap[key] = val.ValueForTelemetry()
+	}
+	return telemetryMap
+}
+
 // Merge combines two flow labels maps into one. Overwrites overlapping keys with values from src.
 func Merge(dst, src FlowLabels) {
 	for key, val := range src {
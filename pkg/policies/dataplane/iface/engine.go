@@ -19,9 +19,19 @@ type Engine interface {
 	RegisterFluxMeter(fm FluxMeter) error
 	UnregisterFluxMeter(fm FluxMeter) error
 	GetFluxMeter(fluxMeterName string) FluxMeter
+	GetMatchingFluxMeters(controlPoint selectors.ControlPoint, serviceIDs []string, labels map[string]string) []FluxMeter
 
 	RegisterRateLimiter(l RateLimiter) error
 	UnregisterRateLimiter(l RateLimiter) error
+
+	// TakeIssuedTokens returns the scheduler tokens issued to a flow and forgets them, so they are taken at most once.
+	TakeIssuedTokens(flowID string) []IssuedTokens
+}
+
+// IssuedTokens are the scheduler tokens a concurrency limiter issued to a flow.
+type IssuedTokens struct {
+	LimiterID LimiterID
+	Tokens    uint64
 }
 
 // MultiMatchResult is used as return value of PolicyConfigAPI.GetMatches.
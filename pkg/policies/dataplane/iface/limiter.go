@@ -30,4 +30,13 @@ type Limiter interface {
 	RunLimiter(labels map[string]string) *flowcontrolv1.LimiterDecision
 	GetLimiterID() LimiterID
 	GetObserver(labels map[string]string) prometheus.Observer
+	// GetDeniedResponse returns the response for the client of the flow rejected by the decision.
+	GetDeniedResponse(decision *flowcontrolv1.LimiterDecision) *flowcontrolv1.DeniedResponse
+}
+
+// ConcurrencyLimiter interface.
+// Limiter which schedules flows, unused tokens of the flows can be returned to it.
+type ConcurrencyLimiter interface {
+	Limiter
+	ReturnTokens(tokens uint64)
 }
@@ -1,7 +1,17 @@
 package iface
 
+import (
+	flowcontrolv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/flowcontrol/v1"
+)
+
 // RateLimiter interface.
 type RateLimiter interface {
 	Limiter
 	TakeN(labels map[string]string, count int) (label string, ok bool, remaining int, current int)
 }
+
+// QuotaLimiter interface.
+type QuotaLimiter interface {
+	RateLimiter
+	AnnotateResponse(decision *flowcontrolv1.LimiterDecision, response *flowcontrolv1.CheckResponse)
+}
@@ -0,0 +1,69 @@
+package dataplane
+
+import (
+	"sync"
+	"time"
+
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+)
+
+// issuedTokensTTL is how long the tokens issued to a flow are kept until the flow ends.
+// A flow that runs longer has used up its tokens, so there is nothing left to return.
+const issuedTokensTTL = time.Minute
+
+// issuedTokens records the scheduler tokens issued to flows by flow ID, so that unused tokens
+// are returned at most once and never more than were issued.
+//
+// Flows that never end are forgotten after one to two TTLs, when the map holding them is rotated out.
+type issuedTokens struct {
+	mutex    sync.Mutex
+	current  map[string][]iface.IssuedTokens
+	previous map[string][]iface.IssuedTokens
+	rotated  time.Time
+}
+
+func newIssuedTokens(now time.Time) *issuedTokens {
+	return &issuedTokens{
+		current:  make(map[string][]iface.IssuedTokens),
+		previous: make(map[string][]iface.IssuedTokens),
+		rotated:  now,
+	}
+}
+
+// record the tokens issued to a flow.
+func (it *issuedTokens) record(now time.Time, flowID string, tokens []iface.IssuedTokens) {
+	it.mutex.Lock()
+	defer it.mutex.Unlock()
+	it.rotate(now)
+	it.current[flowID] = tokens
+}
+
+// take returns the tokens issued to a flow and forgets them.
+func (it *issuedTokens) take(now time.Time, flowID string) []iface.IssuedTokens {
+	it.mutex.Lock()
+	defer it.mutex.Unlock()
+	it.rotate(now)
+	if tokens, ok := it.current[flowID]; ok {
+		delete(it.current, flowID)
+		return tokens
+	}
+	if tokens, ok := it.previous[flowID]; ok {
+		delete(it.previous, flowID)
+		return tokens
+	}
+	return nil
+}
+
+func (it *issuedTokens) rotate(now time.Time) {
+	elapsed := now.Sub(it.rotated)
+	if elapsed < issuedTokensTTL {
+		return
+	}
+	if elapsed < 2*issuedTokensTTL {
+		it.previous = it.current
+	} else {
+		it.previous = make(map[string][]iface.IssuedTokens)
+	}
+	it.current = make(map[string][]iface.IssuedTokens)
+	it.rotated = now
+}
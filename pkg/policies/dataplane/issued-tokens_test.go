@@ -0,0 +1,48 @@
+package dataplane
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/iface"
+)
+
+var _ = Describe("Issued tokens", func() {
+	var (
+		now    time.Time
+		it     *issuedTokens
+		tokens []iface.IssuedTokens
+	)
+
+	BeforeEach(func() {
+		now = time.Unix(0, 0)
+		it = newIssuedTokens(now)
+		tokens = []iface.IssuedTokens{{
+			LimiterID: iface.LimiterID{PolicyName: "policy", PolicyHash: "hash", ComponentIndex: 1},
+			Tokens:    50,
+		}}
+	})
+
+	It("returns the tokens of a flow once", func() {
+		it.record(now, "flow", tokens)
+		Expect(it.take(now, "unknown")).To(BeEmpty())
+		Expect(it.take(now, "flow")).To(Equal(tokens))
+		Expect(it.take(now, "flow")).To(BeEmpty())
+	})
+
+	It("keeps the tokens of a flow for at least the TTL", func() {
+		it.record(now, "flow", tokens)
+		it.record(now.Add(issuedTokensTTL), "other", tokens)
+		Expect(it.take(now.Add(issuedTokensTTL+time.Second), "flow")).To(Equal(tokens))
+	})
+
+	It("forgets the tokens of flows that did not end", func() {
+		it.record(now, "flow", tokens)
+		it.record(now.Add(issuedTokensTTL), "other", tokens)
+		it.record(now.Add(2*issuedTokensTTL), "another", tokens)
+		Expect(it.take(now.Add(2*issuedTokensTTL), "flow")).To(BeEmpty())
+		Expect(it.take(now.Add(5*issuedTokensTTL), "another")).To(BeEmpty())
+	})
+})
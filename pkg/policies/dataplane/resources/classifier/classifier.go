@@ -3,8 +3,10 @@ package classifier
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/open-policy-agent/opa/ast"
 	"github.com/open-policy-agent/opa/rego"
@@ -18,16 +20,21 @@ import (
 	"github.com/fluxninja/aperture/pkg/multimatcher"
 	"github.com/fluxninja/aperture/pkg/policies/dataplane/flowlabel"
 	"github.com/fluxninja/aperture/pkg/policies/dataplane/resources/classifier/compiler"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/resources/classifier/extractors"
 	"github.com/fluxninja/aperture/pkg/policies/dataplane/selectors"
 	"github.com/fluxninja/aperture/pkg/status"
 )
 
 type multiMatcherByControlPoint map[selectors.ControlPointID]*multimatcher.MultiMatcher[int, []*compiler.LabelerWithSelector]
 
+type responseMultiMatcherByControlPoint map[selectors.ControlPointID]*multimatcher.MultiMatcher[int, []*compiler.ResponseLabelerWithSelector]
+
 // rules is a helper struct to keep both compiled and uncompiled sets of rules in sync.
 type rules struct {
 	// rules compiled to map from ControlPointID to MultiMatcher
 	MultiMatcherByControlPointID multiMatcherByControlPoint
+	// response rules compiled to map from ControlPointID to MultiMatcher
+	ResponseMultiMatcherByControlPointID responseMultiMatcherByControlPoint
 	// non-compiled version of rules, used for reporting
 	ReportedRules []compiler.ReportedRule
 }
@@ -40,6 +47,9 @@ type ClassificationEngine struct {
 	activeRulesets  map[rulesetID]compiler.CompiledRuleset
 	classifierProto *classificationv1.Classifier
 	nextRulesetID   rulesetID
+	requestLabels   *requestLabels
+	// secret keying the hash telemetry transform, shared by the agents of the installation
+	telemetryHashKey []byte
 }
 
 type rulesetID = uint64
@@ -49,6 +59,7 @@ func NewClassificationEngine(registry status.Registry) *ClassificationEngine {
 	return &ClassificationEngine{
 		activeRulesets: make(map[rulesetID]compiler.CompiledRuleset),
 		registry:       registry,
+		requestLabels:  newRequestLabels(time.Now()),
 	}
 }
 
@@ -57,6 +68,7 @@ func (c *ClassificationEngine) populateFlowLabels(ctx context.Context,
 	mm *multimatcher.MultiMatcher[int, []*compiler.LabelerWithSelector],
 	labelsForMatching map[string]string,
 	input ast.Value,
+	celActivation *compiler.CELActivation,
 ) (classifierMsgs []*flowcontrolv1.ClassifierInfo) {
 	logger := c.registry.GetLogger()
 	logSampled := logger.Sample(zerolog.Sometimes)
@@ -72,6 +84,24 @@ func (c *ClassificationEngine) populateFlowLabels(ctx context.Context,
 
 	for _, labelerWithSelector := range mm.Match(labelsForMatching) {
 		labeler := labelerWithSelector.Labeler
+		if labeler.Program != nil {
+			val, _, err := labeler.Program.ContextEval(ctx, celActivation)
+			if err != nil {
+				logSampled.Warn().Err(err).Msg("CEL: Evaluation failed")
+				appendNewClassifier(labelerWithSelector, flowcontrolv1.ClassifierInfo_ERROR_EVAL_FAILED)
+				continue
+			}
+			value, err := compiler.CELValueToString(val)
+			if err != nil {
+				logSampled.Warn().Err(err).Msg("CEL: Conversion of result failed")
+				appendNewClassifier(labelerWithSelector, flowcontrolv1.ClassifierInfo_ERROR_EVAL_FAILED)
+				continue
+			}
+			flowLabels[labeler.LabelName] = labeler.Guard.FlowLabelValue(value, labeler.Telemetry)
+			appendNewClassifier(labelerWithSelector, flowcontrolv1.ClassifierInfo_ERROR_NONE)
+			continue
+		}
+
 		resultSet, err := labeler.Query.Eval(ctx, rego.EvalParsedInput(input))
 		if err != nil {
 			logSampled.Warn().Msg("Rego: Evaluation failed")
@@ -97,10 +127,7 @@ func (c *ClassificationEngine) populateFlowLabels(ctx context.Context,
 
 		if labeler.LabelName != "" {
 			// single-label-query
-			flowLabels[labeler.LabelName] = flowlabel.FlowLabelValue{
-				Value:     resultSet[0].Expressions[0].String(),
-				Telemetry: labeler.Telemetry,
-			}
+			flowLabels[labeler.LabelName] = labeler.Guard.FlowLabelValue(resultSet[0].Expressions[0].String(), labeler.Telemetry)
 			appendNewClassifier(labelerWithSelector, flowcontrolv1.ClassifierInfo_ERROR_NONE)
 		} else {
 			// multi-label-query
@@ -113,10 +140,24 @@ func (c *ClassificationEngine) populateFlowLabels(ctx context.Context,
 
 			appendNewClassifier(labelerWithSelector, flowcontrolv1.ClassifierInfo_ERROR_NONE)
 			for key, value := range variables {
-				flowLabels[key] = flowlabel.FlowLabelValue{
-					Value:     fmt.Sprint(value),
-					Telemetry: labeler.LabelsTelemetry[key],
+				if key == extractors.JWTUnverifiedKey {
+					unverifiedLabels, _ := value.([]interface{})
+					for _, label := range unverifiedLabels {
+						classifierMsgs = append(classifierMsgs, &flowcontrolv1.ClassifierInfo{
+							PolicyName:      labelerWithSelector.CommonAttributes.PolicyName,
+							PolicyHash:      labelerWithSelector.CommonAttributes.PolicyHash,
+							ClassifierIndex: labelerWithSelector.CommonAttributes.ComponentIndex,
+							LabelKey:        fmt.Sprint(label),
+							Error:           flowcontrolv1.ClassifierInfo_ERROR_JWT_VERIFICATION_FAILED,
+						})
+					}
+					continue
 				}
+				if strings.HasPrefix(key, extractors.HelperPrefix) {
+					// helper rules of compiled extractors are not flow labels
+					continue
+				}
+				flowLabels[key] = labeler.LabelsGuards[key].FlowLabelValue(fmt.Sprint(value), labeler.LabelsTelemetry[key])
 			}
 		}
 	}
@@ -141,12 +182,14 @@ func (c *ClassificationEngine) Classify(
 	}
 
 	var classifierMsgs []*flowcontrolv1.ClassifierInfo
+	// shared by CEL rules, so that the input is converted at most once
+	celActivation := compiler.NewCELActivation(input)
 
 	// Catch all Service
 	cpID := selectors.NewControlPointID("", ctrlPt)
 	mm, ok := r.MultiMatcherByControlPointID[cpID]
 	if ok {
-		classifierMsgs = append(classifierMsgs, c.populateFlowLabels(ctx, flowLabels, mm, labelsForMatching, input)...)
+		classifierMsgs = append(classifierMsgs, c.populateFlowLabels(ctx, flowLabels, mm, labelsForMatching, input, celActivation)...)
 	}
 
 	// TODO (krdln): update prometheus metrics upon classification errors.
@@ -159,12 +202,104 @@ func (c *ClassificationEngine) Classify(
 			logSampled.Trace().Interface("controlPointID", cpID).Msg("No labelers for controlPointID")
 			continue
 		}
-		classifierMsgs = append(classifierMsgs, c.populateFlowLabels(ctx, flowLabels, mm, labelsForMatching, input)...)
+		classifierMsgs = append(classifierMsgs, c.populateFlowLabels(ctx, flowLabels, mm, labelsForMatching, input, celActivation)...)
 	}
 
 	return classifierMsgs, flowLabels, nil
 }
 
+// ResponseAttributes are the attributes of an upstream response available to response rules.
+type ResponseAttributes struct {
+	// Headers with lowercase names
+	Headers map[string]string
+	// StatusCode is empty if unknown
+	StatusCode string
+}
+
+// ClassifyResponse performs classification of the response of a flow and returns a map of flow labels.
+// LabelsForMatching are the labels of the flow to use for selector matching.
+func (c *ClassificationEngine) ClassifyResponse(
+	svcs []string,
+	ctrlPt selectors.ControlPoint,
+	labelsForMatching map[string]string,
+	response ResponseAttributes,
+) flowlabel.FlowLabels {
+	flowLabels := make(flowlabel.FlowLabels)
+
+	r, ok := c.activeRules.Load().(rules)
+	if !ok {
+		return flowLabels
+	}
+
+	populate := func(cpID selectors.ControlPointID) {
+		mm, ok := r.ResponseMultiMatcherByControlPointID[cpID]
+		if !ok {
+			return
+		}
+		for _, labelerWithSelector := range mm.Match(labelsForMatching) {
+			labeler := labelerWithSelector.Labeler
+			value := response.StatusCode
+			if labeler.Header != "" {
+				value = response.Headers[labeler.Header]
+			}
+			if value == "" {
+				continue
+			}
+			flowLabels[labeler.LabelName] = flowlabel.FlowLabelValue{
+				Value:     value,
+				Telemetry: true,
+			}
+		}
+	}
+
+	// Catch all Service
+	populate(selectors.NewControlPointID("", ctrlPt))
+	// Specific Service
+	for _, svc := range svcs {
+		populate(selectors.NewControlPointID(svc, ctrlPt))
+	}
+
+	return flowLabels
+}
+
+// HasResponseRules returns whether any response rules apply to the control point of the given services.
+func (c *ClassificationEngine) HasResponseRules(svcs []string, ctrlPt selectors.ControlPoint) bool {
+	r, ok := c.activeRules.Load().(rules)
+	if !ok {
+		return false
+	}
+	if _, ok := r.ResponseMultiMatcherByControlPointID[selectors.NewControlPointID("", ctrlPt)]; ok {
+		return true
+	}
+	for _, svc := range svcs {
+		if _, ok := r.ResponseMultiMatcherByControlPointID[selectors.NewControlPointID(svc, ctrlPt)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordRequestLabels keeps the flow labels of a request until the response of the flow is classified.
+//
+// Telemetry carries only the labels configured for it, so response rules and flux meters matched
+// after them would not see the other labels of the request otherwise.
+func (c *ClassificationEngine) RecordRequestLabels(flowID string, labels map[string]string) {
+	c.requestLabels.record(time.Now(), flowID, labels)
+}
+
+// TakeRequestLabels returns the flow labels recorded for the request of a flow and forgets them.
+// Returns nil if no labels were recorded.
+func (c *ClassificationEngine) TakeRequestLabels(flowID string) map[string]string {
+	return c.requestLabels.take(time.Now(), flowID)
+}
+
+// SetTelemetryHashKey sets the secret keying the hash telemetry transform of rulesets added afterwards.
+func (c *ClassificationEngine) SetTelemetryHashKey(key []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.telemetryHashKey = key
+}
+
 // ActiveRules returns a slice of uncompiled Rules which are currently active.
 func (c *ClassificationEngine) ActiveRules() []compiler.ReportedRule {
 	ac, _ := c.activeRules.Load().(rules)
@@ -181,7 +316,11 @@ func (c *ClassificationEngine) AddRules(
 	name string,
 	classifierWrapper *wrappersv1.ClassifierWrapper,
 ) (ActiveRuleset, error) {
-	compiledRuleset, err := compiler.CompileRuleset(ctx, name, classifierWrapper)
+	c.mu.Lock()
+	telemetryHashKey := c.telemetryHashKey
+	c.mu.Unlock()
+
+	compiledRuleset, err := compiler.CompileRuleset(ctx, name, classifierWrapper, telemetryHashKey)
 	if err != nil {
 		return ActiveRuleset{}, err
 	}
@@ -236,8 +375,9 @@ func (c *ClassificationEngine) activateRulesets() {
 
 func (c *ClassificationEngine) combineRulesets() rules {
 	combined := rules{
-		MultiMatcherByControlPointID: make(multiMatcherByControlPoint),
-		ReportedRules:                make([]compiler.ReportedRule, 0),
+		MultiMatcherByControlPointID:         make(multiMatcherByControlPoint),
+		ResponseMultiMatcherByControlPointID: make(responseMultiMatcherByControlPoint),
+		ReportedRules:                        make([]compiler.ReportedRule, 0),
 	}
 
 	// to have unique keys to AddEntry
@@ -262,6 +402,23 @@ func (c *ClassificationEngine) combineRulesets() rules {
 				return rules{}
 			}
 		}
+		for i := range ruleset.ResponseLabelers {
+			labelerWithSelector := &ruleset.ResponseLabelers[i]
+			mm, ok := combined.ResponseMultiMatcherByControlPointID[ruleset.ControlPointID]
+			if !ok {
+				mm = multimatcher.New[int, []*compiler.ResponseLabelerWithSelector]()
+				combined.ResponseMultiMatcherByControlPointID[ruleset.ControlPointID] = mm
+			}
+
+			matcherID := controlPointKeys[ruleset.ControlPointID]
+			controlPointKeys[ruleset.ControlPointID]++
+
+			err := mm.AddEntry(matcherID, labelerWithSelector.LabelSelector, multimatcher.Appender(labelerWithSelector))
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to add entry to response multimatcher")
+				return rules{}
+			}
+		}
 	}
 
 	return combined
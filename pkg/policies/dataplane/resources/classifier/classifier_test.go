@@ -2,10 +2,22 @@ package classifier_test
 
 import (
 	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/open-policy-agent/opa/ast"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	labelmatcherv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/common/labelmatcher/v1"
 	selectorv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/common/selector/v1"
@@ -472,6 +484,478 @@ var _ = Describe("Classifier", func() {
 		})
 	})
 
+	Context("configured with response rules", func() {
+		rs := &classificationv1.Classifier{
+			Selector: &selectorv1.Selector{
+				ServiceSelector: &selectorv1.ServiceSelector{
+					Service: "my-service.default.svc.cluster.local",
+				},
+				FlowSelector: &selectorv1.FlowSelector{
+					LabelMatcher: &labelmatcherv1.LabelMatcher{
+						MatchLabels: map[string]string{"version": "one"},
+					},
+					ControlPoint: &selectorv1.ControlPoint{
+						Controlpoint: &selectorv1.ControlPoint_Traffic{
+							Traffic: "ingress",
+						},
+					},
+				},
+			},
+			ResponseRules: map[string]*classificationv1.ResponseRule{
+				"cache":  {From: "response.http.headers.X-Cache"},
+				"status": {From: "response.http.status_code"},
+			},
+		}
+
+		BeforeEach(func() {
+			_, err := classifier.AddRules(context.TODO(), "one", &wrappersv1.ClassifierWrapper{
+				Classifier:       rs,
+				CommonAttributes: commonAttributes,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("classifies response by returning flow labels", func() {
+			labels := classifier.ClassifyResponse(
+				[]string{"my-service.default.svc.cluster.local"},
+				selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""),
+				map[string]string{"version": "one"},
+				ResponseAttributes{
+					StatusCode: "200",
+					Headers:    map[string]string{"x-cache": "HIT"},
+				},
+			)
+			Expect(labels).To(Equal(flowlabel.FlowLabels{
+				"cache":  fl("HIT"),
+				"status": fl("200"),
+			}))
+		})
+
+		It("skips attributes missing in the response", func() {
+			labels := classifier.ClassifyResponse(
+				[]string{"my-service.default.svc.cluster.local"},
+				selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""),
+				map[string]string{"version": "one"},
+				ResponseAttributes{StatusCode: "503"},
+			)
+			Expect(labels).To(Equal(flowlabel.FlowLabels{
+				"status": fl("503"),
+			}))
+		})
+
+		It("skips rules with non-matching labels", func() {
+			labels := classifier.ClassifyResponse(
+				[]string{"my-service.default.svc.cluster.local"},
+				selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""),
+				map[string]string{"version": "two"},
+				ResponseAttributes{StatusCode: "200"},
+			)
+			Expect(labels).To(BeEmpty())
+		})
+
+		It("doesn't classify requests", func() {
+			_, labels, err := classifier.Classify(
+				context.TODO(),
+				[]string{"my-service.default.svc.cluster.local"},
+				selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""),
+				map[string]string{"version": "one"},
+				attributesWithHeaders(object{"x-cache": "HIT"}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels).To(BeEmpty())
+		})
+	})
+
+	Context("configured with invalid response attribute", func() {
+		rs := &classificationv1.Classifier{
+			Selector: &selectorv1.Selector{
+				FlowSelector: &selectorv1.FlowSelector{
+					ControlPoint: &selectorv1.ControlPoint{
+						Controlpoint: &selectorv1.ControlPoint_Traffic{
+							Traffic: "ingress",
+						},
+					},
+				},
+			},
+			ResponseRules: map[string]*classificationv1.ResponseRule{
+				"cache": {From: "response.http.body"},
+			},
+		}
+
+		It("should reject the ruleset", func() {
+			_, err := classifier.AddRules(context.TODO(), "one", &wrappersv1.ClassifierWrapper{
+				Classifier:       rs,
+				CommonAttributes: commonAttributes,
+			})
+			Expect(err).To(MatchError(compiler.BadResponseAttribute))
+		})
+	})
+
+	Context("configured with cel rules", func() {
+		rules := map[string]*classificationv1.Rule{
+			"user": {
+				Source: &classificationv1.Rule_Cel{
+					Cel: `"x-user" in attributes.request.http.headers ? attributes.request.http.headers["x-user"] : attributes.request.http.headers["x-client"]`,
+				},
+				Telemetry: true,
+			},
+			"bar-twice": {
+				Source: &classificationv1.Rule_Cel{
+					Cel: `attributes.request.http.headers.bar * 2`,
+				},
+				Telemetry: false,
+			},
+		}
+
+		BeforeEach(func() {
+			Expect(setRulesForMyService(rules)).To(Succeed())
+		})
+
+		It("classifies input by returning flow labels", func() {
+			_, labels, err := classifier.Classify(
+				context.TODO(),
+				[]string{"my-service.default.svc.cluster.local"},
+				selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""),
+				nil,
+				attributesWithHeaders(object{
+					"x-user":   "alice",
+					"x-client": "mobile",
+					"bar":      21,
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels).To(Equal(flowlabel.FlowLabels{
+				"user":      fl("alice"),
+				"bar-twice": flowlabel.FlowLabelValue{Value: "42", Telemetry: false},
+			}))
+		})
+
+		It("falls back to the other header", func() {
+			_, labels, err := classifier.Classify(
+				context.TODO(),
+				[]string{"my-service.default.svc.cluster.local"},
+				selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""),
+				nil,
+				attributesWithHeaders(object{
+					"x-client": "mobile",
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels).To(Equal(flowlabel.FlowLabels{
+				"user": fl("mobile"),
+			}))
+		})
+
+		It("reports rules that failed to evaluate", func() {
+			classifierMsgs, labels, err := classifier.Classify(
+				context.TODO(),
+				[]string{"my-service.default.svc.cluster.local"},
+				selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""),
+				nil,
+				attributesWithHeaders(object{}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels).To(BeEmpty())
+			Expect(classifierMsgs).To(HaveLen(2))
+			for _, msg := range classifierMsgs {
+				Expect(msg.Error).To(Equal(flowcontrolv1.ClassifierInfo_ERROR_EVAL_FAILED))
+			}
+		})
+	})
+
+	Context("incorrect cel passed", func() {
+		rules := map[string]*classificationv1.Rule{
+			"user": {
+				Source: &classificationv1.Rule_Cel{
+					Cel: `attributes.request.http.headers[`,
+				},
+				Telemetry: true,
+			},
+		}
+
+		It("fails to compile cel", func() {
+			err := setRulesForMyService(rules)
+			Expect(err).To(MatchError(compiler.BadCEL))
+		})
+	})
+
+	Context("configured with label guards", func() {
+		rules := map[string]*classificationv1.Rule{
+			"user": {
+				Source:         headerExtractor("user"),
+				Telemetry:      true,
+				MaxCardinality: 2,
+			},
+			"session": {
+				Source: &classificationv1.Rule_Cel{
+					Cel: `attributes.request.http.headers.session`,
+				},
+				Telemetry:          true,
+				TelemetryTransform: "hash",
+			},
+			"email": {
+				Source:             headerExtractor("email"),
+				Telemetry:          true,
+				TelemetryTransform: "redact",
+			},
+		}
+
+		BeforeEach(func() {
+			Expect(setRulesForMyService(rules)).To(Succeed())
+		})
+
+		classify := func(headers object) flowlabel.FlowLabels {
+			_, labels, err := classifier.Classify(
+				context.TODO(),
+				[]string{"my-service.default.svc.cluster.local"},
+				selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""),
+				nil,
+				attributesWithHeaders(headers),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			return labels
+		}
+
+		It("replaces values over max cardinality", func() {
+			Expect(classify(object{"user": "alice"})["user"].Value).To(Equal("alice"))
+			Expect(classify(object{"user": "bob"})["user"].Value).To(Equal("bob"))
+			Expect(classify(object{"user": "carol"})["user"].Value).To(Equal(compiler.OverflowValue))
+			Expect(classify(object{"user": "alice"})["user"].Value).To(Equal("alice"))
+		})
+
+		It("transforms telemetry values, keeping the original ones", func() {
+			labels := classify(object{
+				"session": "secret-session",
+				"email":   "alice@example.com",
+			})
+			Expect(labels["session"].Value).To(Equal("secret-session"))
+			Expect(labels["session"].ValueForTelemetry()).To(HaveLen(16))
+			Expect(labels["session"].ValueForTelemetry()).NotTo(ContainSubstring("secret"))
+			Expect(labels["email"].Value).To(Equal("alice@example.com"))
+			Expect(labels["email"].ValueForTelemetry()).To(Equal(compiler.RedactedValue))
+			Expect(labels.ToTelemetryMap()).To(Equal(map[string]string{
+				"session": labels["session"].TelemetryValue,
+				"email":   compiler.RedactedValue,
+			}))
+		})
+
+		It("hashes values consistently", func() {
+			first := classify(object{"session": "secret-session"})
+			second := classify(object{"session": "secret-session"})
+			Expect(first["session"].TelemetryValue).To(Equal(second["session"].TelemetryValue))
+		})
+
+		It("keys the hash with the telemetry hash key", func() {
+			unkeyed := classify(object{"session": "secret-session"})["session"].TelemetryValue
+
+			classifier = NewClassificationEngine(status.NewRegistry(log.GetGlobalLogger()))
+			classifier.SetTelemetryHashKey([]byte("key"))
+			Expect(setRulesForMyService(rules)).To(Succeed())
+			keyed := classify(object{"session": "secret-session"})["session"].TelemetryValue
+			Expect(keyed).To(HaveLen(16))
+			Expect(keyed).NotTo(Equal(unkeyed))
+
+			classifier.SetTelemetryHashKey([]byte("other key"))
+			Expect(setRulesForMyService(rules)).To(Succeed())
+			Expect(classify(object{"session": "secret-session"})["session"].TelemetryValue).NotTo(Equal(keyed))
+		})
+	})
+
+	Context("configured with invalid telemetry transform", func() {
+		rules := map[string]*classificationv1.Rule{
+			"user": {
+				Source:             headerExtractor("user"),
+				Telemetry:          true,
+				TelemetryTransform: "encrypt",
+			},
+		}
+
+		It("should reject the ruleset", func() {
+			err := setRulesForMyService(rules)
+			Expect(err).To(MatchError(compiler.BadTelemetryTransform))
+		})
+	})
+
+	Context("configured with cookie, query and regex extractors", func() {
+		rules := map[string]*classificationv1.Rule{
+			"session": {
+				Source: &classificationv1.Rule_Extractor{
+					Extractor: &classificationv1.Extractor{
+						Variant: &classificationv1.Extractor_Cookie{
+							Cookie: &classificationv1.CookieExtractor{Name: "session"},
+						},
+					},
+				},
+				Telemetry: true,
+			},
+			"user": {
+				Source: &classificationv1.Rule_Extractor{
+					Extractor: &classificationv1.Extractor{
+						Variant: &classificationv1.Extractor_Query{
+							Query: &classificationv1.QueryExtractor{Name: "user"},
+						},
+					},
+				},
+				Telemetry: true,
+			},
+			"client": {
+				Source: &classificationv1.Rule_Extractor{
+					Extractor: &classificationv1.Extractor{
+						Variant: &classificationv1.Extractor_Regex{
+							Regex: &classificationv1.RegexExtractor{
+								From:         "request.http.headers.user-agent",
+								Regex:        "^([a-zA-Z]+)/",
+								CaptureGroup: 1,
+							},
+						},
+					},
+				},
+				Telemetry: true,
+			},
+		}
+
+		BeforeEach(func() {
+			Expect(setRulesForMyService(rules)).To(Succeed())
+		})
+
+		classify := func(path string, headers object) flowlabel.FlowLabels {
+			_, labels, err := classifier.Classify(
+				context.TODO(),
+				[]string{"my-service.default.svc.cluster.local"},
+				selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""),
+				nil,
+				ast.MustInterfaceToValue(object{
+					"attributes": object{
+						"request": object{
+							"http": object{
+								"path":    path,
+								"headers": headers,
+							},
+						},
+					},
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			return labels
+		}
+
+		It("classifies input by returning flow labels", func() {
+			labels := classify("/orders?user=alice%40example.com&user=bob", object{
+				"cookie":     "theme=dark; session=a=b; session=other",
+				"user-agent": "curl/7.81.0",
+			})
+			Expect(labels).To(Equal(flowlabel.FlowLabels{
+				"session": fl("a=b"),
+				"user":    fl("alice@example.com"),
+				"client":  fl("curl"),
+			}))
+		})
+
+		It("skips missing cookies, parameters and non-matching attributes", func() {
+			labels := classify("/orders", object{
+				"cookie":     "theme=dark",
+				"user-agent": "-",
+			})
+			Expect(labels).To(BeEmpty())
+		})
+	})
+
+	Context("configured with verified jwt extractor", func() {
+		var (
+			key      *rsa.PrivateKey
+			classify func(token string) ([]*flowcontrolv1.ClassifierInfo, flowlabel.FlowLabels)
+		)
+
+		BeforeEach(func() {
+			var err error
+			key, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			jwksFile := filepath.Join(GinkgoT().TempDir(), "jwks.json")
+			Expect(os.WriteFile(jwksFile, jwksFor(&key.PublicKey), 0o600)).To(Succeed())
+
+			rules := map[string]*classificationv1.Rule{
+				"user": {
+					Source: &classificationv1.Rule_Extractor{
+						Extractor: &classificationv1.Extractor{
+							Variant: &classificationv1.Extractor_Jwt{
+								Jwt: &classificationv1.JWTExtractor{
+									From:        "request.http.bearer",
+									JsonPointer: "/sub",
+									Verification: &classificationv1.JWTExtractor_Verification{
+										JwksFile:            jwksFile,
+										JwksRefreshInterval: durationpb.New(time.Minute),
+										Issuer:              "https://issuer.example.com",
+									},
+								},
+							},
+						},
+					},
+					Telemetry: true,
+				},
+			}
+			Expect(setRulesForMyService(rules)).To(Succeed())
+			// the keys are loaded in background, flows are not verified until then
+			validToken := signRS256(key, object{
+				"sub": "alice",
+				"iss": "https://issuer.example.com",
+			})
+			Eventually(func() flowlabel.FlowLabels {
+				_, labels := classify(validToken)
+				return labels
+			}).ShouldNot(BeEmpty())
+		})
+
+		classify = func(token string) ([]*flowcontrolv1.ClassifierInfo, flowlabel.FlowLabels) {
+			classifierMsgs, labels, err := classifier.Classify(
+				context.TODO(),
+				[]string{"my-service.default.svc.cluster.local"},
+				selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, ""),
+				nil,
+				attributesWithHeaders(object{
+					"authorization": "Bearer " + token,
+				}),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			return classifierMsgs, labels
+		}
+
+		It("classifies tokens with a valid signature", func() {
+			classifierMsgs, labels := classify(signRS256(key, object{
+				"sub": "alice",
+				"iss": "https://issuer.example.com",
+			}))
+			Expect(labels).To(Equal(flowlabel.FlowLabels{
+				"user": fl("alice"),
+			}))
+			for _, msg := range classifierMsgs {
+				Expect(msg.Error).To(Equal(flowcontrolv1.ClassifierInfo_ERROR_NONE))
+			}
+		})
+
+		It("reports tokens signed with another key", func() {
+			otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			classifierMsgs, labels := classify(signRS256(otherKey, object{
+				"sub": "alice",
+				"iss": "https://issuer.example.com",
+			}))
+			Expect(labels).To(BeEmpty())
+			Expect(classifierMsgs).To(ContainElement(HaveField("Error", flowcontrolv1.ClassifierInfo_ERROR_JWT_VERIFICATION_FAILED)))
+		})
+
+		It("reports tokens from another issuer", func() {
+			classifierMsgs, labels := classify(signRS256(key, object{
+				"sub": "alice",
+				"iss": "https://other.example.com",
+			}))
+			Expect(labels).To(BeEmpty())
+			Expect(classifierMsgs).To(ContainElement(And(
+				HaveField("LabelKey", "user"),
+				HaveField("Error", flowcontrolv1.ClassifierInfo_ERROR_JWT_VERIFICATION_FAILED),
+			)))
+		})
+	})
+
 	Context("configured with invalid label name", func() {
 		// Classifier with a simple extractor-based rule
 		rs := &classificationv1.Classifier{
@@ -535,3 +1019,100 @@ func headerExtractor(headerName string) *classificationv1.Rule_Extractor {
 		},
 	}
 }
+
+// jwksFor returns a JWKS document with the given public key.
+func jwksFor(key *rsa.PublicKey) []byte {
+	jwks, err := json.Marshal(object{
+		"keys": []object{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return jwks
+}
+
+// signRS256 returns a JWT with the given claims signed with the key.
+func signRS256(key *rsa.PrivateKey, claims object) string {
+	header, err := json.Marshal(object{"alg": "RS256", "typ": "JWT"})
+	Expect(err).NotTo(HaveOccurred())
+	payload, err := json.Marshal(claims)
+	Expect(err).NotTo(HaveOccurred())
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	Expect(err).NotTo(HaveOccurred())
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// benchmarkClassify measures classification of a flow with a single rule of the "header X if present, else header Y" kind.
+func benchmarkClassify(b *testing.B, rule *classificationv1.Rule) {
+	log.SetGlobalLevel(log.WarnLevel)
+	classifier := NewClassificationEngine(status.NewRegistry(log.GetGlobalLogger()))
+	_, err := classifier.AddRules(context.TODO(), "bench", &wrappersv1.ClassifierWrapper{
+		Classifier: &classificationv1.Classifier{
+			Selector: &selectorv1.Selector{
+				ServiceSelector: &selectorv1.ServiceSelector{
+					Service: "my-service.default.svc.cluster.local",
+				},
+				FlowSelector: &selectorv1.FlowSelector{
+					ControlPoint: &selectorv1.ControlPoint{
+						Controlpoint: &selectorv1.ControlPoint_Traffic{
+							Traffic: "ingress",
+						},
+					},
+				},
+			},
+			Rules: map[string]*classificationv1.Rule{"user": rule},
+		},
+		CommonAttributes: commonAttributes,
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	svcs := []string{"my-service.default.svc.cluster.local"}
+	ctrlPt := selectors.NewControlPoint(flowcontrolv1.ControlPointInfo_TYPE_INGRESS, "")
+	input := attributesWithHeaders(object{
+		"x-client":   "mobile",
+		"user-agent": "curl/7.79.1",
+		"accept":     "*/*",
+	})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, labels, err := classifier.Classify(context.TODO(), svcs, ctrlPt, nil, input)
+		if err != nil || labels["user"].Value != "mobile" {
+			b.Fatalf("unexpected classification: %v %v", labels, err)
+		}
+	}
+}
+
+func BenchmarkClassifyRego(b *testing.B) {
+	benchmarkClassify(b, &classificationv1.Rule{
+		Source: &classificationv1.Rule_Rego_{
+			Rego: &classificationv1.Rule_Rego{
+				Source: `
+					package my.pkg
+					headers := input.attributes.request.http.headers
+					default user = ""
+					user = headers["x-user"] { headers["x-user"] }
+					else = headers["x-client"]
+				`,
+				Query: "data.my.pkg.user",
+			},
+		},
+		Telemetry: true,
+	})
+}
+
+func BenchmarkClassifyCEL(b *testing.B) {
+	benchmarkClassify(b, &classificationv1.Rule{
+		Source: &classificationv1.Rule_Cel{
+			Cel: `"x-user" in attributes.request.http.headers ? attributes.request.http.headers["x-user"] : attributes.request.http.headers["x-client"]`,
+		},
+		Telemetry: true,
+	})
+}
@@ -0,0 +1,142 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/interpreter"
+	"github.com/open-policy-agent/opa/ast"
+)
+
+// celInputVariables are the top-level fields of the classifier input available to CEL expressions.
+var celInputVariables = []string{
+	"attributes",
+	"parsed_path",
+	"parsed_query",
+	"parsed_body",
+	"truncated_body",
+	"version",
+}
+
+// newCELEnv creates the environment CEL rules of a ruleset are compiled in.
+func newCELEnv() (*cel.Env, error) {
+	opts := make([]cel.EnvOption, 0, len(celInputVariables))
+	for _, variable := range celInputVariables {
+		opts = append(opts, cel.Variable(variable, cel.DynType))
+	}
+	return cel.NewEnv(opts...)
+}
+
+// compileCEL compiles a CEL expression to a program returning the flow label value.
+func compileCEL(env *cel.Env, expression string) (cel.Program, error) {
+	checked, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %v", BadCEL, issues.Err())
+	}
+	program, err := env.Program(checked, cel.EvalOptions(cel.OptOptimize))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", BadCEL, err)
+	}
+	return program, nil
+}
+
+// CELValueToString converts the result of a CEL rule to a flow label value.
+func CELValueToString(val ref.Val) (string, error) {
+	str := val.ConvertToType(types.StringType)
+	if types.IsError(str) {
+		return "", fmt.Errorf("cannot convert %s to string: %v", val.Type().TypeName(), str)
+	}
+	s, ok := str.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("cannot convert %s to string", val.Type().TypeName())
+	}
+	return s, nil
+}
+
+// CELActivation exposes the classifier input to CEL programs.
+//
+// Input fields are converted on first use, so the conversion is shared by
+// all the CEL rules evaluated for a flow and fields not referenced are not converted at all.
+type CELActivation struct {
+	input     ast.Value
+	variables map[string]interface{}
+}
+
+// Make sure CELActivation implements interpreter.Activation.
+var _ interpreter.Activation = (*CELActivation)(nil)
+
+// NewCELActivation creates an activation for the given classifier input.
+func NewCELActivation(input ast.Value) *CELActivation {
+	return &CELActivation{
+		input: input,
+	}
+}
+
+// ResolveName implements interpreter.Activation.
+func (a *CELActivation) ResolveName(name string) (interface{}, bool) {
+	if value, ok := a.variables[name]; ok {
+		return value, value != nil
+	}
+	obj, ok := a.input.(ast.Object)
+	if !ok {
+		return nil, false
+	}
+	if a.variables == nil {
+		a.variables = make(map[string]interface{}, len(celInputVariables))
+	}
+	term := obj.Get(ast.StringTerm(name))
+	if term == nil {
+		a.variables[name] = nil
+		return nil, false
+	}
+	value := astValueToNative(term.Value)
+	a.variables[name] = value
+	return value, value != nil
+}
+
+// astValueToNative converts a rego value to a value CEL can adapt, numbers become int64 if integral, float64 otherwise.
+func astValueToNative(v ast.Value) interface{} {
+	switch v := v.(type) {
+	case ast.Null:
+		return nil
+	case ast.Boolean:
+		return bool(v)
+	case ast.String:
+		return string(v)
+	case ast.Number:
+		if i, ok := v.Int64(); ok {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case *ast.Array:
+		arr := make([]interface{}, 0, v.Len())
+		v.Foreach(func(term *ast.Term) {
+			arr = append(arr, astValueToNative(term.Value))
+		})
+		return arr
+	case ast.Set:
+		arr := make([]interface{}, 0, v.Len())
+		v.Foreach(func(term *ast.Term) {
+			arr = append(arr, astValueToNative(term.Value))
+		})
+		return arr
+	case ast.Object:
+		obj := make(map[string]interface{}, v.Len())
+		v.Foreach(func(key, value *ast.Term) {
+			if k, ok := key.Value.(ast.String); ok {
+				obj[string(k)] = astValueToNative(value.Value)
+			}
+		})
+		return obj
+	default:
+		return nil
+	}
+}
+
+// Parent implements interpreter.Activation.
+func (a *CELActivation) Parent() interpreter.Activation {
+	return nil
+}
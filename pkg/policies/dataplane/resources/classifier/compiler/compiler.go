@@ -12,6 +12,7 @@ import (
 	"github.com/fluxninja/aperture/pkg/multimatcher"
 	"github.com/fluxninja/aperture/pkg/policies/dataplane/resources/classifier/extractors"
 	"github.com/fluxninja/aperture/pkg/policies/dataplane/selectors"
+	"github.com/google/cel-go/cel"
 	"github.com/open-policy-agent/opa/rego"
 )
 
@@ -19,9 +20,10 @@ const defaultPackageName = "fluxninja.classification.extractors"
 
 // CompiledRuleset is compiled form of Classifier proto.
 type CompiledRuleset struct {
-	ControlPointID selectors.ControlPointID
-	Labelers       []LabelerWithSelector
-	ReportedRules  []ReportedRule
+	ControlPointID   selectors.ControlPointID
+	Labelers         []LabelerWithSelector
+	ResponseLabelers []ResponseLabelerWithSelector
+	ReportedRules    []ReportedRule
 }
 
 // LabelerWithSelector is a labeler with its selector.
@@ -41,11 +43,31 @@ type Labeler struct {
 	// Result expression should be a single value (if LabelName is set) or a
 	// map[string]interface{} otherwise.
 	Query rego.PreparedEvalQuery
+	// CEL program evaluated instead of Query, if set (single-label variant only)
+	Program cel.Program
 	// flags for created flow labels:
 	LabelsTelemetry map[string]bool // multi-label variant
+	// guards of created flow labels, nil if not needed:
+	LabelsGuards map[string]*LabelGuard // multi-label variant
 	// flow label that the result should be assigned to (single-label variant)
 	LabelName string
-	Telemetry bool // single-label variant
+	Telemetry bool        // single-label variant
+	Guard     *LabelGuard // single-label variant
+}
+
+// ResponseLabelerWithSelector is a response labeler with its selector.
+type ResponseLabelerWithSelector struct {
+	Labeler          *ResponseLabeler
+	LabelSelector    multimatcher.Expr
+	CommonAttributes *wrappersv1.CommonAttributes
+}
+
+// ResponseLabeler is used to create a flow label from an attribute of the response.
+type ResponseLabeler struct {
+	// flow label that the attribute should be assigned to
+	LabelName string
+	// lowercase name of the response header to read, empty to read the status code
+	Header string
 }
 
 // ReportedRule is a rule along with its selector and label name.
@@ -79,6 +101,20 @@ type badRego struct{}
 
 func (b badRego) Error() string { return "failed to compile rego" }
 
+// BadCEL is an error occurring when CEL compilation fails.
+var BadCEL = badCEL{}
+
+type badCEL struct{}
+
+func (b badCEL) Error() string { return "failed to compile cel" }
+
+// BadTelemetryTransform is an error occurring when rule refers to an unknown telemetry transform.
+var BadTelemetryTransform = badTelemetryTransform{}
+
+type badTelemetryTransform struct{}
+
+func (b badTelemetryTransform) Error() string { return "invalid telemetry transform" }
+
 // BadSelector is an error occurring when selector is invalid.
 var BadSelector = badSelector{}
 
@@ -89,8 +125,22 @@ func (b badSelector) Error() string { return "invalid ruleset selector" }
 // BadLabelName is an error occurring when label name is invalid.
 var BadLabelName = extractors.BadLabelName
 
+// BadResponseAttribute is an error occurring when response rule refers to an unknown attribute.
+var BadResponseAttribute = badResponseAttribute{}
+
+type badResponseAttribute struct{}
+
+func (b badResponseAttribute) Error() string { return "invalid response attribute" }
+
+const (
+	responseStatusCodeAttribute    = "response.http.status_code"
+	responseHeadersAttributePrefix = "response.http.headers."
+)
+
 // CompileRuleset parses ruleset's selector and compiles its rules.
-func CompileRuleset(ctx context.Context, name string, classifierWrapper *wrappersv1.ClassifierWrapper) (CompiledRuleset, error) {
+//
+// TelemetryHashKey is the secret keying the hash telemetry transform of flow labels.
+func CompileRuleset(ctx context.Context, name string, classifierWrapper *wrappersv1.ClassifierWrapper, telemetryHashKey []byte) (CompiledRuleset, error) {
 	classifierMsg := classifierWrapper.GetClassifier()
 	if classifierMsg.Selector == nil {
 		return CompiledRuleset{}, fmt.Errorf("%w: missing selector", BadSelector)
@@ -101,15 +151,21 @@ func CompileRuleset(ctx context.Context, name string, classifierWrapper *wrapper
 		return CompiledRuleset{}, fmt.Errorf("%w: %v", BadSelector, err)
 	}
 
-	labelers, err := compileRules(ctx, selector.LabelMatcher(), classifierWrapper)
+	labelers, err := compileRules(ctx, selector.LabelMatcher(), classifierWrapper, telemetryHashKey)
 	if err != nil {
 		return CompiledRuleset{}, fmt.Errorf("failed to compile %q rules for %v: %w", name, selector, err)
 	}
 
+	responseLabelers, err := compileResponseRules(selector.LabelMatcher(), classifierWrapper)
+	if err != nil {
+		return CompiledRuleset{}, fmt.Errorf("failed to compile %q response rules for %v: %w", name, selector, err)
+	}
+
 	cr := CompiledRuleset{
-		ControlPointID: selector.ControlPointID(),
-		Labelers:       labelers,
-		ReportedRules:  rulesetToReportedRules(classifierMsg, name),
+		ControlPointID:   selector.ControlPointID(),
+		Labelers:         labelers,
+		ResponseLabelers: responseLabelers,
+		ReportedRules:    rulesetToReportedRules(classifierMsg, name),
 	}
 
 	return cr, nil
@@ -118,8 +174,9 @@ func CompileRuleset(ctx context.Context, name string, classifierWrapper *wrapper
 // compileRules compiles a set of rules into set of rego queries
 //
 // Raw rego rules are compiled 1:1 to rego queries. High-level extractor-based
-// rules are compiled into a single rego query.
-func compileRules(ctx context.Context, labelSelector multimatcher.Expr, classifierWrapper *wrappersv1.ClassifierWrapper) ([]LabelerWithSelector, error) {
+// rules are compiled into a single rego query. CEL rules are compiled 1:1 to
+// CEL programs sharing the environment of the ruleset.
+func compileRules(ctx context.Context, labelSelector multimatcher.Expr, classifierWrapper *wrappersv1.ClassifierWrapper, telemetryHashKey []byte) ([]LabelerWithSelector, error) {
 	log.Trace().Msg("Classifier.compileRules starting")
 
 	commonAttributes := classifierWrapper.GetCommonAttributes()
@@ -133,9 +190,13 @@ func compileRules(ctx context.Context, labelSelector multimatcher.Expr, classifi
 	// single rego query
 	labelExtractors := map[string]*classificationv1.Extractor{}
 	labelsTelemetry := map[string]bool{} // Telemetry flag for labels created by extractors
+	labelsGuards := map[string]*LabelGuard{}
 
 	rawRegoCount := 0
+	celCount := 0
 	var labelers []LabelerWithSelector
+	// created on first CEL rule
+	var celEnv *cel.Env
 
 	for labelName, rule := range labelRules {
 		if strings.Contains(labelName, "/") {
@@ -147,10 +208,18 @@ func compileRules(ctx context.Context, labelSelector multimatcher.Expr, classifi
 			return nil, fmt.Errorf("%w: cannot contain '/'", BadLabelName)
 		}
 
+		guard, err := newLabelGuard(labelName, rule, telemetryHashKey)
+		if err != nil {
+			return nil, err
+		}
+
 		switch source := rule.GetSource().(type) {
 		case *classificationv1.Rule_Extractor:
 			labelExtractors[labelName] = source.Extractor
 			labelsTelemetry[labelName] = rule.GetTelemetry()
+			if guard != nil {
+				labelsGuards[labelName] = guard
+			}
 		case *classificationv1.Rule_Rego_:
 			query, err := rego.New(
 				rego.Query(source.Rego.Query),
@@ -173,10 +242,35 @@ func compileRules(ctx context.Context, labelSelector multimatcher.Expr, classifi
 					Query:     query,
 					LabelName: labelName,
 					Telemetry: rule.GetTelemetry(),
+					Guard:     guard,
 				},
 				CommonAttributes: commonAttributes,
 			})
 			rawRegoCount++
+		case *classificationv1.Rule_Cel:
+			if celEnv == nil {
+				var err error
+				celEnv, err = newCELEnv()
+				if err != nil {
+					return nil, fmt.Errorf("(bug) failed to create cel environment: %w", err)
+				}
+			}
+			program, err := compileCEL(celEnv, source.Cel)
+			if err != nil {
+				log.Trace().Str("expression", source.Cel).Msg("Failed to compile cel")
+				return nil, fmt.Errorf("failed to compile cel expression, label: %s: %w", labelName, err)
+			}
+			labelers = append(labelers, LabelerWithSelector{
+				LabelSelector: labelSelector,
+				Labeler: &Labeler{
+					Program:   program,
+					LabelName: labelName,
+					Telemetry: rule.GetTelemetry(),
+					Guard:     guard,
+				},
+				CommonAttributes: commonAttributes,
+			})
+			celCount++
 		}
 	}
 
@@ -188,6 +282,7 @@ func compileRules(ctx context.Context, labelSelector multimatcher.Expr, classifi
 		query, err := rego.New(
 			rego.Query("data."+defaultPackageName),
 			rego.Module("tmp.rego", regoSrc),
+			extractors.JWKSBuiltin,
 		).PrepareForEval(ctx)
 		if err != nil {
 			// Note: Not wrapping BadRego error here – the rego returned by
@@ -196,12 +291,15 @@ func compileRules(ctx context.Context, labelSelector multimatcher.Expr, classifi
 			log.Trace().Str("src", regoSrc).Msg("Failed to prepare for eval")
 			return nil, fmt.Errorf("(bug) failed to compile classification rules: %w", err)
 		}
+		// load the keys ahead of the first flow, evaluation does not wait for them
+		extractors.PrefetchJWKS(labelExtractors)
 
 		labelers = append(labelers, LabelerWithSelector{
 			LabelSelector: labelSelector,
 			Labeler: &Labeler{
 				Query:           query,
 				LabelsTelemetry: labelsTelemetry,
+				LabelsGuards:    labelsGuards,
 			},
 			CommonAttributes: commonAttributes,
 		})
@@ -210,8 +308,43 @@ func compileRules(ctx context.Context, labelSelector multimatcher.Expr, classifi
 	log.Info().
 		Int("modules", len(labelers)).
 		Int("raw rego modules", rawRegoCount).
+		Int("cel programs", celCount).
 		Int("extractors", len(labelExtractors)).
 		Msg("Compilation of rules finished")
 
 	return labelers, nil
 }
+
+// compileResponseRules parses the attribute paths of response rules.
+func compileResponseRules(labelSelector multimatcher.Expr, classifierWrapper *wrappersv1.ClassifierWrapper) ([]ResponseLabelerWithSelector, error) {
+	commonAttributes := classifierWrapper.GetCommonAttributes()
+	if commonAttributes == nil {
+		return nil, fmt.Errorf("commonAttributes is nil")
+	}
+
+	var labelers []ResponseLabelerWithSelector
+	for labelName, rule := range classifierWrapper.GetClassifier().GetResponseRules() {
+		if strings.Contains(labelName, "/") {
+			return nil, fmt.Errorf("%w: cannot contain '/'", BadLabelName)
+		}
+
+		labeler := &ResponseLabeler{
+			LabelName: labelName,
+		}
+		from := rule.GetFrom()
+		switch {
+		case from == responseStatusCodeAttribute:
+		case strings.HasPrefix(from, responseHeadersAttributePrefix) && len(from) > len(responseHeadersAttributePrefix):
+			labeler.Header = strings.ToLower(strings.TrimPrefix(from, responseHeadersAttributePrefix))
+		default:
+			return nil, fmt.Errorf("%w: %q, label: %s", BadResponseAttribute, from, labelName)
+		}
+
+		labelers = append(labelers, ResponseLabelerWithSelector{
+			Labeler:          labeler,
+			LabelSelector:    labelSelector,
+			CommonAttributes: commonAttributes,
+		})
+	}
+	return labelers, nil
+}
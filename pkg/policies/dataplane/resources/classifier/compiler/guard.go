@@ -0,0 +1,139 @@
+package compiler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	classificationv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/policies/dataplane/flowlabel"
+)
+
+const (
+	// OverflowValue replaces values of a flow label seen after its max cardinality is reached.
+	OverflowValue = "__overflow__"
+	// RedactedValue replaces values of a flow label in telemetry when the redact transform is used.
+	RedactedValue = "redacted"
+)
+
+const (
+	telemetryTransformNone   = "none"
+	telemetryTransformHash   = "hash"
+	telemetryTransformRedact = "redact"
+)
+
+// length of the hex-encoded hash of values when the hash transform is used
+const hashedValueLength = 16
+
+// LabelGuard limits the cardinality of a flow label and transforms its values for telemetry.
+type LabelGuard struct {
+	values             map[string]struct{}
+	labelName          string
+	telemetryTransform string
+	hashKey            []byte
+	lock               sync.RWMutex
+	maxCardinality     int
+	overflowReported   atomic.Bool
+}
+
+// newLabelGuard creates a guard for the rule, nil if the rule doesn't need one.
+//
+// HashKey is the secret keying the hash transform, so that hashed values cannot be matched against hashes of guessed values.
+func newLabelGuard(labelName string, rule *classificationv1.Rule, hashKey []byte) (*LabelGuard, error) {
+	telemetryTransform := rule.GetTelemetryTransform()
+	switch telemetryTransform {
+	case "", telemetryTransformNone:
+		telemetryTransform = ""
+	case telemetryTransformHash, telemetryTransformRedact:
+	default:
+		return nil, fmt.Errorf("%w: %q, label: %s", BadTelemetryTransform, telemetryTransform, labelName)
+	}
+	if rule.GetMaxCardinality() == 0 && telemetryTransform == "" {
+		return nil, nil
+	}
+	guard := &LabelGuard{
+		labelName:          labelName,
+		telemetryTransform: telemetryTransform,
+		hashKey:            hashKey,
+		maxCardinality:     int(rule.GetMaxCardinality()),
+	}
+	if guard.maxCardinality > 0 {
+		guard.values = make(map[string]struct{}, guard.maxCardinality)
+	}
+	return guard, nil
+}
+
+// FlowLabelValue creates the flow label value for value produced by the rule.
+//
+// Guard can be nil, in which case the value is used as is.
+func (g *LabelGuard) FlowLabelValue(value string, telemetry bool) flowlabel.FlowLabelValue {
+	if g == nil {
+		return flowlabel.FlowLabelValue{
+			Value:     value,
+			Telemetry: telemetry,
+		}
+	}
+	value = g.limit(value)
+	return flowlabel.FlowLabelValue{
+		Value:          value,
+		TelemetryValue: g.transform(value),
+		Telemetry:      telemetry,
+	}
+}
+
+// limit returns the value, or OverflowValue if it's a new value and the max cardinality was reached.
+func (g *LabelGuard) limit(value string) string {
+	if g.maxCardinality == 0 {
+		return value
+	}
+	g.lock.RLock()
+	_, seen := g.values[value]
+	full := len(g.values) >= g.maxCardinality
+	g.lock.RUnlock()
+	if seen {
+		return value
+	}
+	if full {
+		g.reportOverflow()
+		return OverflowValue
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if _, seen := g.values[value]; seen {
+		return value
+	}
+	if len(g.values) >= g.maxCardinality {
+		g.reportOverflow()
+		return OverflowValue
+	}
+	g.values[value] = struct{}{}
+	return value
+}
+
+// reportOverflow logs the first overflow of the label.
+func (g *LabelGuard) reportOverflow() {
+	if !g.overflowReported.CompareAndSwap(false, true) {
+		return
+	}
+	log.Warn().Str("label", g.labelName).Int("maxCardinality", g.maxCardinality).
+		Msg("Flow label reached max cardinality, new values are replaced by " + OverflowValue)
+}
+
+// transform returns the value to be used in telemetry, empty if value should be used as is.
+func (g *LabelGuard) transform(value string) string {
+	switch g.telemetryTransform {
+	case telemetryTransformHash:
+		mac := hmac.New(sha256.New, g.hashKey)
+		mac.Write([]byte(value))
+		return hex.EncodeToString(mac.Sum(nil))[:hashedValueLength]
+	case telemetryTransformRedact:
+		return RedactedValue
+	default:
+		return ""
+	}
+}
@@ -3,6 +3,7 @@ package extractors
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -48,6 +49,12 @@ func CompileToRego(
 	if needs.Bearer {
 		out.WriteString(bearer)
 	}
+	if needs.Cookies {
+		out.WriteString(cookies)
+	}
+	if needs.Query {
+		out.WriteString(query)
+	}
 	return out.String(), nil
 }
 
@@ -72,9 +79,20 @@ func (b badPackageName) Error() string { return "invalid package name" }
 
 type badPackageName struct{}
 
+// HelperPrefix prefixes the names of helper rules, which are not flow labels.
+const HelperPrefix = "_ninja_"
+
+// JWTUnverifiedKey is the name of the helper rule containing the labels of JWT extractors whose token failed verification.
+const JWTUnverifiedKey = HelperPrefix + "jwt_unverified"
+
+// jwtPayloadPrefix prefixes the names of helper rules holding verified JWT payloads.
+const jwtPayloadPrefix = HelperPrefix + "jwt_payload_"
+
 type needs struct {
 	Segments bool
 	Bearer   bool
+	Cookies  bool
+	Query    bool
 }
 
 const segments = `
@@ -91,6 +109,14 @@ _ninja_bearer := value {
 }
 `
 
+const cookies = `
+_ninja_cookies := [trim_space(cookie) | cookie := split(input.attributes.request.http.headers.cookie, ";")[_]]
+`
+
+const query = `
+_ninja_query := urlquery.decode_object(split(input.attributes.request.http.path, "?")[1])
+`
+
 func emitExtractor(key string, e *classificationv1.Extractor, needs *needs) (string, error) {
 	if e.GetVariant() == nil {
 		return "", errors.New("no variant set")
@@ -128,6 +154,9 @@ func emitExtractor(key string, e *classificationv1.Extractor, needs *needs) (str
 		if err != nil {
 			return "", err
 		}
+		if verification := variant.Jwt.GetVerification(); verification != nil {
+			return compileVerifiedJWT(key, renderAttributePath(from, needs), pointer, verification)
+		}
 		out := strings.Builder{}
 		fmt.Fprintf(&out, "%s := payload%s {\n", key, renderJSONPointer(pointer))
 		fmt.Fprintf(
@@ -154,11 +183,94 @@ func emitExtractor(key string, e *classificationv1.Extractor, needs *needs) (str
 
 	case *classificationv1.Extractor_PathTemplates:
 		return compilePathTemplates(key, variant.PathTemplates, needs)
+
+	case *classificationv1.Extractor_Cookie:
+		name := variant.Cookie.Name
+		if name == "" || strings.ContainsAny(name, ";= ") {
+			return "", fmt.Errorf("invalid cookie name %q", name)
+		}
+		needs.Cookies = true
+		prefix := name + "="
+		out := strings.Builder{}
+		fmt.Fprintf(&out, "%s := values[0] {\n", key)
+		fmt.Fprintf(&out, "  values := [substring(cookie, %d, -1) | cookie := _ninja_cookies[_]; startswith(cookie, %q)]\n", len(prefix), prefix)
+		fmt.Fprintf(&out, "}\n")
+		return out.String(), nil
+
+	case *classificationv1.Extractor_Query:
+		if variant.Query.Name == "" {
+			return "", errors.New("missing query parameter name")
+		}
+		needs.Query = true
+		return fmt.Sprintf("%s := _ninja_query[%q][0]\n", key, variant.Query.Name), nil
+
+	case *classificationv1.Extractor_Regex:
+		from := ParseAttributePath(variant.Regex.From)
+		if err := from.validate(); err != nil {
+			return "", err
+		}
+		re, err := regexp.Compile(variant.Regex.Regex)
+		if err != nil {
+			return "", err
+		}
+		group := int(variant.Regex.CaptureGroup)
+		if group < 0 || group > re.NumSubexp() {
+			return "", fmt.Errorf("capture group %d out of range, regex has %d groups", group, re.NumSubexp())
+		}
+		out := strings.Builder{}
+		fmt.Fprintf(&out, "%s := match[%d] {\n", key, group)
+		fmt.Fprintf(
+			&out,
+			"  match := regex.find_all_string_submatch_n(%q, %s, 1)[0]\n",
+			variant.Regex.Regex,
+			renderAttributePath(from, needs),
+		)
+		fmt.Fprintf(&out, "}\n")
+		return out.String(), nil
 	default:
 		return "", errors.New("unsupported extractor variant")
 	}
 }
 
+// compileVerifiedJWT compiles a JWT extractor verifying the signature and claims of the token.
+//
+// The verified payload is kept in a helper rule, so that tokens failing verification
+// can be told apart from payloads missing the field the pointer points to.
+func compileVerifiedJWT(
+	key string,
+	token string,
+	pointer JSONPointer,
+	verification *classificationv1.JWTExtractor_Verification,
+) (string, error) {
+	source, err := jwksSource(verification)
+	if err != nil {
+		return "", err
+	}
+	refreshInterval := verification.GetJwksRefreshInterval().AsDuration()
+
+	constraints := fmt.Sprintf(`{"cert": %s(%q, %d)`, JWKSBuiltinName, source, int64(refreshInterval.Seconds()))
+	if issuer := verification.GetIssuer(); issuer != "" {
+		constraints += fmt.Sprintf(`, "iss": %q`, issuer)
+	}
+	if audience := verification.GetAudience(); audience != "" {
+		constraints += fmt.Sprintf(`, "aud": %q`, audience)
+	}
+	constraints += "}"
+
+	payloadRule := jwtPayloadPrefix + key
+	out := strings.Builder{}
+	fmt.Fprintf(&out, "%s := payload {\n", payloadRule)
+	fmt.Fprintf(&out, "  [valid, _, payload] := io.jwt.decode_verify(%s, %s)\n", token, constraints)
+	fmt.Fprintf(&out, "  valid\n")
+	fmt.Fprintf(&out, "}\n")
+	fmt.Fprintf(&out, "%s := %s%s\n", key, payloadRule, renderJSONPointer(pointer))
+	fmt.Fprintf(&out, "%s[%q] {\n", JWTUnverifiedKey, key)
+	fmt.Fprintf(&out, "  %s\n", token)
+	fmt.Fprintf(&out, "  not %s\n", payloadRule)
+	fmt.Fprintf(&out, "}\n")
+	return out.String(), nil
+}
+
 func renderAttributePath(path AttributePath, needs *needs) string {
 	if path.isBearer() {
 		needs.Bearer = true
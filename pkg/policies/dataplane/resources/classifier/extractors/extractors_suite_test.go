@@ -172,6 +172,106 @@ var _ = Describe("Extractor", func() {
 		)
 	})
 
+	It("compiles jwt extractor with verification", func() {
+		checkOk(
+			`
+      labels:
+        user:
+          jwt:
+            from: request.http.bearer
+            json_pointer: /sub
+            verification:
+              jwks_url: https://example.com/.well-known/jwks.json
+              issuer: https://example.com
+			`,
+			`
+			_ninja_jwt_payload_user := payload {
+			  [valid, _, payload] := io.jwt.decode_verify(_ninja_bearer, {"cert": aperture.jwks("https://example.com/.well-known/jwks.json", 300), "iss": "https://example.com"})
+			  valid
+			}
+			user := _ninja_jwt_payload_user.sub
+			_ninja_jwt_unverified["user"] {
+			  _ninja_bearer
+			  not _ninja_jwt_payload_user
+			}
+
+			_ninja_bearer := value {
+				header := input.attributes.request.http.headers.authorization
+				startswith(header, "Bearer ")
+				value := substring(header, count("Bearer "), -1)
+			}
+			`,
+		)
+	})
+
+	It("compiles cookie extractor", func() {
+		checkOk(
+			`
+      labels:
+        session:
+          cookie:
+            name: session
+			`,
+			`
+			session := values[0] {
+			  values := [substring(cookie, 8, -1) | cookie := _ninja_cookies[_]; startswith(cookie, "session=")]
+			}
+
+			_ninja_cookies := [trim_space(cookie) | cookie := split(input.attributes.request.http.headers.cookie, ";")[_]]
+			`,
+		)
+	})
+
+	It("compiles query extractor", func() {
+		checkOk(
+			`
+      labels:
+        user:
+          query:
+            name: user-id
+			`,
+			`
+			user := _ninja_query["user-id"][0]
+
+			_ninja_query := urlquery.decode_object(split(input.attributes.request.http.path, "?")[1])
+			`,
+		)
+	})
+
+	It("compiles regex extractor", func() {
+		checkOk(
+			`
+      labels:
+        client:
+          regex:
+            from: request.http.headers.user-agent
+            regex: ^([a-zA-Z]+)/([0-9.]+)
+            capture_group: 2
+			`,
+			`
+			client := match[2] {
+			  match := regex.find_all_string_submatch_n("^([a-zA-Z]+)/([0-9.]+)", input.attributes.request.http.headers["user-agent"], 1)[0]
+			}
+			`,
+		)
+	})
+
+	It("rejects regex extractor with capture group out of range", func() {
+		labelExtractors := map[string]*classificationv1.Extractor{
+			"client": {
+				Variant: &classificationv1.Extractor_Regex{
+					Regex: &classificationv1.RegexExtractor{
+						From:         "request.http.headers.user-agent",
+						Regex:        "^([a-zA-Z]+)/",
+						CaptureGroup: 2,
+					},
+				},
+			},
+		}
+		_, err := extractors.CompileToRego("pkgname", labelExtractors)
+		Expect(err).To(MatchError(extractors.BadExtractor))
+	})
+
 	Context("path templates extractor", func() {
 		It("parses and compiles", func() {
 			checkOk(
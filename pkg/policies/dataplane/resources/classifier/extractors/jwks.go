@@ -0,0 +1,224 @@
+package extractors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/open-policy-agent/opa/types"
+	"github.com/rs/zerolog"
+
+	classificationv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/language/v1"
+	"github.com/fluxninja/aperture/pkg/log"
+	"github.com/fluxninja/aperture/pkg/panichandler"
+)
+
+// JWKSBuiltinName is the name of the rego builtin returning the JWKS document of a source.
+//
+// Usage: `aperture.jwks(source, refresh_interval_seconds)`, where source is an HTTP(S) URL or "file://" followed by a path.
+const JWKSBuiltinName = "aperture.jwks"
+
+const jwksFileScheme = "file://"
+
+const (
+	jwksFetchTimeout = 10 * time.Second
+	// failed loads are retried at most this often
+	jwksRetryInterval = 10 * time.Second
+	// documents larger than this are rejected
+	jwksMaxSize = 1 << 20
+)
+
+// JWKSBuiltin is the rego option registering the JWKS builtin, to be passed to rego.New of queries using compiled extractors.
+var JWKSBuiltin = rego.Function2(
+	&rego.Function{
+		Name: JWKSBuiltinName,
+		Decl: types.NewFunction(types.Args(types.S, types.N), types.S),
+		// one load per query evaluation
+		Memoize: true,
+	},
+	func(bctx rego.BuiltinContext, sourceTerm, refreshTerm *ast.Term) (*ast.Term, error) {
+		source, ok := sourceTerm.Value.(ast.String)
+		if !ok {
+			return nil, errors.New("jwks source must be a string")
+		}
+		refreshNumber, ok := refreshTerm.Value.(ast.Number)
+		if !ok {
+			return nil, errors.New("jwks refresh interval must be a number")
+		}
+		refreshSeconds, ok := refreshNumber.Int64()
+		if !ok {
+			return nil, errors.New("jwks refresh interval must be an integer")
+		}
+		jwks, err := defaultJWKSCache.get(string(source), time.Duration(refreshSeconds)*time.Second)
+		if err != nil {
+			// undefined, so that the token is reported as unverified
+			log.Sample(zerolog.Sometimes).Warn().Err(err).Str("source", string(source)).Msg("JWKS not available")
+			return nil, nil
+		}
+		return ast.StringTerm(jwks), nil
+	},
+)
+
+// PrefetchJWKS starts loading the JWKS documents used by the verified JWT extractors in background,
+// so that they are available by the time the extractors are evaluated.
+func PrefetchJWKS(labelExtractors map[string]*classificationv1.Extractor) {
+	for _, extractor := range labelExtractors {
+		verification := extractor.GetJwt().GetVerification()
+		if verification == nil {
+			continue
+		}
+		source, err := jwksSource(verification)
+		if err != nil {
+			continue
+		}
+		defaultJWKSCache.prefetch(source)
+	}
+}
+
+// jwksSource returns the source of the JWKS document of the verification.
+func jwksSource(verification *classificationv1.JWTExtractor_Verification) (string, error) {
+	if source := verification.GetJwksUrl(); source != "" {
+		return source, nil
+	}
+	if verification.GetJwksFile() == "" {
+		return "", errors.New("missing jwks source")
+	}
+	return jwksFileScheme + verification.GetJwksFile(), nil
+}
+
+// jwksCache caches JWKS documents by source, they are loaded and refreshed in background.
+type jwksCache struct {
+	entries map[string]*jwksEntry
+	load    func(ctx context.Context, source string) (string, error)
+	lock    sync.Mutex
+}
+
+type jwksEntry struct {
+	loadedAt time.Time
+	failedAt time.Time
+	jwks     string
+	lock     sync.Mutex
+	loading  bool
+}
+
+var defaultJWKSCache = newJWKSCache(loadJWKS)
+
+func newJWKSCache(load func(ctx context.Context, source string) (string, error)) *jwksCache {
+	return &jwksCache{
+		entries: make(map[string]*jwksEntry),
+		load:    load,
+	}
+}
+
+func (c *jwksCache) getEntry(source string) *jwksEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[source]
+	if !ok {
+		entry = &jwksEntry{}
+		c.entries[source] = entry
+	}
+	return entry
+}
+
+// prefetch starts loading the JWKS document of the source, unless it's already loaded or being loaded.
+func (c *jwksCache) prefetch(source string) {
+	entry := c.getEntry(source)
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+	if entry.jwks == "" && !entry.loading {
+		c.startLoad(entry, source)
+	}
+}
+
+// get returns the JWKS document of the source, without waiting for it to load.
+//
+// Documents which are not loaded yet are reported as not available, and loaded in background.
+func (c *jwksCache) get(source string, refreshInterval time.Duration) (string, error) {
+	entry := c.getEntry(source)
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+	now := time.Now()
+
+	if entry.jwks == "" {
+		if !entry.loading && now.Sub(entry.failedAt) >= jwksRetryInterval {
+			c.startLoad(entry, source)
+		}
+		return "", errors.New("jwks not loaded yet")
+	}
+
+	if now.Sub(entry.loadedAt) >= refreshInterval && !entry.loading && now.Sub(entry.failedAt) >= jwksRetryInterval {
+		c.startLoad(entry, source)
+	}
+	return entry.jwks, nil
+}
+
+// startLoad loads the JWKS document of the entry in background, parent function must hold the entry lock.
+func (c *jwksCache) startLoad(entry *jwksEntry, source string) {
+	entry.loading = true
+	panichandler.Go(func() {
+		jwks, err := c.load(context.Background(), source)
+		entry.lock.Lock()
+		defer entry.lock.Unlock()
+		entry.loading = false
+		if err != nil {
+			// keep serving the stale document, if any
+			entry.failedAt = time.Now()
+			log.Warn().Err(err).Str("source", source).Msg("Failed to load JWKS")
+			return
+		}
+		entry.jwks = jwks
+		entry.loadedAt = time.Now()
+	})
+}
+
+// loadJWKS reads the JWKS document from a file or fetches it over HTTP.
+func loadJWKS(ctx context.Context, source string) (string, error) {
+	var data []byte
+	if strings.HasPrefix(source, jwksFileScheme) {
+		var err error
+		data, err = os.ReadFile(strings.TrimPrefix(source, jwksFileScheme))
+		if err != nil {
+			return "", err
+		}
+	} else {
+		ctx, cancel := context.WithTimeout(ctx, jwksFetchTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return "", err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+		data, err = io.ReadAll(io.LimitReader(resp.Body, jwksMaxSize))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var doc struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return "", fmt.Errorf("invalid jwks: %w", err)
+	}
+	if len(doc.Keys) == 0 {
+		return "", errors.New("invalid jwks: no keys")
+	}
+	return string(data), nil
+}
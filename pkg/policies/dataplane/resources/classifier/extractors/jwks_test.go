@@ -0,0 +1,79 @@
+package extractors
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JWKS cache", func() {
+	const source = "https://example.com/.well-known/jwks.json"
+
+	var (
+		cache   *jwksCache
+		release chan struct{}
+		loads   chan string
+		loadErr error
+	)
+
+	BeforeEach(func() {
+		release = make(chan struct{})
+		loads = make(chan string, 10)
+		loadErr = nil
+		cache = newJWKSCache(func(_ context.Context, source string) (string, error) {
+			loads <- source
+			<-release
+			if loadErr != nil {
+				return "", loadErr
+			}
+			return `{"keys": [{}]}`, nil
+		})
+	})
+
+	It("does not wait for the first load", func() {
+		cache.prefetch(source)
+		Eventually(loads).Should(Receive(Equal(source)))
+
+		// the load is in flight, get fails right away
+		_, err := cache.get(source, time.Minute)
+		Expect(err).To(HaveOccurred())
+		// and does not start another load
+		Consistently(loads, 50*time.Millisecond).ShouldNot(Receive())
+
+		close(release)
+		Eventually(func() error {
+			_, err := cache.get(source, time.Minute)
+			return err
+		}).Should(Succeed())
+	})
+
+	It("starts loading on first use if not prefetched", func() {
+		_, err := cache.get(source, time.Minute)
+		Expect(err).To(HaveOccurred())
+		Eventually(loads).Should(Receive(Equal(source)))
+		close(release)
+		Eventually(func() (string, error) {
+			return cache.get(source, time.Minute)
+		}).Should(Equal(`{"keys": [{}]}`))
+	})
+
+	It("does not retry failed loads right away", func() {
+		loadErr = errors.New("unavailable")
+		close(release)
+		cache.prefetch(source)
+		Eventually(loads).Should(Receive())
+		Eventually(func() bool {
+			entry := cache.getEntry(source)
+			entry.lock.Lock()
+			defer entry.lock.Unlock()
+			return !entry.loading
+		}).Should(BeTrue())
+
+		_, err := cache.get(source, time.Minute)
+		Expect(err).To(HaveOccurred())
+		Consistently(loads, 50*time.Millisecond).ShouldNot(Receive())
+	})
+})
@@ -2,8 +2,11 @@ package classifier
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"path"
 
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/fx"
 
 	wrappersv1 "github.com/fluxninja/aperture/api/gen/proto/go/aperture/policy/wrappers/v1"
@@ -53,9 +56,10 @@ func setupEtcdClassifierWatcher(etcdClient *etcdclient.Client, lc fx.Lifecycle,
 // ClassificationEngineIn holds parameters for ProvideClassificationEngine.
 type ClassificationEngineIn struct {
 	fx.In
-	Watcher   notifiers.Watcher `name:"classifier"`
-	Lifecycle fx.Lifecycle
-	Registry  status.Registry
+	Watcher    notifiers.Watcher `name:"classifier"`
+	Lifecycle  fx.Lifecycle
+	Registry   status.Registry
+	EtcdClient *etcdclient.Client
 }
 
 // ProvideClassificationEngine provides a classifier that loads the rules from config file.
@@ -73,7 +77,13 @@ func ProvideClassificationEngine(in ClassificationEngineIn) *ClassificationEngin
 	}
 
 	in.Lifecycle.Append(fx.Hook{
-		OnStart: func(context.Context) error {
+		OnStart: func(ctx context.Context) error {
+			// rulesets hash telemetry values with the key, so it has to be known before they are added
+			telemetryHashKey, err := loadTelemetryHashKey(ctx, in.EtcdClient)
+			if err != nil {
+				return err
+			}
+			classificationEngine.SetTelemetryHashKey(telemetryHashKey)
 			return in.Watcher.AddPrefixNotifier(fxDriver)
 		},
 		OnStop: func(context.Context) error {
@@ -83,6 +93,36 @@ func ProvideClassificationEngine(in ClassificationEngineIn) *ClassificationEngin
 	return classificationEngine
 }
 
+// telemetryHashKeyLength is the length of the secret keying the hash telemetry transform, in bytes.
+const telemetryHashKeyLength = 32
+
+// loadTelemetryHashKey returns the secret keying the hash telemetry transform of the installation.
+// The first agent to start generates it, so that all the agents hash values the same way.
+func loadTelemetryHashKey(ctx context.Context, etcdClient *etcdclient.Client) ([]byte, error) {
+	key := make([]byte, telemetryHashKeyLength)
+	_, err := rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+	keyPath := common.TelemetryHashKeyPath
+	resp, err := etcdClient.KV.Txn(clientv3.WithRequireLeader(ctx)).
+		If(clientv3.Compare(clientv3.CreateRevision(keyPath), "=", 0)).
+		Then(clientv3.OpPut(keyPath, string(key))).
+		Else(clientv3.OpGet(keyPath)).
+		Commit()
+	if err != nil {
+		return nil, err
+	}
+	if resp.Succeeded {
+		return key, nil
+	}
+	kvs := resp.Responses[0].GetResponseRange().GetKvs()
+	if len(kvs) == 0 {
+		return nil, errors.New("telemetry hash key not found")
+	}
+	return kvs[0].Value, nil
+}
+
 // Per classifier fx app.
 func (c *ClassificationEngine) provideClassifierFxOptions(
 	key notifiers.Key,
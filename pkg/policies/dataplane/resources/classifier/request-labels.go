@@ -0,0 +1,66 @@
+package classifier
+
+import (
+	"sync"
+	"time"
+)
+
+// requestLabelsTTL is how long the request flow labels of a flow are kept until its access log is processed.
+const requestLabelsTTL = time.Minute
+
+// requestLabels records the flow labels of requests by flow ID, so that response rules and the
+// flux meters matched after them can use request labels that are not sent to telemetry.
+//
+// Flows whose access log never arrives are forgotten after one to two TTLs, when the map holding them is rotated out.
+type requestLabels struct {
+	mutex    sync.Mutex
+	current  map[string]map[string]string
+	previous map[string]map[string]string
+	rotated  time.Time
+}
+
+func newRequestLabels(now time.Time) *requestLabels {
+	return &requestLabels{
+		current:  make(map[string]map[string]string),
+		previous: make(map[string]map[string]string),
+		rotated:  now,
+	}
+}
+
+// record the request flow labels of a flow.
+func (rl *requestLabels) record(now time.Time, flowID string, labels map[string]string) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	rl.rotate(now)
+	rl.current[flowID] = labels
+}
+
+// take returns the request flow labels of a flow and forgets them.
+func (rl *requestLabels) take(now time.Time, flowID string) map[string]string {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	rl.rotate(now)
+	if labels, ok := rl.current[flowID]; ok {
+		delete(rl.current, flowID)
+		return labels
+	}
+	if labels, ok := rl.previous[flowID]; ok {
+		delete(rl.previous, flowID)
+		return labels
+	}
+	return nil
+}
+
+func (rl *requestLabels) rotate(now time.Time) {
+	elapsed := now.Sub(rl.rotated)
+	if elapsed < requestLabelsTTL {
+		return
+	}
+	if elapsed < 2*requestLabelsTTL {
+		rl.previous = rl.current
+	} else {
+		rl.previous = make(map[string]map[string]string)
+	}
+	rl.current = make(map[string]map[string]string)
+	rl.rotated = now
+}
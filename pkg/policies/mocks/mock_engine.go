@@ -64,6 +64,20 @@ func (mr *MockEngineMockRecorder) GetFluxMeter(fluxMeterName interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFluxMeter", reflect.TypeOf((*MockEngine)(nil).GetFluxMeter), fluxMeterName)
 }
 
+// GetMatchingFluxMeters mocks base method.
+func (m *MockEngine) GetMatchingFluxMeters(controlPoint selectors.ControlPoint, serviceIDs []string, labels map[string]string) []iface.FluxMeter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatchingFluxMeters", controlPoint, serviceIDs, labels)
+	ret0, _ := ret[0].([]iface.FluxMeter)
+	return ret0
+}
+
+// GetMatchingFluxMeters indicates an expected call of GetMatchingFluxMeters.
+func (mr *MockEngineMockRecorder) GetMatchingFluxMeters(controlPoint, serviceIDs, labels interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchingFluxMeters", reflect.TypeOf((*MockEngine)(nil).GetMatchingFluxMeters), controlPoint, serviceIDs, labels)
+}
+
 // ProcessRequest mocks base method.
 func (m *MockEngine) ProcessRequest(controlPoint selectors.ControlPoint, serviceIDs []string, labels map[string]string) *flowcontrolv1.CheckResponse {
 	m.ctrl.T.Helper()
@@ -120,6 +134,20 @@ func (mr *MockEngineMockRecorder) RegisterRateLimiter(l interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRateLimiter", reflect.TypeOf((*MockEngine)(nil).RegisterRateLimiter), l)
 }
 
+// TakeIssuedTokens mocks base method.
+func (m *MockEngine) TakeIssuedTokens(flowID string) []iface.IssuedTokens {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeIssuedTokens", flowID)
+	ret0, _ := ret[0].([]iface.IssuedTokens)
+	return ret0
+}
+
+// TakeIssuedTokens indicates an expected call of TakeIssuedTokens.
+func (mr *MockEngineMockRecorder) TakeIssuedTokens(flowID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeIssuedTokens", reflect.TypeOf((*MockEngine)(nil).TakeIssuedTokens), flowID)
+}
+
 // UnregisterConcurrencyLimiter mocks base method.
 func (m *MockEngine) UnregisterConcurrencyLimiter(sa iface.Limiter) error {
 	m.ctrl.T.Helper()
@@ -37,6 +37,20 @@ func (m *MockLimiter) EXPECT() *MockLimiterMockRecorder {
 	return m.recorder
 }
 
+// GetDeniedResponse mocks base method.
+func (m *MockLimiter) GetDeniedResponse(decision *flowcontrolv1.LimiterDecision) *flowcontrolv1.DeniedResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeniedResponse", decision)
+	ret0, _ := ret[0].(*flowcontrolv1.DeniedResponse)
+	return ret0
+}
+
+// GetDeniedResponse indicates an expected call of GetDeniedResponse.
+func (mr *MockLimiterMockRecorder) GetDeniedResponse(decision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeniedResponse", reflect.TypeOf((*MockLimiter)(nil).GetDeniedResponse), decision)
+}
+
 // GetLimiterID mocks base method.
 func (m *MockLimiter) GetLimiterID() iface.LimiterID {
 	m.ctrl.T.Helper()
@@ -106,3 +120,122 @@ func (mr *MockLimiterMockRecorder) RunLimiter(labels interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunLimiter", reflect.TypeOf((*MockLimiter)(nil).RunLimiter), labels)
 }
+
+// MockConcurrencyLimiter is a mock of ConcurrencyLimiter interface.
+type MockConcurrencyLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockConcurrencyLimiterMockRecorder
+}
+
+// MockConcurrencyLimiterMockRecorder is the mock recorder for MockConcurrencyLimiter.
+type MockConcurrencyLimiterMockRecorder struct {
+	mock *MockConcurrencyLimiter
+}
+
+// NewMockConcurrencyLimiter creates a new mock instance.
+func NewMockConcurrencyLimiter(ctrl *gomock.Controller) *MockConcurrencyLimiter {
+	mock := &MockConcurrencyLimiter{ctrl: ctrl}
+	mock.recorder = &MockConcurrencyLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConcurrencyLimiter) EXPECT() *MockConcurrencyLimiterMockRecorder {
+	return m.recorder
+}
+
+// GetDeniedResponse mocks base method.
+func (m *MockConcurrencyLimiter) GetDeniedResponse(decision *flowcontrolv1.LimiterDecision) *flowcontrolv1.DeniedResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeniedResponse", decision)
+	ret0, _ := ret[0].(*flowcontrolv1.DeniedResponse)
+	return ret0
+}
+
+// GetDeniedResponse indicates an expected call of GetDeniedResponse.
+func (mr *MockConcurrencyLimiterMockRecorder) GetDeniedResponse(decision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeniedResponse", reflect.TypeOf((*MockConcurrencyLimiter)(nil).GetDeniedResponse), decision)
+}
+
+// GetLimiterID mocks base method.
+func (m *MockConcurrencyLimiter) GetLimiterID() iface.LimiterID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimiterID")
+	ret0, _ := ret[0].(iface.LimiterID)
+	return ret0
+}
+
+// GetLimiterID indicates an expected call of GetLimiterID.
+func (mr *MockConcurrencyLimiterMockRecorder) GetLimiterID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimiterID", reflect.TypeOf((*MockConcurrencyLimiter)(nil).GetLimiterID))
+}
+
+// GetObserver mocks base method.
+func (m *MockConcurrencyLimiter) GetObserver(labels map[string]string) prometheus.Observer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObserver", labels)
+	ret0, _ := ret[0].(prometheus.Observer)
+	return ret0
+}
+
+// GetObserver indicates an expected call of GetObserver.
+func (mr *MockConcurrencyLimiterMockRecorder) GetObserver(labels interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObserver", reflect.TypeOf((*MockConcurrencyLimiter)(nil).GetObserver), labels)
+}
+
+// GetPolicyName mocks base method.
+func (m *MockConcurrencyLimiter) GetPolicyName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPolicyName indicates an expected call of GetPolicyName.
+func (mr *MockConcurrencyLimiterMockRecorder) GetPolicyName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyName", reflect.TypeOf((*MockConcurrencyLimiter)(nil).GetPolicyName))
+}
+
+// GetSelector mocks base method.
+func (m *MockConcurrencyLimiter) GetSelector() *selectorv1.Selector {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelector")
+	ret0, _ := ret[0].(*selectorv1.Selector)
+	return ret0
+}
+
+// GetSelector indicates an expected call of GetSelector.
+func (mr *MockConcurrencyLimiterMockRecorder) GetSelector() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelector", reflect.TypeOf((*MockConcurrencyLimiter)(nil).GetSelector))
+}
+
+// ReturnTokens mocks base method.
+func (m *MockConcurrencyLimiter) ReturnTokens(tokens uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReturnTokens", tokens)
+}
+
+// ReturnTokens indicates an expected call of ReturnTokens.
+func (mr *MockConcurrencyLimiterMockRecorder) ReturnTokens(tokens interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnTokens", reflect.TypeOf((*MockConcurrencyLimiter)(nil).ReturnTokens), tokens)
+}
+
+// RunLimiter mocks base method.
+func (m *MockConcurrencyLimiter) RunLimiter(labels map[string]string) *flowcontrolv1.LimiterDecision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunLimiter", labels)
+	ret0, _ := ret[0].(*flowcontrolv1.LimiterDecision)
+	return ret0
+}
+
+// RunLimiter indicates an expected call of RunLimiter.
+func (mr *MockConcurrencyLimiterMockRecorder) RunLimiter(labels interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunLimiter", reflect.TypeOf((*MockConcurrencyLimiter)(nil).RunLimiter), labels)
+}